@@ -11,7 +11,7 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.24.0
 	github.com/aws/aws-sdk-go v1.55.6
-	github.com/aws/aws-sdk-go-v2 v1.36.2
+	github.com/aws/aws-sdk-go-v2 v1.37.0
 	github.com/aws/aws-sdk-go-v2/config v1.29.7
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.60
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.29
@@ -100,11 +100,12 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/docdb v1.40.11
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.14.13
 	github.com/aws/aws-sdk-go-v2/service/drs v1.30.16
+	github.com/aws/aws-sdk-go-v2/service/dsql v1.5.0
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.40.2
 	github.com/aws/aws-sdk-go-v2/service/ec2 v1.203.1
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.41.1
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.31.3
-	github.com/aws/aws-sdk-go-v2/service/ecs v1.53.16
+	github.com/aws/aws-sdk-go-v2/service/ecs v1.61.0
 	github.com/aws/aws-sdk-go-v2/service/efs v1.34.12
 	github.com/aws/aws-sdk-go-v2/service/eks v1.58.1
 	github.com/aws/aws-sdk-go-v2/service/elasticache v1.44.13
@@ -116,6 +117,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/emr v1.47.13
 	github.com/aws/aws-sdk-go-v2/service/emrcontainers v1.34.0
 	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.27.9
+	github.com/aws/aws-sdk-go-v2/service/entityresolution v1.15.17
 	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.36.12
 	github.com/aws/aws-sdk-go-v2/service/evidently v1.23.16
 	github.com/aws/aws-sdk-go-v2/service/finspace v1.28.16
@@ -134,7 +136,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/healthlake v1.29.4
 	github.com/aws/aws-sdk-go-v2/service/iam v1.39.2
 	github.com/aws/aws-sdk-go-v2/service/identitystore v1.27.17
-	github.com/aws/aws-sdk-go-v2/service/imagebuilder v1.40.8
+	github.com/aws/aws-sdk-go-v2/service/imagebuilder v1.42.1
 	github.com/aws/aws-sdk-go-v2/service/inspector v1.25.16
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.34.10
 	github.com/aws/aws-sdk-go-v2/service/internetmonitor v1.20.14
@@ -180,6 +182,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.45.0
 	github.com/aws/aws-sdk-go-v2/service/networkmanager v1.32.11
 	github.com/aws/aws-sdk-go-v2/service/networkmonitor v1.7.16
+	github.com/aws/aws-sdk-go-v2/service/notifications v1.2.2
 	github.com/aws/aws-sdk-go-v2/service/oam v1.15.19
 	github.com/aws/aws-sdk-go-v2/service/opensearch v1.45.12
 	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.18.1
@@ -221,7 +224,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.53.5
 	github.com/aws/aws-sdk-go-v2/service/s3outposts v1.28.16
 	github.com/aws/aws-sdk-go-v2/service/s3tables v1.1.3
-	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.177.0
+	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.192.0
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.12.18
 	github.com/aws/aws-sdk-go-v2/service/schemas v1.28.18
 	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.34.19
@@ -266,7 +269,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.52.6
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.26.0
 	github.com/aws/aws-sdk-go-v2/service/xray v1.30.13
-	github.com/aws/smithy-go v1.22.3
+	github.com/aws/smithy-go v1.22.5
 	github.com/beevik/etree v1.5.0
 	github.com/cedar-policy/cedar-go v0.1.0
 	github.com/davecgh/go-spew v1.1.1
@@ -322,8 +325,8 @@ require (
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.10 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.33 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.33 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.4.0 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.7.0 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.3 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.33 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.3 // indirect
@@ -104,6 +104,7 @@ variable "service_labels" {
     "docdbelastic",
     "drs",
     "ds",
+    "dsql",
     "dynamodb",
     "dynamodbstreams",
     "ebs",
@@ -126,6 +127,7 @@ variable "service_labels" {
     "emr",
     "emrcontainers",
     "emrserverless",
+    "entityresolution",
     "events",
     "evidently",
     "finspace",
@@ -235,6 +237,7 @@ variable "service_labels" {
     "networkmanager",
     "networkmonitor",
     "nimble",
+    "notifications",
     "oam",
     "opensearch",
     "opensearchserverless",
@@ -143,6 +143,26 @@ provider "aws" {
 `, key1, value1)
 }
 
+// ConfigDefaultTagsUnknownValue1 returns a provider configuration with two default tags.
+// The value of the first is not known until apply, the value of the second is known.
+func ConfigDefaultTagsUnknownValue1(unknownKey, unknownValue, knownKey, knownValue string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = terraform_data.default_tags.output
+      %[3]q = %[4]q
+    }
+  }
+}
+
+resource "terraform_data" "default_tags" {
+  input = %[2]q
+}
+`, unknownKey, unknownValue, knownKey, knownValue)
+}
+
 func ConfigIgnoreTagsKeyPrefixes1(keyPrefix1 string) string {
 	//lintignore:AT004
 	return fmt.Sprintf(`
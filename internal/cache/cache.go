@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache memoizes the results of read-only calls for a short time.
+// It is intended to span a single refresh of many resources that make identical calls,
+// so the time-to-live should be no longer than that.
+//
+// Concurrent callers for the same key share a single in-flight call.
+// Errors are not cached.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[K]*entry[V]
+}
+
+type fetchContextKey struct{}
+
+type entry[V any] struct {
+	done    chan struct{}
+	expires time.Time
+	value   V
+	err     error
+}
+
+// New returns a Cache whose results expire after the specified time-to-live.
+func New[K comparable, V any](ttl time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[K]*entry[V]),
+	}
+}
+
+// Get returns the cached result for key, calling fetch if there is none.
+// All callers share the returned value, which must therefore not be modified.
+func (c *Cache[K, V]) Get(ctx context.Context, key K, fetch func(context.Context) (V, error)) (V, error) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if ok && c.expired(e) {
+		ok = false
+	}
+	if !ok {
+		e = &entry[V]{done: make(chan struct{})}
+		c.entries[key] = e
+		c.mu.Unlock()
+
+		e.value, e.err = fetch(context.WithValue(ctx, fetchContextKey{}, true))
+
+		c.mu.Lock()
+		e.expires = c.now().Add(c.ttl)
+		if e.err != nil && c.entries[key] == e {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+
+		close(e.done)
+
+		return e.value, e.err
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-e.done:
+		return e.value, e.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// Invalidate discards all cached results.
+// Calls in flight complete for their current callers, but their results are not cached.
+func (c *Cache[K, V]) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[K]*entry[V])
+}
+
+// InvalidateFunc discards the cached results whose keys satisfy the predicate.
+func (c *Cache[K, V]) InvalidateFunc(f func(K) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k := range c.entries {
+		if f(k) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// InFetch returns whether ctx is that of a call made to fill a Cache.
+// Other caches use it to avoid caching the same result twice, which would extend its lifetime.
+func InFetch(ctx context.Context) bool {
+	v, _ := ctx.Value(fetchContextKey{}).(bool)
+	return v
+}
+
+// expired returns whether a completed entry is past its expiry time.
+// Entries with a call still in flight are never expired.
+// The caller must hold the lock.
+func (c *Cache[K, V]) expired(e *entry[V]) bool {
+	select {
+	case <-e.done:
+		return !c.now().Before(e.expires)
+	default:
+		return false
+	}
+}
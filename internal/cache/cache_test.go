@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("concurrent callers share one call", func(t *testing.T) {
+		t.Parallel()
+
+		c := New[string, int](time.Minute)
+		var calls atomic.Int32
+		fetch := func(context.Context) (int, error) {
+			calls.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return 42, nil
+		}
+
+		var wg sync.WaitGroup
+		for range 100 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				v, err := c.Get(ctx, "k", fetch)
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				if v != 42 {
+					t.Errorf("got %d, expected 42", v)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if got := calls.Load(); got != 1 {
+			t.Errorf("got %d calls, expected 1", got)
+		}
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		t.Parallel()
+
+		c := New[string, int](time.Minute)
+		var calls atomic.Int32
+		fetch := func(context.Context) (int, error) {
+			return int(calls.Add(1)), nil
+		}
+
+		c.Get(ctx, "k", fetch)
+		c.Invalidate()
+		v, _ := c.Get(ctx, "k", fetch)
+
+		if v != 2 {
+			t.Errorf("got %d, expected 2", v)
+		}
+	})
+
+	t.Run("invalidate func", func(t *testing.T) {
+		t.Parallel()
+
+		c := New[string, int](time.Minute)
+		var calls atomic.Int32
+		fetch := func(context.Context) (int, error) {
+			return int(calls.Add(1)), nil
+		}
+
+		c.Get(ctx, "k1", fetch)
+		c.Get(ctx, "k2", fetch)
+		c.InvalidateFunc(func(k string) bool { return k == "k1" })
+		c.Get(ctx, "k1", fetch)
+		c.Get(ctx, "k2", fetch)
+
+		if got := calls.Load(); got != 3 {
+			t.Errorf("got %d calls, expected 3", got)
+		}
+	})
+
+	t.Run("invalidate during call", func(t *testing.T) {
+		t.Parallel()
+
+		c := New[string, int](time.Minute)
+		var calls atomic.Int32
+		fetch := func(context.Context) (int, error) {
+			if calls.Add(1) == 1 {
+				c.Invalidate()
+			}
+			return int(calls.Load()), nil
+		}
+
+		c.Get(ctx, "k", fetch)
+		v, _ := c.Get(ctx, "k", fetch)
+
+		if v != 2 {
+			t.Errorf("got %d, expected 2", v)
+		}
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Unix(0, 0)
+		c := New[string, int](time.Minute)
+		c.now = func() time.Time { return now }
+		var calls atomic.Int32
+		fetch := func(context.Context) (int, error) {
+			return int(calls.Add(1)), nil
+		}
+
+		c.Get(ctx, "k", fetch)
+		now = now.Add(59 * time.Second)
+		if v, _ := c.Get(ctx, "k", fetch); v != 1 {
+			t.Errorf("before expiry, got %d, expected 1", v)
+		}
+		now = now.Add(time.Second)
+		if v, _ := c.Get(ctx, "k", fetch); v != 2 {
+			t.Errorf("after expiry, got %d, expected 2", v)
+		}
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		t.Parallel()
+
+		c := New[string, int](time.Minute)
+		var calls atomic.Int32
+		fetch := func(context.Context) (int, error) {
+			if calls.Add(1) == 1 {
+				return 0, errors.New("throttled")
+			}
+			return 1, nil
+		}
+
+		if _, err := c.Get(ctx, "k", fetch); err == nil {
+			t.Error("expected error")
+		}
+		v, err := c.Get(ctx, "k", fetch)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+		if v != 1 {
+			t.Errorf("got %d, expected 1", v)
+		}
+	})
+
+	t.Run("in fetch", func(t *testing.T) {
+		t.Parallel()
+
+		if InFetch(ctx) {
+			t.Error("expected context not to be in fetch")
+		}
+
+		c := New[string, bool](time.Minute)
+		v, _ := c.Get(ctx, "k", func(ctx context.Context) (bool, error) {
+			return InFetch(ctx), nil
+		})
+
+		if !v {
+			t.Error("expected context to be in fetch")
+		}
+	})
+}
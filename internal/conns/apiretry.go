@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
@@ -27,3 +29,25 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// AddMaxBackoff returns a Retryer whose delay between attempts is at most maxBackoff.
+func AddMaxBackoff(r aws.Retryer, maxBackoff time.Duration) aws.Retryer {
+	v, ok := r.(aws.RetryerV2)
+	if !ok {
+		return retry.AddWithMaxBackoffDelay(r, maxBackoff)
+	}
+
+	return &withBackoff{
+		RetryerV2: v,
+		backoff:   &v1CompatibleBackoff{maxRetryDelay: maxBackoff},
+	}
+}
+
+type withBackoff struct {
+	aws.RetryerV2
+	backoff retry.BackoffDelayer
+}
+
+func (r *withBackoff) RetryDelay(attempt int, err error) (time.Duration, error) {
+	return r.backoff.BackoffDelay(attempt, err)
+}
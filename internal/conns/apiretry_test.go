@@ -7,6 +7,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
@@ -93,3 +94,29 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestAddMaxBackoff(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxBackoff = 2 * time.Second
+	)
+	r := AddMaxBackoff(retry.NewStandard(func(o *retry.StandardOptions) {
+		o.MaxBackoff = 300 * time.Second
+	}), maxBackoff)
+
+	for attempt := 1; attempt <= 30; attempt++ {
+		delay, err := r.RetryDelay(attempt, errors.New("testing"))
+		if err != nil {
+			t.Fatalf("RetryDelay(%d): %s", attempt, err)
+		}
+
+		if delay > maxBackoff {
+			t.Errorf("RetryDelay(%d) = %s, want at most %s", attempt, delay, maxBackoff)
+		}
+	}
+
+	if _, ok := r.(aws.RetryerV2); !ok {
+		t.Errorf("AddMaxBackoff returned %T, want aws.RetryerV2", r)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const (
+	auditLogRedactedValue = "[REDACTED]"
+)
+
+var (
+	// Prefixes of API operation names that do not modify resources.
+	auditLogReadOnlyOperationPrefixes = []string{
+		"Describe",
+		"Get",
+		"Head",
+		"List",
+		"Lookup",
+		"Query",
+		"Scan",
+		"Search",
+	}
+
+	// Suffixes of API parameter names whose values identify resources and are recorded.
+	// The values of all other parameters are redacted, as any payload may contain secrets.
+	auditLogIdentifierParameterSuffixes = []string{
+		"Arn",
+		"Arns",
+		"Id",
+		"Identifier",
+		"Identifiers",
+		"Ids",
+		"Name",
+		"Names",
+	}
+)
+
+// auditLog records mutating AWS API calls as JSON lines.
+type auditLog struct {
+	lock sync.Mutex
+	path string
+}
+
+type auditLogRecord struct {
+	Time               time.Time `json:"time"`
+	ServiceID          string    `json:"service_id"`
+	Operation          string    `json:"operation"`
+	Region             string    `json:"region,omitempty"`
+	ServicePackageName string    `json:"service_package,omitempty"`
+	ResourceName       string    `json:"resource,omitempty"`
+	Parameters         any       `json:"parameters,omitempty"`
+	RequestID          string    `json:"request_id,omitempty"`
+	StatusCode         int       `json:"status_code,omitempty"`
+	Error              string    `json:"error,omitempty"`
+}
+
+func newAuditLog(path string) *auditLog {
+	return &auditLog{
+		path: path,
+	}
+}
+
+// addMiddleware adds the audit log middleware to an AWS SDK for Go v2 API client's middleware stack.
+func (l *auditLog) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformAuditLog", l.handleInitialize), middleware.After)
+}
+
+func (l *auditLog) handleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	operation := awsmiddleware.GetOperationName(ctx)
+	if !isMutatingOperation(operation) {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	record := auditLogRecord{
+		Time:       time.Now().UTC(),
+		ServiceID:  awsmiddleware.GetServiceID(ctx),
+		Operation:  operation,
+		Region:     awsmiddleware.GetRegion(ctx),
+		Parameters: redactedParameters(in.Parameters),
+	}
+	if v, ok := FromContext(ctx); ok {
+		record.ServicePackageName = v.ServicePackageName
+		record.ResourceName = v.ResourceName
+	}
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		record.RequestID = v
+	}
+	if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && v != nil {
+		record.StatusCode = v.StatusCode
+	}
+	if err != nil {
+		record.Error = err.Error()
+
+		if respErr := (*awshttp.ResponseError)(nil); errors.As(err, &respErr) {
+			if record.RequestID == "" {
+				record.RequestID = respErr.ServiceRequestID()
+			}
+			if record.StatusCode == 0 {
+				record.StatusCode = respErr.HTTPStatusCode()
+			}
+		}
+	}
+
+	l.write(record)
+
+	return out, metadata, err
+}
+
+func (l *auditLog) write(record auditLogRecord) {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	b = append(b, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// The file is only held open while writing, as the provider has no hook to close it on exit.
+	// Audit logging is best-effort and must never fail the API call.
+	f, err := openAuditLogFile(l.path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	_, _ = f.Write(b)
+}
+
+func openAuditLogFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+}
+
+func isMutatingOperation(operation string) bool {
+	for _, prefix := range auditLogReadOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// redactedParameters returns a JSON-compatible copy of an API operation's top-level input parameters
+// in which only the values of identifier parameters are retained.
+func redactedParameters(params any) any {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return nil
+	}
+
+	var v map[string]any
+	if err := json.Unmarshal(b, &v); err != nil || v == nil {
+		return nil
+	}
+
+	for k, e := range v {
+		if e != nil && !(isIdentifierParameterName(k) && isIdentifierValue(e)) {
+			v[k] = auditLogRedactedValue
+		}
+	}
+
+	return v
+}
+
+func isIdentifierParameterName(name string) bool {
+	for _, suffix := range auditLogIdentifierParameterSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isIdentifierValue returns whether v is a string or a list of strings.
+func isIdentifierValue(v any) bool {
+	switch v := v.(type) {
+	case string:
+		return true
+	case []any:
+		for _, e := range v {
+			if _, ok := e.(string); !ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsMutatingOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"CreateVpc":           true,
+		"DeleteBucket":        true,
+		"DescribeInstances":   false,
+		"GetObject":           false,
+		"HeadBucket":          false,
+		"ListTagsForResource": false,
+		"LookupEvents":        false,
+		"PutBucketPolicy":     true,
+		"Query":               false,
+		"Scan":                false,
+		"SearchResources":     false,
+		"TagResource":         true,
+	}
+
+	for operation, want := range testCases {
+		t.Run(operation, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isMutatingOperation(operation); got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRedactedParameters(t *testing.T) {
+	t.Parallel()
+
+	type environment struct {
+		Variables map[string]string
+	}
+	type input struct {
+		ClientToken      *string
+		Environment      *environment
+		FunctionName     *string
+		KeyId            *string
+		Plaintext        []byte
+		SecurityGroupIds []string
+		Unset            *string
+		Value            *string
+	}
+
+	name, keyID, token, value := "my-function", "alias/test", "abc", "s3cr3t"
+	params := &input{
+		ClientToken: &token,
+		Environment: &environment{
+			Variables: map[string]string{"PASSWORD": "hunter2"},
+		},
+		FunctionName:     &name,
+		KeyId:            &keyID,
+		Plaintext:        []byte("plaintext"),
+		SecurityGroupIds: []string{"sg-1", "sg-2"},
+		Value:            &value,
+	}
+
+	want := map[string]any{
+		"ClientToken":      auditLogRedactedValue,
+		"Environment":      auditLogRedactedValue,
+		"FunctionName":     "my-function",
+		"KeyId":            "alias/test",
+		"Plaintext":        auditLogRedactedValue,
+		"SecurityGroupIds": []any{"sg-1", "sg-2"},
+		"Unset":            nil,
+		"Value":            auditLogRedactedValue,
+	}
+
+	if diff := cmp.Diff(redactedParameters(params), any(want)); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestAuditLogWrite(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l := newAuditLog(path)
+
+	l.write(auditLogRecord{Operation: "CreateVpc"})
+	l.write(auditLogRecord{Operation: "DeleteVpc"})
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading audit log: %s", err)
+	}
+
+	var operations []string
+	for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		var record auditLogRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("unmarshaling %q: %s", line, err)
+		}
+		operations = append(operations, record.Operation)
+	}
+
+	if diff := cmp.Diff(operations, []string{"CreateVpc", "DeleteVpc"}); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
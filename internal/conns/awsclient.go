@@ -12,6 +12,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -39,6 +40,7 @@ type AWSClient struct {
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
 	region                    string
+	serviceMaxBackoff         map[string]time.Duration // From provider configuration.
 	servicePackages           map[string]ServicePackage
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3.Client
@@ -322,6 +324,14 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
+	if v, ok := c.serviceMaxBackoff[servicePackageName]; ok {
+		cfg := c.awsConfig.Copy()
+		retryer := cfg.Retryer
+		cfg.Retryer = func() aws.Retryer {
+			return AddMaxBackoff(retryer(), v)
+		}
+		m["aws_sdkv2_config"] = &cfg
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
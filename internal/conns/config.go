@@ -5,7 +5,9 @@ package conns
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -31,17 +33,20 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogFile                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EnableReadCache                bool
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	MaxBackoff                     time.Duration
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
@@ -50,6 +55,7 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceMaxBackoff              map[string]time.Duration
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -71,8 +77,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, logger := logging.NewTfLogger(ctx)
 
 	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+		defaultMaxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
+	maxBackoff := defaultMaxBackoff
+	if c.MaxBackoff > 0 {
+		maxBackoff = c.MaxBackoff
+	}
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -145,9 +155,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 
 	for _, d := range awsDiags {
+		var err error
+		if v, ok := d.(basediag.DiagnosticWithErr); ok {
+			err = v.Err()
+		}
+		if awsbase.IsCannotAssumeRoleError(d) {
+			err = &assumeRoleError{index: cannotAssumeRoleIndex(ctx, awsbaseConfig), count: len(c.AssumeRole), err: err}
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: baseSeverityToSDKSeverity(d.Severity()),
-			Summary:  d.Summary(),
+			Summary:  assumeRoleSummary(d.Summary(), err),
 			Detail:   d.Detail(),
 		})
 	}
@@ -163,6 +180,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if c.AuditLogFile != "" {
+		f, err := openAuditLogFile(c.AuditLogFile)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening audit log file (%s): %s", c.AuditLogFile, err)
+		}
+		f.Close()
+
+		cfg.APIOptions = append(cfg.APIOptions, newAuditLog(c.AuditLogFile).addMiddleware)
+	}
+
+	if c.EnableReadCache {
+		cfg.APIOptions = append(cfg.APIOptions, newReadCache().addMiddleware)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -222,11 +253,53 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceMaxBackoff = c.ServiceMaxBackoff
 	client.stsRegion = c.STSRegion
 
 	return client, diags
 }
 
+// assumeRoleError is returned when a role in an assume_role chain cannot be assumed.
+type assumeRoleError struct {
+	index int // Position of the role in the chain, starting at 0.
+	count int // Number of roles in the chain.
+	err   error
+}
+
+func (e *assumeRoleError) Error() string {
+	return fmt.Sprintf("assuming IAM Role (assume_role %d of %d): %s", e.index+1, e.count, e.err)
+}
+
+func (e *assumeRoleError) Unwrap() error {
+	return e.err
+}
+
+// assumeRoleSummary adds the position of the role that could not be assumed to a diagnostic summary
+// when more than one role is chained.
+func assumeRoleSummary(summary string, err error) string {
+	if v := (*assumeRoleError)(nil); errors.As(err, &v) && v.count > 1 {
+		return fmt.Sprintf("%s (assume_role %d of %d)", summary, v.index+1, v.count)
+	}
+
+	return summary
+}
+
+// cannotAssumeRoleIndex returns the position of the first role in the assume_role chain that cannot be assumed.
+// aws-sdk-go-base does not report the position, so successively longer chains are assumed until one fails.
+func cannotAssumeRoleIndex(ctx context.Context, awsbaseConfig awsbase.Config) int {
+	roles := awsbaseConfig.AssumeRole
+
+	for i := 1; i < len(roles); i++ {
+		awsbaseConfig.AssumeRole = roles[:i]
+
+		if _, _, diags := awsbase.GetAwsConfig(ctx, &awsbaseConfig); slices.ContainsFunc(diags, awsbase.IsCannotAssumeRoleError) {
+			return i - 1
+		}
+	}
+
+	return len(roles) - 1
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
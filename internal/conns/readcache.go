@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/cache"
+)
+
+const (
+	// Cached results are short-lived so that waiters polling a cached operation see changes quickly.
+	readCacheTTL = 10 * time.Second
+)
+
+var (
+	// Prefixes of the API operation names, keyed by service ID, whose results may be cached.
+	readCacheOperationPrefixes = map[string][]string{
+		"EC2": {"Describe"},
+		"IAM": {"Get"},
+		"STS": {"GetCallerIdentity"},
+	}
+)
+
+// readCache caches the results of idempotent read API calls for a short time.
+// Any mutating API call to a service invalidates all of that service's cached results.
+// Calls made to fill a service-level cache are not cached again here.
+type readCache struct {
+	results *cache.Cache[readCacheKey, any]
+}
+
+type readCacheKey struct {
+	serviceID string
+	region    string
+	operation string
+	input     string
+}
+
+func newReadCache() *readCache {
+	return &readCache{
+		results: cache.New[readCacheKey, any](readCacheTTL),
+	}
+}
+
+// addMiddleware adds the read cache middleware to an AWS SDK for Go v2 API client's middleware stack.
+func (c *readCache) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformReadCache", c.handleInitialize), middleware.After)
+}
+
+func (c *readCache) handleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	return c.handle(ctx, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetRegion(ctx), awsmiddleware.GetOperationName(ctx), in, next)
+}
+
+func (c *readCache) handle(ctx context.Context, serviceID, region, operation string, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	if isMutatingOperation(operation) {
+		c.invalidate(serviceID)
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		// Reads that were in flight during the mutation may have seen either state.
+		c.invalidate(serviceID)
+
+		return out, metadata, err
+	}
+
+	if !isCacheableOperation(serviceID, operation) || cache.InFetch(ctx) {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	b, err := json.Marshal(in.Parameters)
+	if err != nil {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	key := readCacheKey{
+		serviceID: serviceID,
+		region:    region,
+		operation: operation,
+		input:     fmt.Sprintf("%T%s", in.Parameters, b),
+	}
+
+	var (
+		fetched  bool
+		metadata middleware.Metadata
+	)
+	result, err := c.results.Get(ctx, key, func(ctx context.Context) (any, error) {
+		fetched = true
+		out, md, err := next.HandleInitialize(ctx, in)
+		metadata = md
+
+		return out.Result, err
+	})
+
+	if err != nil {
+		return middleware.InitializeOutput{}, metadata, err
+	}
+
+	if !fetched {
+		tflog.Debug(ctx, "Using cached AWS API result", map[string]any{
+			"service_id": serviceID,
+			"operation":  operation,
+		})
+	}
+
+	// Callers own the results they are returned, so never hand out the cached value itself.
+	return middleware.InitializeOutput{Result: deepCopy(result)}, metadata, nil
+}
+
+func (c *readCache) invalidate(serviceID string) {
+	c.results.InvalidateFunc(func(k readCacheKey) bool {
+		return k.serviceID == serviceID
+	})
+}
+
+func isCacheableOperation(serviceID, operation string) bool {
+	for _, prefix := range readCacheOperationPrefixes[serviceID] {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deepCopy returns a copy of an API operation's result that shares no mutable state with the original.
+// Unexported struct fields are copied shallowly.
+func deepCopy(v any) any {
+	if v == nil {
+		return nil
+	}
+
+	return deepCopyValue(reflect.ValueOf(v)).Interface()
+}
+
+func deepCopyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopyValue(v.Elem()))
+		return c
+
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := range v.NumField() {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(deepCopyValue(v.Field(i)))
+			}
+		}
+		return c
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			c.Index(i).Set(deepCopyValue(v.Index(i)))
+		}
+		return c
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+		}
+		return c
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopyValue(v.Elem()))
+		return c
+
+	default:
+		return v
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/cache"
+)
+
+func TestIsCacheableOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		serviceID string
+		operation string
+		want      bool
+	}{
+		{"EC2", "DescribeVpcs", true},
+		{"EC2", "CreateVpc", false},
+		{"IAM", "GetRole", true},
+		{"IAM", "ListRoles", false},
+		{"STS", "GetCallerIdentity", true},
+		{"STS", "GetSessionToken", false},
+		{"S3", "GetObject", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.serviceID+"/"+testCase.operation, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isCacheableOperation(testCase.serviceID, testCase.operation); got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+type readCacheTestInput struct {
+	VpcIds []string
+}
+
+type readCacheTestOutput struct {
+	Names []string
+	Tags  map[string]*string
+}
+
+// readCacheTestHandler counts the calls that reach the API.
+type readCacheTestHandler struct {
+	calls int
+}
+
+func (h *readCacheTestHandler) HandleInitialize(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+	h.calls++
+
+	return middleware.InitializeOutput{Result: &readCacheTestOutput{Names: []string{"one"}}}, middleware.Metadata{}, nil
+}
+
+func TestReadCache(t *testing.T) {
+	t.Parallel()
+
+	c := newReadCache()
+	next := &readCacheTestHandler{}
+
+	call := func(ctx context.Context, serviceID, operation string, vpcIDs ...string) *readCacheTestOutput {
+		t.Helper()
+
+		out, _, err := c.handle(ctx, serviceID, "us-west-2", operation, middleware.InitializeInput{Parameters: &readCacheTestInput{VpcIds: vpcIDs}}, next) //lintignore:AWSAT003
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return out.Result.(*readCacheTestOutput)
+	}
+
+	ctx := context.Background()
+
+	call(ctx, "EC2", "DescribeVpcs", "vpc-1")
+	output := call(ctx, "EC2", "DescribeVpcs", "vpc-1")
+	if got, want := next.calls, 1; got != want {
+		t.Errorf("after repeated read, calls = %d, want %d", got, want)
+	}
+
+	// Changes made by the caller must not leak into the cache.
+	output.Names[0] = "modified"
+	if diff := cmp.Diff(call(ctx, "EC2", "DescribeVpcs", "vpc-1").Names, []string{"one"}); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	call(ctx, "EC2", "DescribeVpcs", "vpc-2")
+	if got, want := next.calls, 2; got != want {
+		t.Errorf("after read with different input, calls = %d, want %d", got, want)
+	}
+
+	call(ctx, "IAM", "CreateRole")
+	call(ctx, "EC2", "DescribeVpcs", "vpc-1")
+	if got, want := next.calls, 3; got != want {
+		t.Errorf("after mutating call to another service, calls = %d, want %d", got, want)
+	}
+
+	call(ctx, "EC2", "CreateTags")
+	call(ctx, "EC2", "DescribeVpcs", "vpc-1")
+	if got, want := next.calls, 5; got != want {
+		t.Errorf("after mutating call to the same service, calls = %d, want %d", got, want)
+	}
+
+	call(ctx, "S3", "ListBuckets")
+	call(ctx, "S3", "ListBuckets")
+	if got, want := next.calls, 7; got != want {
+		t.Errorf("after uncacheable reads, calls = %d, want %d", got, want)
+	}
+
+	// Calls made to fill a service-level cache are not cached again.
+	serviceCache := cache.New[string, *readCacheTestOutput](time.Minute)
+	for _, k := range []string{"k1", "k2"} {
+		serviceCache.Get(ctx, k, func(ctx context.Context) (*readCacheTestOutput, error) {
+			return call(ctx, "EC2", "DescribeRouteTables"), nil
+		})
+	}
+	if got, want := next.calls, 9; got != want {
+		t.Errorf("after reads filling a service-level cache, calls = %d, want %d", got, want)
+	}
+}
+
+func TestReadCache_invalidatedDuringRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := newReadCache()
+	var calls int
+	next := middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+		calls++
+		if calls == 1 {
+			// A mutation completes while the first read is in flight.
+			c.invalidate("EC2")
+		}
+
+		return middleware.InitializeOutput{Result: &readCacheTestOutput{}}, middleware.Metadata{}, nil
+	})
+
+	for range 2 {
+		if _, _, err := c.handle(ctx, "EC2", "us-west-2", "DescribeVpcs", middleware.InitializeInput{Parameters: &readCacheTestInput{}}, next); err != nil { //lintignore:AWSAT003
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := calls, 2; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestDeepCopy(t *testing.T) {
+	t.Parallel()
+
+	value := "v1"
+	original := &readCacheTestOutput{
+		Names: []string{"one", "two"},
+		Tags:  map[string]*string{"k1": &value},
+	}
+
+	c := deepCopy(original).(*readCacheTestOutput)
+
+	if diff := cmp.Diff(c, original); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	c.Names[0] = "modified"
+	*c.Tags["k1"] = "modified"
+
+	if got, want := original.Names[0], "one"; got != want {
+		t.Errorf("original Names[0] = %q, want %q", got, want)
+	}
+	if got, want := value, "v1"; got != want {
+		t.Errorf("original Tags[k1] = %q, want %q", got, want)
+	}
+}
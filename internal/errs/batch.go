@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ItemError is the failure of a single item in a batch API call.
+type ItemError struct {
+	ID      string // Identifier of the failed item, e.g. an ARN or name
+	Code    string
+	Message string
+}
+
+func (e ItemError) Error() string {
+	var sb strings.Builder
+
+	if e.ID != "" {
+		sb.WriteString(e.ID)
+		sb.WriteString(": ")
+	}
+
+	switch {
+	case e.Code != "" && e.Message != "":
+		fmt.Fprintf(&sb, "%s: %s", e.Code, e.Message)
+	case e.Code != "":
+		sb.WriteString(e.Code)
+	case e.Message != "":
+		sb.WriteString(e.Message)
+	default:
+		sb.WriteString("unknown error")
+	}
+
+	return sb.String()
+}
+
+// BatchError aggregates the per-item failures returned by a batch API call.
+type BatchError struct {
+	Items []ItemError
+}
+
+// NewBatchError returns a BatchError for the specified item failures,
+// or nil if there are none.
+func NewBatchError(items []ItemError) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	return &BatchError{Items: items}
+}
+
+// Error returns one line per failed item, in the order returned by the API.
+func (e *BatchError) Error() string {
+	lines := make([]string, len(e.Items))
+
+	for i, item := range e.Items {
+		lines[i] = item.Error()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap allows errors.As to match individual item failures.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Items))
+
+	for i, item := range e.Items {
+		errs[i] = item
+	}
+
+	return errs
+}
+
+// IDs returns the identifiers of the failed items.
+func (e *BatchError) IDs() []string {
+	ids := make([]string, len(e.Items))
+
+	for i, item := range e.Items {
+		ids[i] = item.ID
+	}
+
+	return ids
+}
+
+// ItemCodeEquals returns a predicate matching item failures with any of the specified error codes.
+func ItemCodeEquals(codes ...string) func(ItemError) bool {
+	return func(item ItemError) bool {
+		return slices.Contains(codes, item.Code)
+	}
+}
+
+// AllItemsMatch returns whether err is a BatchError and every failed item satisfies the predicate,
+// e.g. to decide whether a whole batch call can be retried.
+func AllItemsMatch(err error, predicate func(ItemError) bool) bool {
+	batchErr, ok := As[*BatchError](err)
+	if !ok {
+		return false
+	}
+
+	return !slices.ContainsFunc(batchErr.Items, func(item ItemError) bool {
+		return !predicate(item)
+	})
+}
+
+// AnyItemMatches returns whether err is a BatchError and any failed item satisfies the predicate.
+func AnyItemMatches(err error, predicate func(ItemError) bool) bool {
+	batchErr, ok := As[*BatchError](err)
+	if !ok {
+		return false
+	}
+
+	return slices.ContainsFunc(batchErr.Items, predicate)
+}
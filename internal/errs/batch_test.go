@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestNewBatchError(t *testing.T) {
+	t.Parallel()
+
+	if err := errs.NewBatchError(nil); err != nil {
+		t.Errorf("NewBatchError(nil) = %v, want nil", err)
+	}
+
+	err := errs.NewBatchError([]errs.ItemError{
+		{ID: "arn:aws:secretsmanager:us-west-2:123456789012:secret:one", Code: "InvalidSecretArn", Message: "not found"},
+		{ID: "two", Code: "ThrottlingException"},
+		{Message: "internal failure"},
+	})
+
+	if got, want := err.Error(), "arn:aws:secretsmanager:us-west-2:123456789012:secret:one: InvalidSecretArn: not found\ntwo: ThrottlingException\ninternal failure"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	batchErr, ok := errs.As[*errs.BatchError](fmt.Errorf("creating: %w", err))
+	if !ok {
+		t.Fatalf("wrapped error is not a BatchError")
+	}
+	if got, want := batchErr.IDs(), []string{"arn:aws:secretsmanager:us-west-2:123456789012:secret:one", "two", ""}; !slices.Equal(got, want) {
+		t.Errorf("IDs() = %v, want %v", got, want)
+	}
+
+	var itemErr errs.ItemError
+	if !errors.As(err, &itemErr) {
+		t.Fatalf("errors.As did not match an ItemError")
+	}
+	if got, want := itemErr.Code, "InvalidSecretArn"; got != want {
+		t.Errorf("first item Code = %q, want %q", got, want)
+	}
+}
+
+func TestAllItemsMatch(t *testing.T) {
+	t.Parallel()
+
+	retryable := errs.ItemCodeEquals("ThrottlingException", "InternalServerError")
+
+	testCases := map[string]struct {
+		err            error
+		wantAllMatch   bool
+		wantAnyMatches bool
+	}{
+		"nil": {
+			err: nil,
+		},
+		"not a batch error": {
+			err: errors.New("ThrottlingException"),
+		},
+		"all retryable": {
+			err: errs.NewBatchError([]errs.ItemError{
+				{ID: "one", Code: "ThrottlingException"},
+				{ID: "two", Code: "InternalServerError"},
+			}),
+			wantAllMatch:   true,
+			wantAnyMatches: true,
+		},
+		"mixed retryable and terminal": {
+			err: errs.NewBatchError([]errs.ItemError{
+				{ID: "one", Code: "ThrottlingException"},
+				{ID: "two", Code: "ValidationException"},
+			}),
+			wantAnyMatches: true,
+		},
+		"all terminal": {
+			err: errs.NewBatchError([]errs.ItemError{
+				{ID: "one", Code: "ValidationException"},
+			}),
+		},
+		"wrapped": {
+			err: fmt.Errorf("tagging: %w", errs.NewBatchError([]errs.ItemError{
+				{ID: "one", Code: "ThrottlingException"},
+			})),
+			wantAllMatch:   true,
+			wantAnyMatches: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := errs.AllItemsMatch(testCase.err, retryable), testCase.wantAllMatch; got != want {
+				t.Errorf("AllItemsMatch() = %t, want %t", got, want)
+			}
+			if got, want := errs.AnyItemMatches(testCase.err, retryable), testCase.wantAnyMatches; got != want {
+				t.Errorf("AnyItemMatches() = %t, want %t", got, want)
+			}
+		})
+	}
+}
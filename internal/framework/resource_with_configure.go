@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -53,12 +54,22 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 		return
 	}
 
-	if !planTags.IsUnknown() {
+	if !planTags.IsUnknown() && !defaultTagsConfig.IsUnknown() {
 		if !mapHasUnknownElements(planTags) {
 			resourceTags := tftags.New(ctx, planTags)
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
-
-			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+			tagsAll := flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map())
+
+			// Only the values of default tags that are not yet known are planned as unknown.
+			if keys := defaultTagsConfig.UnknownKeys(resourceTags, allTags); len(keys) > 0 {
+				elems := tagsAll.Elements()
+				for _, k := range keys {
+					elems[k] = types.StringUnknown()
+				}
+				tagsAll = types.MapValueMust(types.StringType, elems)
+			}
+
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tagsAll)...)
 		} else {
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 		}
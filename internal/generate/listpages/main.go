@@ -32,9 +32,11 @@ const (
 var (
 	inputPaginator  = flag.String("InputPaginator", "", "name of the input pagination token field")
 	listOps         = flag.String("ListOps", "", "ListOps")
+	morePages       = flag.String("MorePages", "", "name of the boolean output field indicating that more pages are available")
 	outputPaginator = flag.String("OutputPaginator", "", "name of the output pagination token field")
 	paginator       = flag.String("Paginator", "NextToken", "name of the pagination token field")
 	export          = flag.Bool("Export", false, "whether to export the list functions")
+	v2Paginators    = flag.Bool("V2Paginators", false, "whether to wrap the AWS SDK for Go v2 paginators where available")
 	v2Suffix        = flag.Bool("V2Suffix", false, "whether to append a V2 suffix to the list functions")
 )
 
@@ -86,20 +88,30 @@ func main() {
 		tmpl:            tmpl,
 		inputPaginator:  *inputPaginator,
 		outputPaginator: *outputPaginator,
+		morePages:       *morePages,
+		v2Paginators:    *v2Paginators,
 	}
 
 	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%[1]s", awsService)
 
 	g.parsePackage(sourcePackage)
 
+	funcSpecs := make([]FuncSpec, len(functions))
+	importAWS := false
+	for i, functionName := range functions {
+		funcSpecs[i] = g.funcSpec(functionName, awsService, *export)
+		importAWS = importAWS || funcSpecs[i].NeedsAWS()
+	}
+
 	g.printHeader(HeaderInfo{
 		Parameters:         strings.Join(os.Args[1:], " "),
 		DestinationPackage: servicePackage,
 		SourcePackage:      sourcePackage,
+		ImportAWS:          importAWS,
 	})
 
-	for _, functionName := range functions {
-		g.generateFunction(functionName, awsService, *export)
+	for _, funcSpec := range funcSpecs {
+		g.generateFunction(funcSpec)
 	}
 
 	src := g.format()
@@ -114,6 +126,7 @@ type HeaderInfo struct {
 	Parameters         string
 	DestinationPackage string
 	SourcePackage      string
+	ImportAWS          bool
 }
 
 type Generator struct {
@@ -122,6 +135,8 @@ type Generator struct {
 	tmpl            *template.Template
 	inputPaginator  string
 	outputPaginator string
+	morePages       string
+	v2Paginators    bool
 }
 
 func (g *Generator) Printf(format string, args ...interface{}) {
@@ -174,34 +189,30 @@ func (g *Generator) addPackage(pkg *packages.Package) {
 }
 
 type FuncSpec struct {
-	Name            string
-	AWSName         string
-	AWSService      string
-	ParamType       string
-	ResultType      string
-	InputPaginator  string
-	OutputPaginator string
+	Name               string
+	AWSName            string
+	AWSService         string
+	ParamType          string
+	ResultType         string
+	InputPaginator     string
+	OutputPaginator    string
+	MorePages          string
+	MorePagesIsPointer bool
+	V2Paginator        string
 }
 
-func (g *Generator) generateFunction(functionName, awsService string, export bool) {
-	var function *ast.FuncDecl
-
-	for _, file := range g.pkg.files {
-		if file.file != nil {
-			for _, decl := range file.file.Decls {
-				if funcDecl, ok := decl.(*ast.FuncDecl); ok {
-					if funcDecl.Name.Name == functionName {
-						function = funcDecl
-						break
-					}
-				}
-			}
-			if function != nil {
-				break
-			}
-		}
+// NeedsAWS returns whether the generated function references the aws package.
+func (f FuncSpec) NeedsAWS() bool {
+	if f.MorePages != "" {
+		return f.MorePagesIsPointer
 	}
 
+	return f.V2Paginator == ""
+}
+
+func (g *Generator) funcSpec(functionName, awsService string, export bool) FuncSpec {
+	function := g.findFunction(functionName)
+
 	if function == nil {
 		log.Fatalf("function \"%s\" not found", functionName)
 	}
@@ -220,12 +231,92 @@ func (g *Generator) generateFunction(functionName, awsService string, export boo
 		ResultType:      g.expandTypeField(function.Type.Results, true), // Assumes we can take the first return parameter
 		InputPaginator:  g.inputPaginator,
 		OutputPaginator: g.outputPaginator,
+		MorePages:       g.morePages,
 	}
 
+	if g.v2Paginators {
+		// Not every operation has an SDK paginator; fall back to the token loop for those.
+		if name := fmt.Sprintf("New%sPaginator", function.Name.Name); g.findFunction(name) != nil {
+			funcSpec.V2Paginator = name
+		} else {
+			log.Printf("%s not found, generating token-based pagination for %s", name, functionName)
+		}
+	}
+
+	resultTypeName := function.Type.Results.List[0].Type.(*ast.StarExpr).X.(*ast.Ident).Name
+
+	if funcSpec.V2Paginator == "" {
+		if _, ok := g.structFieldType(resultTypeName, funcSpec.OutputPaginator); !ok {
+			log.Fatalf("output field \"%s\" not found on %s", funcSpec.OutputPaginator, resultTypeName)
+		}
+	}
+
+	if funcSpec.MorePages != "" {
+		typeValue, ok := g.structFieldType(resultTypeName, funcSpec.MorePages)
+		if !ok {
+			log.Fatalf("output field \"%s\" not found on %s", funcSpec.MorePages, resultTypeName)
+		}
+		_, funcSpec.MorePagesIsPointer = typeValue.(*ast.StarExpr)
+	}
+
+	return funcSpec
+}
+
+func (g *Generator) generateFunction(funcSpec FuncSpec) {
 	err := g.tmpl.Execute(&g.buf, funcSpec)
 	if err != nil {
-		log.Fatalf("error writing function \"%s\": %s", functionName, err)
+		log.Fatalf("error writing function \"%s\": %s", funcSpec.AWSName, err)
+	}
+}
+
+func (g *Generator) findFunction(functionName string) *ast.FuncDecl {
+	for _, file := range g.pkg.files {
+		if file.file == nil {
+			continue
+		}
+		for _, decl := range file.file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Name.Name == functionName {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
+
+// structFieldType returns the type expression of the named field of the named struct type.
+func (g *Generator) structFieldType(typeName, fieldName string) (ast.Expr, bool) {
+	for _, file := range g.pkg.files {
+		if file.file == nil {
+			continue
+		}
+		for _, decl := range file.file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || typeSpec.Name.Name != typeName {
+					continue
+				}
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					return nil, false
+				}
+				for _, field := range structType.Fields.List {
+					for _, name := range field.Names {
+						if name.Name == fieldName {
+							return field.Type, true
+						}
+					}
+				}
+				return nil, false
+			}
+		}
 	}
+
+	return nil, false
 }
 
 func (g *Generator) expandTypeField(field *ast.FieldList, result bool) string {
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"go/format"
+	"html/template"
+	"strings"
+	"testing"
+)
+
+const fixturePackage = "./testdata/fixture"
+
+func newTestGenerator(t *testing.T, g Generator) *Generator {
+	t.Helper()
+
+	if g.inputPaginator == "" {
+		g.inputPaginator = "NextToken"
+	}
+	if g.outputPaginator == "" {
+		g.outputPaginator = "NextToken"
+	}
+	g.tmpl = template.Must(template.New("function").Parse(functionTemplate))
+	g.parsePackage(fixturePackage)
+
+	return &g
+}
+
+func generate(t *testing.T, g *Generator, functionName string) (FuncSpec, string) {
+	t.Helper()
+
+	funcSpec := g.funcSpec(functionName, "fixture", false)
+
+	g.printHeader(HeaderInfo{
+		DestinationPackage: "test",
+		SourcePackage:      fixturePackage,
+		ImportAWS:          funcSpec.NeedsAWS(),
+	})
+	g.generateFunction(funcSpec)
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		t.Fatalf("invalid Go generated: %s\n%s", err, g.buf.String())
+	}
+
+	return funcSpec, string(src)
+}
+
+func expectContains(t *testing.T, src string, want ...string) {
+	t.Helper()
+
+	for _, w := range want {
+		if !strings.Contains(src, w) {
+			t.Errorf("generated source does not contain %q:\n%s", w, src)
+		}
+	}
+}
+
+func expectNotContains(t *testing.T, src string, unwanted ...string) {
+	t.Helper()
+
+	for _, u := range unwanted {
+		if strings.Contains(src, u) {
+			t.Errorf("generated source unexpectedly contains %q:\n%s", u, src)
+		}
+	}
+}
+
+func TestGenerateTokenPagination(t *testing.T) {
+	t.Parallel()
+
+	g := newTestGenerator(t, Generator{})
+	funcSpec, src := generate(t, g, "ListThings")
+
+	if funcSpec.V2Paginator != "" {
+		t.Errorf("V2Paginator = %q, want none", funcSpec.V2Paginator)
+	}
+	expectContains(t, src,
+		`"github.com/aws/aws-sdk-go-v2/aws"`,
+		"func listThingsPages(ctx context.Context, conn *fixture.Client, input *fixture.ListThingsInput, fn func(*fixture.ListThingsOutput, bool) bool) error {",
+		"output, err := conn.ListThings(ctx, input)",
+		`lastPage := aws.ToString(output.NextToken) == ""`,
+		"input.NextToken = output.NextToken",
+	)
+}
+
+func TestGenerateCustomOutputFieldsAndPointerMorePages(t *testing.T) {
+	t.Parallel()
+
+	g := newTestGenerator(t, Generator{
+		inputPaginator:  "Marker",
+		outputPaginator: "NextMarker",
+		morePages:       "IsTruncated",
+	})
+	funcSpec, src := generate(t, g, "ListWidgets")
+
+	if !funcSpec.MorePagesIsPointer {
+		t.Error("MorePagesIsPointer = false, want true")
+	}
+	expectContains(t, src,
+		`"github.com/aws/aws-sdk-go-v2/aws"`,
+		"lastPage := !aws.ToBool(output.IsTruncated)",
+		"input.Marker = output.NextMarker",
+	)
+}
+
+func TestGenerateValueMorePages(t *testing.T) {
+	t.Parallel()
+
+	g := newTestGenerator(t, Generator{
+		morePages: "HasMore",
+	})
+	funcSpec, src := generate(t, g, "ListGadgets")
+
+	if funcSpec.MorePagesIsPointer {
+		t.Error("MorePagesIsPointer = true, want false")
+	}
+	if funcSpec.NeedsAWS() {
+		t.Error("NeedsAWS() = true, want false")
+	}
+	expectContains(t, src,
+		"lastPage := !output.HasMore",
+		"input.NextToken = output.NextToken",
+	)
+	expectNotContains(t, src, `"github.com/aws/aws-sdk-go-v2/aws"`)
+}
+
+func TestGenerateV2Paginator(t *testing.T) {
+	t.Parallel()
+
+	g := newTestGenerator(t, Generator{
+		v2Paginators: true,
+	})
+	funcSpec, src := generate(t, g, "ListThings")
+
+	if got, want := funcSpec.V2Paginator, "NewListThingsPaginator"; got != want {
+		t.Errorf("V2Paginator = %q, want %q", got, want)
+	}
+	expectContains(t, src,
+		"pages := fixture.NewListThingsPaginator(conn, input)",
+		"for pages.HasMorePages() {",
+		"output, err := pages.NextPage(ctx)",
+		"lastPage := !pages.HasMorePages()",
+	)
+	expectNotContains(t, src,
+		`"github.com/aws/aws-sdk-go-v2/aws"`,
+		"conn.ListThings(ctx, input)",
+		"input.NextToken = output.NextToken",
+	)
+}
+
+func TestGenerateV2PaginatorNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	g := newTestGenerator(t, Generator{
+		v2Paginators: true,
+	})
+	funcSpec, src := generate(t, g, "ListGadgets")
+
+	if funcSpec.V2Paginator != "" {
+		t.Errorf("V2Paginator = %q, want none", funcSpec.V2Paginator)
+	}
+	expectContains(t, src,
+		"output, err := conn.ListGadgets(ctx, input)",
+		`lastPage := aws.ToString(output.NextToken) == ""`,
+	)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fixture mimics the shape of an AWS SDK for Go v2 service client.
+package fixture
+
+import (
+	"context"
+)
+
+type Client struct{}
+
+type ListThingsInput struct {
+	NextToken *string
+}
+
+type ListThingsOutput struct {
+	Things    []string
+	NextToken *string
+}
+
+func (c *Client) ListThings(ctx context.Context, params *ListThingsInput, optFns ...func(*Options)) (*ListThingsOutput, error) {
+	return &ListThingsOutput{}, nil
+}
+
+type ListThingsPaginator struct{}
+
+func NewListThingsPaginator(client *Client, params *ListThingsInput) *ListThingsPaginator {
+	return &ListThingsPaginator{}
+}
+
+type ListWidgetsInput struct {
+	Marker *string
+}
+
+type ListWidgetsOutput struct {
+	Widgets     []string
+	IsTruncated *bool
+	NextMarker  *string
+}
+
+func (c *Client) ListWidgets(ctx context.Context, params *ListWidgetsInput, optFns ...func(*Options)) (*ListWidgetsOutput, error) {
+	return &ListWidgetsOutput{}, nil
+}
+
+type ListGadgetsInput struct {
+	NextToken *string
+}
+
+type ListGadgetsOutput struct {
+	Gadgets   []string
+	HasMore   bool
+	NextToken *string
+}
+
+func (c *Client) ListGadgets(ctx context.Context, params *ListGadgetsInput, optFns ...func(*Options)) (*ListGadgetsOutput, error) {
+	return &ListGadgetsOutput{}, nil
+}
+
+type Options struct{}
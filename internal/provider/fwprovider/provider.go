@@ -60,6 +60,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"audit_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "File to which mutating AWS API calls are recorded as JSON lines. Only the values of parameters that identify resources are recorded.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -72,6 +76,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"enable_read_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Cache the results of idempotent read API calls (EC2 `Describe*`, IAM `Get*` and STS `GetCallerIdentity`) for a short time. Cached results for a service are discarded after any mutating API call to that service.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -88,6 +96,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"max_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum delay between retries of an AWS API request, as a duration string\nsuch as `300s` or `5m`. Defaults to `300s`.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
@@ -122,6 +134,11 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_max_backoff": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The maximum delay between retries of AWS API requests to specific services, as duration strings\nkeyed by the service names used in the `endpoints` block. Overrides `max_backoff`.",
+			},
 			"shared_config_files": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -54,6 +55,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "File to which mutating AWS API calls are recorded as JSON lines. " +
+					"Only the values of parameters that identify resources are recorded.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -90,6 +97,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"enable_read_cache": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Cache the results of idempotent read API calls (EC2 `Describe*`, IAM `Get*` and STS `GetCallerIdentity`) for a short time. " +
+					"Cached results for a service are discarded after any mutating API call to that service.",
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -139,6 +152,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"max_backoff": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validMaxBackoff,
+				Description: "The maximum delay between retries of an AWS API request, as a duration string\n" +
+					"such as `300s` or `5m`. Defaults to `300s`.",
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -191,6 +211,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_max_backoff": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The maximum delay between retries of AWS API requests to specific services, as duration strings\n" +
+					"keyed by the service names used in the `endpoints` block. Overrides `max_backoff`.",
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -481,9 +508,11 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogFile:                   d.Get("audit_log_file").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		EnableReadCache:                d.Get("enable_read_cache").(bool),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
@@ -510,6 +539,23 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.RetryMode = mode
 	}
 
+	if v, ok := d.Get("max_backoff").(string); ok && v != "" {
+		maxBackoff, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "parsing max_backoff: %s", err)
+		}
+		config.MaxBackoff = maxBackoff
+	}
+
+	if v, ok := d.GetOk("service_max_backoff"); ok && len(v.(map[string]interface{})) > 0 {
+		serviceMaxBackoff, dx := expandServiceMaxBackoff(v.(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceMaxBackoff = serviceMaxBackoff
+	}
+
 	if v, ok := d.Get("s3_us_east_1_regional_endpoint").(string); ok && v != "" {
 		config.S3USEast1RegionalEndpoint = conns.NormalizeS3USEast1RegionalEndpoint(v)
 	}
@@ -565,6 +611,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
+	if keys, unknown := defaultTagsUnknownValueKeys(d.GetRawConfig()); unknown || len(keys) > 0 {
+		if config.DefaultTagsConfig == nil {
+			config.DefaultTagsConfig = &tftags.DefaultConfig{}
+		}
+		config.DefaultTagsConfig.UnknownTags = unknown
+		config.DefaultTagsConfig.UnknownValueKeys = keys
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -894,6 +948,80 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return nil
 }
 
+// defaultTagsUnknownValueKeys returns the keys of "default_tags" whose values are unknown in the raw provider configuration,
+// and whether the default tags as a whole (including their keys) are unknown.
+// This is the case during planning when a value is derived from a resource that has not yet been created.
+func defaultTagsUnknownValueKeys(rawConfig cty.Value) ([]string, bool) {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return nil, false
+	}
+
+	defaultTags := rawConfig.GetAttr("default_tags")
+	if !defaultTags.IsKnown() {
+		return nil, true
+	}
+	if defaultTags.IsNull() || defaultTags.LengthInt() == 0 {
+		return nil, false
+	}
+
+	var keys []string
+
+	for _, v := range defaultTags.AsValueSlice() {
+		if !v.IsKnown() {
+			return nil, true
+		}
+		if v.IsNull() {
+			continue
+		}
+
+		tags := v.GetAttr("tags")
+		if !tags.IsKnown() {
+			return nil, true
+		}
+		if tags.IsNull() {
+			continue
+		}
+
+		for k, v := range tags.AsValueMap() {
+			if !v.IsWhollyKnown() {
+				keys = append(keys, k)
+			}
+		}
+	}
+
+	slices.Sort(keys)
+
+	return keys, false
+}
+
+// expandServiceMaxBackoff returns the "service_max_backoff" durations keyed by service package name.
+func expandServiceMaxBackoff(tfMap map[string]interface{}) (map[string]time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceMaxBackoff := make(map[string]time.Duration, len(tfMap))
+	for k, v := range tfMap {
+		attrPath := cty.GetAttrPath("service_max_backoff").IndexString(k)
+
+		pkg, err := names.ProviderPackageForAlias(k)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(attrPath, "Invalid Attribute Value", err.Error()))
+			continue
+		}
+
+		_, es := validMaxBackoff(v, errs.PathString(attrPath))
+		for _, err := range es {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(attrPath, "Invalid Attribute Value", err.Error()))
+		}
+		if len(es) > 0 {
+			continue
+		}
+
+		serviceMaxBackoff[pkg], _ = time.ParseDuration(v.(string))
+	}
+
+	return serviceMaxBackoff, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []interface{}
 
@@ -5,9 +5,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"maps"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -615,3 +617,248 @@ func TestProviderConfig_AssumeRole(t *testing.T) { //nolint:paralleltest
 		})
 	}
 }
+
+func TestProviderConfig_AssumeRoleChainFailure(t *testing.T) { //nolint:paralleltest
+	testCases := map[string]struct {
+		AssumeRole       []any
+		ExpectedSummary  string
+		MockStsEndpoints []*servicemocks.MockEndpoint
+	}{
+		"single": {
+			AssumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			ExpectedSummary: "Cannot assume IAM Role",
+		},
+
+		"multiple first": {
+			AssumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn2,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName2,
+				},
+			},
+			ExpectedSummary: "Cannot assume IAM Role (assume_role 1 of 2)",
+		},
+
+		"multiple last": {
+			AssumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn2,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName2,
+				},
+			},
+			ExpectedSummary: "Cannot assume IAM Role (assume_role 2 of 2)",
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+		},
+
+		"repeated role": {
+			AssumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn2,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName2,
+				},
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName2,
+				},
+			},
+			ExpectedSummary: "Cannot assume IAM Role (assume_role 3 of 3)",
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"RoleArn":         servicemocks.MockStsAssumeRoleArn2,
+					"RoleSessionName": servicemocks.MockStsAssumeRoleSessionName2,
+				}),
+			},
+		},
+	}
+
+	for name, tc := range testCases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			servicemocks.InitSessionTestEnv(t)
+
+			closeSts, _, stsEndpoint := mockdata.GetMockedAwsApiSession("STS", tc.MockStsEndpoints)
+			defer closeSts()
+
+			config := map[string]any{
+				"region":                      "us-west-2", //lintignore:AWSAT003
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+				"assume_role":                 tc.AssumeRole,
+				"endpoints": []any{
+					map[string]any{
+						"sts": stsEndpoint,
+					},
+				},
+			}
+
+			rc := terraformsdk.NewResourceConfigRaw(config)
+
+			p, err := New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Validate(rc)
+			if diags.HasError() {
+				t.Fatalf("validating: %s", sdkdiag.DiagnosticsString(diags))
+			}
+
+			diags = p.Configure(ctx, rc)
+			if !diags.HasError() {
+				t.Fatal("expected error, got none")
+			}
+
+			var summaries []string
+			for _, d := range diags {
+				if d.Severity == diag.Error {
+					summaries = append(summaries, d.Summary)
+				}
+			}
+
+			if diff := cmp.Diff(summaries, []string{tc.ExpectedSummary}); diff != "" {
+				t.Errorf("unexpected error summaries difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderConfig_MaxBackoff(t *testing.T) { //nolint:paralleltest
+	testCases := map[string]struct {
+		Config                          map[string]any
+		ExpectedValidateError           bool
+		ExpectedConfigureError          bool
+		ExpectedMaxBackoff              time.Duration
+		ExpectedOrganizationsMaxBackoff time.Duration
+	}{
+		"default": {
+			ExpectedMaxBackoff:              300 * time.Second,
+			ExpectedOrganizationsMaxBackoff: 300 * time.Second,
+		},
+
+		"max_backoff": {
+			Config: map[string]any{
+				"max_backoff": "5s",
+			},
+			ExpectedMaxBackoff:              5 * time.Second,
+			ExpectedOrganizationsMaxBackoff: 5 * time.Second,
+		},
+
+		"max_backoff too short": {
+			Config: map[string]any{
+				"max_backoff": "0s",
+			},
+			ExpectedValidateError: true,
+		},
+
+		"service_max_backoff": {
+			Config: map[string]any{
+				"max_backoff": "5s",
+				"service_max_backoff": map[string]any{
+					"organizations": "2s",
+				},
+			},
+			ExpectedMaxBackoff:              5 * time.Second,
+			ExpectedOrganizationsMaxBackoff: 2 * time.Second,
+		},
+
+		"service_max_backoff invalid service": {
+			Config: map[string]any{
+				"service_max_backoff": map[string]any{
+					"notaservice": "2s",
+				},
+			},
+			ExpectedConfigureError: true,
+		},
+
+		"service_max_backoff too short": {
+			Config: map[string]any{
+				"service_max_backoff": map[string]any{
+					"organizations": "0s",
+				},
+			},
+			ExpectedConfigureError: true,
+		},
+	}
+
+	for name, tc := range testCases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			servicemocks.InitSessionTestEnv(t)
+
+			config := map[string]any{
+				"region":                      "us-west-2", //lintignore:AWSAT003
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+			}
+
+			maps.Copy(config, tc.Config)
+
+			rc := terraformsdk.NewResourceConfigRaw(config)
+
+			p, err := New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Validate(rc)
+			if got, want := diags.HasError(), tc.ExpectedValidateError; got != want {
+				t.Fatalf("validating: got error %t, want %t: %s", got, want, sdkdiag.DiagnosticsString(diags))
+			}
+			if diags.HasError() {
+				return
+			}
+
+			diags = p.Configure(ctx, rc)
+			if got, want := diags.HasError(), tc.ExpectedConfigureError; got != want {
+				t.Fatalf("configuring: got error %t, want %t: %s", got, want, sdkdiag.DiagnosticsString(diags))
+			}
+			if diags.HasError() {
+				return
+			}
+
+			meta := p.Meta().(*conns.AWSClient)
+			err = errors.New("testing")
+
+			for attempt := 1; attempt <= 30; attempt++ {
+				if delay, _ := meta.AwsConfig(ctx).Retryer().RetryDelay(attempt, err); delay > tc.ExpectedMaxBackoff {
+					t.Errorf("RetryDelay(%d) = %s, want at most %s", attempt, delay, tc.ExpectedMaxBackoff)
+				}
+
+				if delay, _ := meta.OrganizationsClient(ctx).Options().Retryer.RetryDelay(attempt, err); delay > tc.ExpectedOrganizationsMaxBackoff {
+					t.Errorf("Organizations RetryDelay(%d) = %s, want at most %s", attempt, delay, tc.ExpectedOrganizationsMaxBackoff)
+				}
+			}
+
+			// The largest delays are at least half of the maximum backoff.
+			if delay, _ := meta.OrganizationsClient(ctx).Options().Retryer.RetryDelay(30, err); delay < tc.ExpectedOrganizationsMaxBackoff/2 {
+				t.Errorf("Organizations RetryDelay(30) = %s, want at least %s", delay, tc.ExpectedOrganizationsMaxBackoff/2)
+			}
+		})
+	}
+}
@@ -73,3 +73,51 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidMaxBackoff(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val:         "",
+			expectedErr: regexache.MustCompile(`cannot be parsed as a duration`),
+		},
+		{
+			val:         "30",
+			expectedErr: regexache.MustCompile(`cannot be parsed as a duration`),
+		},
+		{
+			val:         "0s",
+			expectedErr: regexache.MustCompile(`must be at least 1 second \(1s\)`),
+		},
+		{
+			val:         "500ms",
+			expectedErr: regexache.MustCompile(`must be at least 1 second \(1s\)`),
+		},
+		{
+			val: "1s",
+		},
+		{
+			val: "5m",
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validMaxBackoff(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
@@ -17,9 +17,11 @@ func TestAccAccount_serial(t *testing.T) {
 			acctest.CtBasic:      testAccAlternateContact_basic,
 			acctest.CtDisappears: testAccAlternateContact_disappears,
 			"AccountID":          testAccAlternateContact_accountID,
+			"MultipleTypes":      testAccAlternateContact_multipleTypes,
 		},
 		"PrimaryContact": {
-			acctest.CtBasic: testAccPrimaryContact_basic,
+			acctest.CtBasic:         testAccPrimaryContact_basic,
+			"PhoneNumberFormatting": testAccPrimaryContact_phoneNumberFormatting,
 		},
 		"Region": {
 			acctest.CtBasic: testAccRegion_basic,
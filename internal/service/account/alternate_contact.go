@@ -70,9 +70,10 @@ func resourceAlternateContact() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
 			"phone_number": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9\s()+-]+$`), "must be a valid phone number"),
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringMatch(regexache.MustCompile(`^[0-9\s()+-]+$`), "must be a valid phone number"),
+				DiffSuppressFunc: suppressEquivalentPhoneNumbers,
 			},
 			"title": {
 				Type:         schema.TypeString,
@@ -199,7 +200,7 @@ func resourceAlternateContactUpdate(ctx context.Context, d *schema.ResourceData,
 			return false, err
 		}
 
-		equal := email == aws.ToString(v.EmailAddress) && name == aws.ToString(v.Name) && phone == aws.ToString(v.PhoneNumber) && title == aws.ToString(v.Title)
+		equal := email == aws.ToString(v.EmailAddress) && name == aws.ToString(v.Name) && normalizePhoneNumber(phone) == normalizePhoneNumber(aws.ToString(v.PhoneNumber)) && title == aws.ToString(v.Title)
 
 		return !equal, nil
 	}).Run(ctx, d.Timeout(schema.TimeoutUpdate))
@@ -67,6 +67,48 @@ func testAccAlternateContact_basic(t *testing.T) {
 	})
 }
 
+func testAccAlternateContact_multipleTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	billingResourceName := "aws_account_alternate_contact.billing"
+	operationsResourceName := "aws_account_alternate_contact.operations"
+	domain := acctest.RandomDomainName()
+	emailAddress := acctest.RandomEmailAddress(domain)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccountServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlternateContactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlternateContactConfig_multipleTypes(rName, emailAddress),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlternateContactExists(ctx, billingResourceName),
+					resource.TestCheckResourceAttr(billingResourceName, "alternate_contact_type", "BILLING"),
+					resource.TestCheckResourceAttr(billingResourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(billingResourceName, "phone_number", "+1 7031235555"),
+					testAccCheckAlternateContactExists(ctx, operationsResourceName),
+					resource.TestCheckResourceAttr(operationsResourceName, "alternate_contact_type", "OPERATIONS"),
+					resource.TestCheckResourceAttr(operationsResourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(operationsResourceName, "phone_number", "+17031235555"),
+				),
+			},
+			{
+				ResourceName:            billingResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"phone_number"},
+			},
+			{
+				ResourceName:      operationsResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccAlternateContact_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_account_alternate_contact.test"
@@ -215,6 +257,28 @@ resource "aws_account_alternate_contact" "test" {
 `, rName, emailAddress)
 }
 
+func testAccAlternateContactConfig_multipleTypes(rName, emailAddress string) string {
+	return fmt.Sprintf(`
+resource "aws_account_alternate_contact" "billing" {
+  alternate_contact_type = "BILLING"
+
+  email_address = %[2]q
+  name          = %[1]q
+  phone_number  = "+1 7031235555"
+  title         = %[1]q
+}
+
+resource "aws_account_alternate_contact" "operations" {
+  alternate_contact_type = "OPERATIONS"
+
+  email_address = %[2]q
+  name          = %[1]q
+  phone_number  = "+17031235555"
+  title         = %[1]q
+}
+`, rName, emailAddress)
+}
+
 func testAccAlternateContactConfig_organization(rName, emailAddress string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
 data "aws_caller_identity" "test" {
@@ -6,6 +6,7 @@ package account
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -76,9 +77,10 @@ func resourcePrimaryContact() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
 			"phone_number": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[+][0-9\s()-]+$`), "must be a valid phone number"),
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringMatch(regexache.MustCompile(`^[+][0-9\s()-]+$`), "must be a valid phone number"),
+				DiffSuppressFunc: suppressEquivalentPhoneNumbers,
 			},
 			"postal_code": {
 				Type:     schema.TypeString,
@@ -215,3 +217,19 @@ func findContactInformation(ctx context.Context, conn *account.Client, accountID
 
 	return output.ContactInformation, nil
 }
+
+// normalizePhoneNumber strips the formatting characters that the Account API
+// may add to or remove from a phone number, e.g. "+1 5551234567" vs "+15551234567".
+func normalizePhoneNumber(v string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '(', ')', '-':
+			return -1
+		}
+		return r
+	}, v)
+}
+
+func suppressEquivalentPhoneNumbers(k, old, new string, d *schema.ResourceData) bool {
+	return normalizePhoneNumber(old) == normalizePhoneNumber(new)
+}
@@ -72,6 +72,35 @@ func testAccPrimaryContact_basic(t *testing.T) {
 	})
 }
 
+func testAccPrimaryContact_phoneNumberFormatting(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_account_primary_contact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccountServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrimaryConfig_phoneNumber(rName, "+64211111111"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrimaryContactExists(ctx, resourceName),
+				),
+			},
+			{
+				Config:   testAccPrimaryConfig_phoneNumber(rName, "+64 (21) 111-1111"),
+				PlanOnly: true,
+			},
+			{
+				Config:   testAccPrimaryConfig_phoneNumber(rName, "+64 211111111"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckPrimaryContactExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -92,6 +121,10 @@ func testAccCheckPrimaryContactExists(ctx context.Context, n string) resource.Te
 }
 
 func testAccPrimaryConfig_basic(name string) string {
+	return testAccPrimaryConfig_phoneNumber(name, "+64211111111")
+}
+
+func testAccPrimaryConfig_phoneNumber(name, phoneNumber string) string {
 	return fmt.Sprintf(`
 resource "aws_account_primary_contact" "test" {
   address_line_1     = "123 Any Street"
@@ -100,10 +133,10 @@ resource "aws_account_primary_contact" "test" {
   country_code       = "US"
   district_or_county = "King"
   full_name          = %[1]q
-  phone_number       = "+64211111111"
+  phone_number       = %[2]q
   postal_code        = "98101"
   state_or_region    = "WA"
   website_url        = "https://www.examplecorp.com"
 }
-`, name)
+`, name, phoneNumber)
 }
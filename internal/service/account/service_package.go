@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// maxConcurrentRequests bounds the number of in-flight Account API requests per API client.
+// Managing contacts for many organization member accounts otherwise fans out into
+// hundreds of concurrent calls against a low, account-wide request rate quota.
+const maxConcurrentRequests = 10
+
+func (p *servicePackage) withExtraOptions(_ context.Context, config map[string]any) []func(*account.Options) {
+	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
+	// The default API client is cached per provider instance, so each provider configuration gets its own limit.
+	semaphore := make(chan struct{}, maxConcurrentRequests)
+
+	return []func(*account.Options){
+		func(o *account.Options) {
+			o.Retryer = conns.AddIsErrorRetryables(cfg.Retryer().(aws.RetryerV2), retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
+				if errs.IsA[*awstypes.TooManyRequestsException](err) {
+					return aws.TrueTernary
+				}
+				return aws.UnknownTernary // Delegate to configured Retryer.
+			}))
+			o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+				// Acquire a slot for each attempt, after the retry middleware, so that no slot is held while backing off.
+				return stack.Finalize.Insert(concurrencyLimitMiddleware(semaphore), (&retry.Attempt{}).ID(), middleware.After)
+			})
+		},
+	}
+}
+
+func concurrencyLimitMiddleware(semaphore chan struct{}) middleware.FinalizeMiddleware {
+	return middleware.FinalizeMiddlewareFunc("ConcurrencyLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, ctx.Err()
+		}
+		defer func() { <-semaphore }()
+
+		return next.HandleFinalize(ctx, in)
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_account_alternate_contact", sweepAlternateContacts)
+}
+
+func sweepAlternateContacts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.AccountClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+	r := resourceAlternateContact()
+
+	// Only the calling account's contacts are swept and only those created by acceptance tests.
+	for _, contactType := range enum.Values[types.AlternateContactType]() {
+		contact, err := findAlternateContactByTwoPartKey(ctx, conn, "", contactType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.HasPrefix(aws.ToString(contact.Name), sweep.ResourcePrefix) {
+			continue
+		}
+
+		d := r.Data(nil)
+		d.SetId(alternateContactCreateResourceID("", contactType))
+
+		sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+	}
+
+	return sweepResources, nil
+}
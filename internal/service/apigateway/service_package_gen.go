@@ -220,6 +220,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_api_gateway_usage_plan_key",
 			Name:     "Usage Plan Key",
 		},
+		{
+			Factory:  resourceUsagePlanKeys,
+			TypeName: "aws_api_gateway_usage_plan_keys",
+			Name:     "Usage Plan Keys",
+		},
 		{
 			Factory:  resourceVPCLink,
 			TypeName: "aws_api_gateway_vpc_link",
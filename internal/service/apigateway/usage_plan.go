@@ -4,10 +4,12 @@
 package apigateway
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -17,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -57,6 +60,7 @@ func resourceUsagePlan() *schema.Resource {
 						"throttle": {
 							Type:     schema.TypeSet,
 							Optional: true,
+							Set:      usagePlanThrottleHash,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"burst_limit": {
@@ -332,12 +336,12 @@ func resourceUsagePlanUpdate(ctx context.Context, d *schema.ResourceData, meta i
 							th := throttle.(map[string]interface{})
 							operations = append(operations, types.PatchOperation{
 								Op:    types.OpReplace,
-								Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/rateLimit", id, th[names.AttrPath].(string))),
+								Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/rateLimit", id, normalizeThrottlePath(th[names.AttrPath].(string)))),
 								Value: aws.String(strconv.FormatFloat(th["rate_limit"].(float64), 'f', -1, 64)),
 							})
 							operations = append(operations, types.PatchOperation{
 								Op:    types.OpReplace,
-								Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/burstLimit", id, th[names.AttrPath].(string))),
+								Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/burstLimit", id, normalizeThrottlePath(th[names.AttrPath].(string)))),
 								Value: aws.String(strconv.Itoa(th["burst_limit"].(int))),
 							})
 						}
@@ -679,13 +683,44 @@ func expandThrottleSettingsList(tfList []interface{}) map[string]types.ThrottleS
 		}
 
 		if v, ok := tfMap[names.AttrPath].(string); ok && v != "" {
-			apiObjects[v] = apiObject
+			apiObjects[normalizeThrottlePath(v)] = apiObject
 		}
 	}
 
 	return apiObjects
 }
 
+// usagePlanThrottleHash hashes a method throttle on its normalized method path and limits,
+// so that the HTTP method's case and the order in which throttles are returned don't cause diffs.
+func usagePlanThrottleHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m[names.AttrPath].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", normalizeThrottlePath(v)))
+	}
+	switch v := m["burst_limit"].(type) {
+	case int:
+		buf.WriteString(fmt.Sprintf("%d-", v))
+	case int32:
+		buf.WriteString(fmt.Sprintf("%d-", v))
+	}
+	if v, ok := m["rate_limit"].(float64); ok {
+		buf.WriteString(fmt.Sprintf("%s-", strconv.FormatFloat(v, 'f', -1, 64)))
+	}
+
+	return create.StringHashcode(buf.String())
+}
+
+// normalizeThrottlePath upper-cases the HTTP method in a "/resource/path/METHOD" throttle path.
+func normalizeThrottlePath(path string) string {
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		return path[:i+1] + strings.ToUpper(path[i+1:])
+	}
+
+	return path
+}
+
 func flattenThrottleSettingsMap(apiObjects map[string]types.ThrottleSettings) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
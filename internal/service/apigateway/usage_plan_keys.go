@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_api_gateway_usage_plan_keys", name="Usage Plan Keys")
+func resourceUsagePlanKeys() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsagePlanKeysCreate,
+		ReadWithoutTimeout:   resourceUsagePlanKeysRead,
+		UpdateWithoutTimeout: resourceUsagePlanKeysUpdate,
+		DeleteWithoutTimeout: resourceUsagePlanKeysDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("usage_plan_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "API_KEY",
+				ValidateFunc: validation.StringInSlice([]string{"API_KEY"}, false),
+			},
+			"usage_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUsagePlanKeysCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	usagePlanID := d.Get("usage_plan_id").(string)
+	keyType := d.Get("key_type").(string)
+
+	for _, keyID := range flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set)) {
+		if err := createUsagePlanKey(ctx, conn, usagePlanID, keyID, keyType); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating API Gateway Usage Plan (%s) Key (%s): %s", usagePlanID, keyID, err)
+		}
+	}
+
+	d.SetId(usagePlanID)
+
+	return append(diags, resourceUsagePlanKeysRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	keys, err := findUsagePlanKeysByUsagePlanID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway Usage Plan Keys (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway Usage Plan Keys (%s): %s", d.Id(), err)
+	}
+
+	keyType := d.Get("key_type").(string)
+	if keyType == "" {
+		keyType = "API_KEY"
+	}
+	var keyIDs []string
+	for _, key := range keys {
+		if aws.ToString(key.Type) != keyType {
+			continue
+		}
+
+		keyIDs = append(keyIDs, aws.ToString(key.Id))
+	}
+
+	d.Set("key_ids", keyIDs)
+	d.Set("key_type", keyType)
+	d.Set("usage_plan_id", d.Id())
+
+	return diags
+}
+
+func resourceUsagePlanKeysUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	if d.HasChange("key_ids") {
+		o, n := d.GetChange("key_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+		keyType := d.Get("key_type").(string)
+
+		for _, keyID := range del {
+			if err := deleteUsagePlanKey(ctx, conn, d.Id(), keyID); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting API Gateway Usage Plan (%s) Key (%s): %s", d.Id(), keyID, err)
+			}
+		}
+
+		for _, keyID := range add {
+			if err := createUsagePlanKey(ctx, conn, d.Id(), keyID, keyType); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating API Gateway Usage Plan (%s) Key (%s): %s", d.Id(), keyID, err)
+			}
+		}
+	}
+
+	return append(diags, resourceUsagePlanKeysRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	log.Printf("[DEBUG] Deleting API Gateway Usage Plan Keys: %s", d.Id())
+	for _, keyID := range flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set)) {
+		if err := deleteUsagePlanKey(ctx, conn, d.Id(), keyID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting API Gateway Usage Plan (%s) Key (%s): %s", d.Id(), keyID, err)
+		}
+	}
+
+	return diags
+}
+
+func createUsagePlanKey(ctx context.Context, conn *apigateway.Client, usagePlanID, keyID, keyType string) error {
+	input := apigateway.CreateUsagePlanKeyInput{
+		KeyId:       aws.String(keyID),
+		KeyType:     aws.String(keyType),
+		UsagePlanId: aws.String(usagePlanID),
+	}
+
+	_, err := conn.CreateUsagePlanKey(ctx, &input)
+
+	return err
+}
+
+func deleteUsagePlanKey(ctx context.Context, conn *apigateway.Client, usagePlanID, keyID string) error {
+	input := apigateway.DeleteUsagePlanKeyInput{
+		KeyId:       aws.String(keyID),
+		UsagePlanId: aws.String(usagePlanID),
+	}
+
+	_, err := conn.DeleteUsagePlanKey(ctx, &input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findUsagePlanKeysByUsagePlanID(ctx context.Context, conn *apigateway.Client, usagePlanID string) ([]types.UsagePlanKey, error) {
+	input := apigateway.GetUsagePlanKeysInput{
+		Limit:       aws.Int32(500),
+		UsagePlanId: aws.String(usagePlanID),
+	}
+	var output []types.UsagePlanKey
+
+	pages := apigateway.NewGetUsagePlanKeysPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
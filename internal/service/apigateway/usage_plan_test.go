@@ -485,6 +485,30 @@ func TestAccAPIGatewayUsagePlan_APIStages_throttle(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayUsagePlan_APIStages_throttleMethodCase(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.GetUsagePlanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanConfig_apiStagesThrottleMethodCase(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "api_stages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "api_stages.0.throttle.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayUsagePlan_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf apigateway.GetUsagePlanOutput
@@ -741,6 +765,25 @@ resource "aws_api_gateway_usage_plan" "test" {
 `, rName))
 }
 
+func testAccUsagePlanConfig_apiStagesThrottleMethodCase(rName string) string {
+	return acctest.ConfigCompose(testAccUsagePlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_api_gateway_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+
+    throttle {
+      path        = "${aws_api_gateway_resource.test.path}/${lower(aws_api_gateway_method.test.http_method)}"
+      burst_limit = 3
+      rate_limit  = 6.5
+    }
+  }
+}
+`, rName))
+}
+
 func testAccUsagePlanConfig_apiStagesThrottleMulti(rName string) string {
 	return acctest.ConfigCompose(testAccUsagePlanConfig_base(rName), fmt.Sprintf(`
 resource "aws_api_gateway_usage_plan" "test" {
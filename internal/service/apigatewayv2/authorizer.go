@@ -82,7 +82,10 @@ func resourceAuthorizer() *schema.Resource {
 			"identity_sources": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validIdentitySource(),
+				},
 			},
 			"jwt_configuration": {
 				Type:     schema.TypeList,
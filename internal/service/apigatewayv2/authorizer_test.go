@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -222,6 +224,53 @@ func TestAccAPIGatewayV2Authorizer_jwt(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 				),
 			},
+			// Rotate the audience together with the name twice in a row; the authorizer must be updated in place.
+			{
+				Config: testAccAuthorizerConfig_jwtRotated(rName, rName+"-rotated", "rotated1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthorizerExists(ctx, resourceName, &apiId, &v),
+					resource.TestCheckResourceAttr(resourceName, "jwt_configuration.0.audience.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "jwt_configuration.0.audience.*", "rotated1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-rotated"),
+				),
+			},
+			{
+				Config: testAccAuthorizerConfig_jwtRotated(rName, rName, "rotated2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthorizerExists(ctx, resourceName, &apiId, &v),
+					resource.TestCheckResourceAttr(resourceName, "jwt_configuration.0.audience.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "jwt_configuration.0.audience.*", "rotated2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayV2Authorizer_invalidIdentitySources(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAuthorizerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAuthorizerConfig_identitySources(rName, "request.header.Authorization"),
+				ExpectError: regexache.MustCompile(`must be a selection expression`),
+			},
 		},
 	})
 }
@@ -558,6 +607,47 @@ resource "aws_apigatewayv2_authorizer" "test" {
 `, rName))
 }
 
+func testAccAuthorizerConfig_jwtRotated(rName, name, audience string) string {
+	return acctest.ConfigCompose(
+		testAccAuthorizerConfig_apiHTTP(rName),
+		testAccAuthorizerConfig_baseLambda(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_apigatewayv2_authorizer" "test" {
+  api_id           = aws_apigatewayv2_api.test.id
+  authorizer_type  = "JWT"
+  identity_sources = ["$request.header.Authorization"]
+  name             = %[2]q
+
+  jwt_configuration {
+    audience = [%[3]q]
+    issuer   = "https://${aws_cognito_user_pool.test.endpoint}"
+  }
+}
+`, rName, name, audience))
+}
+
+func testAccAuthorizerConfig_identitySources(rName, identitySource string) string {
+	return acctest.ConfigCompose(
+		testAccAuthorizerConfig_apiHTTP(rName),
+		fmt.Sprintf(`
+resource "aws_apigatewayv2_authorizer" "test" {
+  api_id           = aws_apigatewayv2_api.test.id
+  authorizer_type  = "JWT"
+  identity_sources = [%[2]q]
+  name             = %[1]q
+
+  jwt_configuration {
+    audience = ["test"]
+    issuer   = "https://example.com"
+  }
+}
+`, rName, identitySource))
+}
+
 func testAccAuthorizerConfig_httpAPILambdaRequest(rName string) string {
 	return acctest.ConfigCompose(
 		testAccAuthorizerConfig_apiHTTP(rName),
@@ -4,6 +4,7 @@
 package apigatewayv2
 
 import (
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -20,3 +21,12 @@ func validHTTPMethod() schema.SchemaValidateFunc {
 		"PUT",
 	}, false)
 }
+
+// validIdentitySource validates an authorizer identity source selection expression.
+// HTTP APIs use "$request.header.X"-style expressions, WebSocket APIs "route.request.header.X"-style ones.
+func validIdentitySource() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexache.MustCompile(`^((\$request|route\.request)\.(header|querystring)|\$?(context|stageVariables))\.[^\s.]\S*$`),
+		"must be a selection expression such as $request.header.Authorization, $request.querystring.name, $context.identity.sourceIp, $stageVariables.name or route.request.header.Auth",
+	)
+}
@@ -58,9 +58,64 @@ func resourcePolicy() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 255),
 			},
 			"policy_type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "StepScaling",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.PolicyTypeStepScaling,
+				ValidateDiagFunc: enum.Validate[awstypes.PolicyType](),
+			},
+			"predictive_scaling_policy_configuration": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{"step_scaling_policy_configuration", "target_tracking_scaling_policy_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity_breach_behavior": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PredictiveScalingMaxCapacityBreachBehavior](),
+						},
+						"max_capacity_buffer": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"metric_specification": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"customized_capacity_metric_specification": predictiveScalingCustomizedMetricSpecificationSchema(),
+									"customized_load_metric_specification":     predictiveScalingCustomizedMetricSpecificationSchema(),
+									"customized_scaling_metric_specification":  predictiveScalingCustomizedMetricSpecificationSchema(),
+									"predefined_load_metric_specification":     predictiveScalingPredefinedMetricSpecificationSchema(),
+									"predefined_metric_pair_specification":     predictiveScalingPredefinedMetricSpecificationSchema(),
+									"predefined_scaling_metric_specification":  predictiveScalingPredefinedMetricSpecificationSchema(),
+									"target_value": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+								},
+							},
+						},
+						names.AttrMode: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PredictiveScalingMode](),
+						},
+						"scheduling_buffer_time": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 3599),
+						},
+					},
+				},
 			},
 			names.AttrResourceID: {
 				Type:     schema.TypeString,
@@ -300,6 +355,119 @@ func resourcePolicy() *schema.Resource {
 	}
 }
 
+func predictiveScalingCustomizedMetricSpecificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metric_data_query": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrExpression: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 2048),
+							},
+							names.AttrID: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+							"label": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 2047),
+							},
+							"metric_stat": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"metric": {
+											Type:     schema.TypeList,
+											Required: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"dimension": {
+														Type:     schema.TypeSet,
+														Optional: true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																names.AttrName: {
+																	Type:     schema.TypeString,
+																	Required: true,
+																},
+																names.AttrValue: {
+																	Type:     schema.TypeString,
+																	Required: true,
+																},
+															},
+														},
+													},
+													names.AttrMetricName: {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													names.AttrNamespace: {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+										"stat": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 100),
+										},
+										names.AttrUnit: {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"return_data": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func predictiveScalingPredefinedMetricSpecificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"predefined_metric_type": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"resource_label": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1023),
+				},
+			},
+		},
+	}
+}
+
 func resourcePolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
@@ -347,6 +515,9 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrARN, output.PolicyARN)
 	d.Set(names.AttrName, output.PolicyName)
 	d.Set("policy_type", output.PolicyType)
+	if err := d.Set("predictive_scaling_policy_configuration", flattenPredictiveScalingPolicyConfiguration(output.PredictiveScalingPolicyConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting predictive_scaling_policy_configuration: %s", err)
+	}
 	d.Set(names.AttrResourceID, output.ResourceId)
 	d.Set("scalable_dimension", output.ScalableDimension)
 	d.Set("service_namespace", output.ServiceNamespace)
@@ -662,6 +833,10 @@ func expandPutScalingPolicyInput(d *schema.ResourceData) applicationautoscaling.
 		apiObject.PolicyType = awstypes.PolicyType(v.(string))
 	}
 
+	if v, ok := d.GetOk("predictive_scaling_policy_configuration"); ok {
+		apiObject.PredictiveScalingPolicyConfiguration = expandPredictiveScalingPolicyConfiguration(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("scalable_dimension"); ok {
 		apiObject.ScalableDimension = awstypes.ScalableDimension(v.(string))
 	}
@@ -946,3 +1121,292 @@ func flattenPredefinedMetricSpecification(cfg *awstypes.PredefinedMetricSpecific
 
 	return []interface{}{m}
 }
+
+func expandPredictiveScalingPolicyConfiguration(tfList []interface{}) *awstypes.PredictiveScalingPolicyConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.PredictiveScalingPolicyConfiguration{}
+
+	if v, ok := tfMap["max_capacity_breach_behavior"].(string); ok && v != "" {
+		apiObject.MaxCapacityBreachBehavior = awstypes.PredictiveScalingMaxCapacityBreachBehavior(v)
+	}
+
+	if v, ok := tfMap["max_capacity_buffer"].(int); ok && v != 0 {
+		apiObject.MaxCapacityBuffer = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["metric_specification"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MetricSpecifications = expandPredictiveScalingMetricSpecifications(v)
+	}
+
+	if v, ok := tfMap[names.AttrMode].(string); ok && v != "" {
+		apiObject.Mode = awstypes.PredictiveScalingMode(v)
+	}
+
+	if v, ok := tfMap["scheduling_buffer_time"].(int); ok && v != 0 {
+		apiObject.SchedulingBufferTime = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandPredictiveScalingMetricSpecifications(tfList []interface{}) []awstypes.PredictiveScalingMetricSpecification {
+	var apiObjects []awstypes.PredictiveScalingMetricSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.PredictiveScalingMetricSpecification{
+			TargetValue: aws.Float64(tfMap["target_value"].(float64)),
+		}
+
+		if v, ok := tfMap["customized_capacity_metric_specification"].([]interface{}); ok && len(v) > 0 {
+			apiObject.CustomizedCapacityMetricSpecification = expandPredictiveScalingCustomizedMetricSpecification(v)
+		}
+
+		if v, ok := tfMap["customized_load_metric_specification"].([]interface{}); ok && len(v) > 0 {
+			apiObject.CustomizedLoadMetricSpecification = expandPredictiveScalingCustomizedMetricSpecification(v)
+		}
+
+		if v, ok := tfMap["customized_scaling_metric_specification"].([]interface{}); ok && len(v) > 0 {
+			apiObject.CustomizedScalingMetricSpecification = expandPredictiveScalingCustomizedMetricSpecification(v)
+		}
+
+		if v, ok := tfMap["predefined_load_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.PredefinedLoadMetricSpecification = &awstypes.PredictiveScalingPredefinedLoadMetricSpecification{
+				PredefinedMetricType: aws.String(m["predefined_metric_type"].(string)),
+			}
+			if v, ok := m["resource_label"].(string); ok && v != "" {
+				apiObject.PredefinedLoadMetricSpecification.ResourceLabel = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["predefined_metric_pair_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.PredefinedMetricPairSpecification = &awstypes.PredictiveScalingPredefinedMetricPairSpecification{
+				PredefinedMetricType: aws.String(m["predefined_metric_type"].(string)),
+			}
+			if v, ok := m["resource_label"].(string); ok && v != "" {
+				apiObject.PredefinedMetricPairSpecification.ResourceLabel = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["predefined_scaling_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.PredefinedScalingMetricSpecification = &awstypes.PredictiveScalingPredefinedScalingMetricSpecification{
+				PredefinedMetricType: aws.String(m["predefined_metric_type"].(string)),
+			}
+			if v, ok := m["resource_label"].(string); ok && v != "" {
+				apiObject.PredefinedScalingMetricSpecification.ResourceLabel = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPredictiveScalingCustomizedMetricSpecification(tfList []interface{}) *awstypes.PredictiveScalingCustomizedMetricSpecification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.PredictiveScalingCustomizedMetricSpecification{}
+
+	if v, ok := tfMap["metric_data_query"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MetricDataQueries = expandPredictiveScalingMetricDataQueries(v)
+	}
+
+	return apiObject
+}
+
+func expandPredictiveScalingMetricDataQueries(tfList []interface{}) []awstypes.PredictiveScalingMetricDataQuery {
+	var apiObjects []awstypes.PredictiveScalingMetricDataQuery
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.PredictiveScalingMetricDataQuery{
+			Id: aws.String(tfMap[names.AttrID].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrExpression].(string); ok && v != "" {
+			apiObject.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			apiObject.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_stat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.MetricStat = expandPredictiveScalingMetricStat(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["return_data"].(bool); ok {
+			apiObject.ReturnData = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPredictiveScalingMetricStat(tfMap map[string]interface{}) *awstypes.PredictiveScalingMetricStat {
+	apiObject := &awstypes.PredictiveScalingMetricStat{
+		Stat: aws.String(tfMap["stat"].(string)),
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		metric := &awstypes.PredictiveScalingMetric{}
+
+		if v, ok := m["dimension"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+				metric.Dimensions = append(metric.Dimensions, awstypes.PredictiveScalingMetricDimension{
+					Name:  aws.String(tfMap[names.AttrName].(string)),
+					Value: aws.String(tfMap[names.AttrValue].(string)),
+				})
+			}
+		}
+
+		if v, ok := m[names.AttrMetricName].(string); ok && v != "" {
+			metric.MetricName = aws.String(v)
+		}
+
+		if v, ok := m[names.AttrNamespace].(string); ok && v != "" {
+			metric.Namespace = aws.String(v)
+		}
+
+		apiObject.Metric = metric
+	}
+
+	if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPredictiveScalingPolicyConfiguration(apiObject *awstypes.PredictiveScalingPolicyConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"max_capacity_breach_behavior": string(apiObject.MaxCapacityBreachBehavior),
+		"max_capacity_buffer":          aws.ToInt32(apiObject.MaxCapacityBuffer),
+		"metric_specification":         flattenPredictiveScalingMetricSpecifications(apiObject.MetricSpecifications),
+		names.AttrMode:                 string(apiObject.Mode),
+		"scheduling_buffer_time":       aws.ToInt32(apiObject.SchedulingBufferTime),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPredictiveScalingMetricSpecifications(apiObjects []awstypes.PredictiveScalingMetricSpecification) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"customized_capacity_metric_specification": flattenPredictiveScalingCustomizedMetricSpecification(apiObject.CustomizedCapacityMetricSpecification),
+			"customized_load_metric_specification":     flattenPredictiveScalingCustomizedMetricSpecification(apiObject.CustomizedLoadMetricSpecification),
+			"customized_scaling_metric_specification":  flattenPredictiveScalingCustomizedMetricSpecification(apiObject.CustomizedScalingMetricSpecification),
+			"target_value": aws.ToFloat64(apiObject.TargetValue),
+		}
+
+		if v := apiObject.PredefinedLoadMetricSpecification; v != nil {
+			tfMap["predefined_load_metric_specification"] = flattenPredictiveScalingPredefinedMetricSpecification(v.PredefinedMetricType, v.ResourceLabel)
+		}
+
+		if v := apiObject.PredefinedMetricPairSpecification; v != nil {
+			tfMap["predefined_metric_pair_specification"] = flattenPredictiveScalingPredefinedMetricSpecification(v.PredefinedMetricType, v.ResourceLabel)
+		}
+
+		if v := apiObject.PredefinedScalingMetricSpecification; v != nil {
+			tfMap["predefined_scaling_metric_specification"] = flattenPredictiveScalingPredefinedMetricSpecification(v.PredefinedMetricType, v.ResourceLabel)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPredictiveScalingPredefinedMetricSpecification(predefinedMetricType, resourceLabel *string) []interface{} {
+	tfMap := map[string]interface{}{
+		"predefined_metric_type": aws.ToString(predefinedMetricType),
+		"resource_label":         aws.ToString(resourceLabel),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPredictiveScalingCustomizedMetricSpecification(apiObject *awstypes.PredictiveScalingCustomizedMetricSpecification) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfList := make([]interface{}, 0, len(apiObject.MetricDataQueries))
+
+	for _, apiObject := range apiObject.MetricDataQueries {
+		// ReturnData defaults to true when not specified.
+		returnData := true
+		if v := apiObject.ReturnData; v != nil {
+			returnData = aws.ToBool(v)
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrExpression: aws.ToString(apiObject.Expression),
+			names.AttrID:         aws.ToString(apiObject.Id),
+			"label":              aws.ToString(apiObject.Label),
+			"return_data":        returnData,
+		}
+
+		if v := apiObject.MetricStat; v != nil {
+			metricStat := map[string]interface{}{
+				"stat":         aws.ToString(v.Stat),
+				names.AttrUnit: aws.ToString(v.Unit),
+			}
+
+			if v := v.Metric; v != nil {
+				metric := map[string]interface{}{
+					names.AttrMetricName: aws.ToString(v.MetricName),
+					names.AttrNamespace:  aws.ToString(v.Namespace),
+				}
+
+				dimensions := make([]interface{}, 0, len(v.Dimensions))
+				for _, v := range v.Dimensions {
+					dimensions = append(dimensions, map[string]interface{}{
+						names.AttrName:  aws.ToString(v.Name),
+						names.AttrValue: aws.ToString(v.Value),
+					})
+				}
+				metric["dimension"] = dimensions
+
+				metricStat["metric"] = []interface{}{metric}
+			}
+
+			tfMap["metric_stat"] = []interface{}{metricStat}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"metric_data_query": tfList,
+	}}
+}
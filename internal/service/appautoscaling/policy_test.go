@@ -500,6 +500,62 @@ func TestAccAppAutoScalingPolicy_TargetTrack_metricMath(t *testing.T) {
 	})
 }
 
+func TestAccAppAutoScalingPolicy_predictiveScaling(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy awstypes.ScalingPolicy
+	resourceName := "aws_appautoscaling_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppAutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_predictiveScalingPredefined(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "PredictiveScaling"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.mode", "ForecastOnly"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.target_value", "40"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.predefined_metric_pair_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.predefined_metric_pair_specification.0.predefined_metric_type", "ECSServiceCPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "step_scaling_policy_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyConfig_predictiveScalingCustomized(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "PredictiveScaling"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.mode", "ForecastAndScale"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.scheduling_buffer_time", "600"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.predefined_metric_pair_specification.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.customized_scaling_metric_specification.0.metric_data_query.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.customized_load_metric_specification.0.metric_data_query.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "predictive_scaling_policy_configuration.0.metric_specification.0.customized_capacity_metric_specification.0.metric_data_query.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccPolicyConfig_targetTrackingMetricMath(rName string) string {
 	return acctest.ConfigCompose(testAccPolicyConfig_basic(rName), fmt.Sprintf(`
 resource "aws_appautoscaling_policy" "metric_math_test" {
@@ -666,6 +722,168 @@ resource "aws_appautoscaling_policy" "test" {
 `, rName)
 }
 
+func testAccPolicyConfig_ecsServiceBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<EOF
+[
+  {
+    "name": "busybox",
+    "image": "busybox:latest",
+    "cpu": 10,
+    "memory": 128,
+    "essential": true
+  }
+]
+EOF
+}
+
+resource "aws_ecs_service" "test" {
+  cluster                            = aws_ecs_cluster.test.id
+  deployment_maximum_percent         = 200
+  deployment_minimum_healthy_percent = 50
+  desired_count                      = 0
+  name                               = %[1]q
+  task_definition                    = aws_ecs_task_definition.test.arn
+}
+
+resource "aws_appautoscaling_target" "test" {
+  max_capacity       = 4
+  min_capacity       = 0
+  resource_id        = "service/${aws_ecs_cluster.test.name}/${aws_ecs_service.test.name}"
+  scalable_dimension = "ecs:service:DesiredCount"
+  service_namespace  = "ecs"
+}
+`, rName)
+}
+
+func testAccPolicyConfig_predictiveScalingPredefined(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_ecsServiceBase(rName), fmt.Sprintf(`
+resource "aws_appautoscaling_policy" "test" {
+  name               = %[1]q
+  policy_type        = "PredictiveScaling"
+  resource_id        = aws_appautoscaling_target.test.resource_id
+  scalable_dimension = aws_appautoscaling_target.test.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.test.service_namespace
+
+  predictive_scaling_policy_configuration {
+    metric_specification {
+      target_value = 40
+
+      predefined_metric_pair_specification {
+        predefined_metric_type = "ECSServiceCPUUtilization"
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPolicyConfig_predictiveScalingCustomized(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_ecsServiceBase(rName), fmt.Sprintf(`
+resource "aws_appautoscaling_policy" "test" {
+  name               = %[1]q
+  policy_type        = "PredictiveScaling"
+  resource_id        = aws_appautoscaling_target.test.resource_id
+  scalable_dimension = aws_appautoscaling_target.test.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.test.service_namespace
+
+  predictive_scaling_policy_configuration {
+    mode                   = "ForecastAndScale"
+    scheduling_buffer_time = 600
+
+    metric_specification {
+      target_value = 40
+
+      customized_scaling_metric_specification {
+        metric_data_query {
+          id = "cpu"
+
+          metric_stat {
+            stat = "Average"
+
+            metric {
+              metric_name = "CPUUtilization"
+              namespace   = "AWS/ECS"
+
+              dimension {
+                name  = "ClusterName"
+                value = aws_ecs_cluster.test.name
+              }
+              dimension {
+                name  = "ServiceName"
+                value = aws_ecs_service.test.name
+              }
+            }
+          }
+        }
+      }
+
+      customized_load_metric_specification {
+        metric_data_query {
+          id = "load"
+
+          metric_stat {
+            stat = "Sum"
+
+            metric {
+              metric_name = "CPUUtilization"
+              namespace   = "AWS/ECS"
+
+              dimension {
+                name  = "ClusterName"
+                value = aws_ecs_cluster.test.name
+              }
+              dimension {
+                name  = "ServiceName"
+                value = aws_ecs_service.test.name
+              }
+            }
+          }
+        }
+      }
+
+      customized_capacity_metric_specification {
+        metric_data_query {
+          id          = "capacity_sum"
+          return_data = false
+
+          metric_stat {
+            stat = "Sum"
+
+            metric {
+              metric_name = "RunningTaskCount"
+              namespace   = "ECS/ContainerInsights"
+
+              dimension {
+                name  = "ClusterName"
+                value = aws_ecs_cluster.test.name
+              }
+              dimension {
+                name  = "ServiceName"
+                value = aws_ecs_service.test.name
+              }
+            }
+          }
+        }
+
+        metric_data_query {
+          id         = "capacity"
+          expression = "capacity_sum / 60"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccPolicyConfig_spotFleetRequest(rName, validUntil string) string {
 	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(), fmt.Sprintf(`
 data "aws_partition" "current" {}
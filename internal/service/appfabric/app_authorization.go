@@ -97,6 +97,9 @@ func (r *appAuthorizationResource) Schema(ctx context.Context, request resource.
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"credential_wo_version": schema.Int64Attribute{
+				Optional: true,
+			},
 			names.AttrID: framework.IDAttribute(),
 			"persona": schema.StringAttribute{
 				Computed: true,
@@ -125,8 +128,17 @@ func (r *appAuthorizationResource) Schema(ctx context.Context, request resource.
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"api_key": schema.StringAttribute{
-										Required:  true,
+										Optional:  true,
 										Sensitive: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 2048),
+											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("api_key_wo")),
+										},
+									},
+									"api_key_wo": schema.StringAttribute{
+										Optional:  true,
+										Sensitive: true,
+										WriteOnly: true,
 										Validators: []validator.String{
 											stringvalidator.LengthBetween(1, 2048),
 										},
@@ -148,8 +160,17 @@ func (r *appAuthorizationResource) Schema(ctx context.Context, request resource.
 										},
 									},
 									names.AttrClientSecret: schema.StringAttribute{
-										Required:  true,
+										Optional:  true,
 										Sensitive: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 2048),
+											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("client_secret_wo")),
+										},
+									},
+									"client_secret_wo": schema.StringAttribute{
+										Optional:  true,
+										Sensitive: true,
+										WriteOnly: true,
 										Validators: []validator.String{
 											stringvalidator.LengthBetween(1, 2048),
 										},
@@ -207,8 +228,15 @@ func (r *appAuthorizationResource) Create(ctx context.Context, request resource.
 		return
 	}
 
+	// Write-only attributes are only available in the configuration.
+	var config appAuthorizationResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	input := &appfabric.CreateAppAuthorizationInput{}
-	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.withCredential(config.Credential), input)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -322,15 +350,22 @@ func (r *appAuthorizationResource) Update(ctx context.Context, request resource.
 	conn := r.Meta().AppFabricClient(ctx)
 
 	// Check if updates are necessary based on the changed attributes
-	if !old.Credential.Equal(new.Credential) || !old.Tenant.Equal(new.Tenant) {
+	if !old.Credential.Equal(new.Credential) || !old.CredentialWOVersion.Equal(new.CredentialWOVersion) || !old.Tenant.Equal(new.Tenant) {
 		var credentialsData []credentialModel
 		response.Diagnostics.Append(new.Credential.ElementsAs(ctx, &credentialsData, false)...)
 		if response.Diagnostics.HasError() {
 			return
 		}
 
+		// Write-only attributes are only available in the configuration.
+		var config appAuthorizationResourceModel
+		response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
 		input := &appfabric.UpdateAppAuthorizationInput{}
-		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.withCredential(config.Credential), input)...)
 		if response.Diagnostics.HasError() {
 			return
 		}
@@ -507,6 +542,7 @@ type appAuthorizationResourceModel struct {
 	AuthURL             types.String                                     `tfsdk:"auth_url"`
 	CreatedAt           timetypes.RFC3339                                `tfsdk:"created_at"`
 	Credential          fwtypes.ListNestedObjectValueOf[credentialModel] `tfsdk:"credential"`
+	CredentialWOVersion types.Int64                                      `tfsdk:"credential_wo_version"`
 	ID                  types.String                                     `tfsdk:"id"`
 	Persona             types.String                                     `tfsdk:"persona"`
 	Tags                tftags.Map                                       `tfsdk:"tags"`
@@ -532,6 +568,14 @@ func (m *appAuthorizationResourceModel) InitFromID() error {
 	return nil
 }
 
+// withCredential returns a copy of the model using the specified credential,
+// typically taken from the configuration so that write-only values are included.
+func (m appAuthorizationResourceModel) withCredential(credential fwtypes.ListNestedObjectValueOf[credentialModel]) appAuthorizationResourceModel {
+	m.Credential = credential
+
+	return m
+}
+
 func (m *appAuthorizationResourceModel) setID() (string, error) {
 	parts := []string{
 		m.AppAuthorizationARN.ValueString(),
@@ -565,6 +609,10 @@ func (m credentialModel) Expand(ctx context.Context) (result any, diags diag.Dia
 			return nil, diags
 		}
 
+		if !efsStorageConfigurationData.ApiKeyWO.IsNull() {
+			r.Value.ApiKey = fwflex.StringFromFramework(ctx, efsStorageConfigurationData.ApiKeyWO)
+		}
+
 		return &r, diags
 
 	case !m.Oauth2Credential.IsNull():
@@ -580,6 +628,10 @@ func (m credentialModel) Expand(ctx context.Context) (result any, diags diag.Dia
 			return nil, diags
 		}
 
+		if !fsxStorageConfigurationData.ClientSecretWO.IsNull() {
+			r.Value.ClientSecret = fwflex.StringFromFramework(ctx, fsxStorageConfigurationData.ClientSecretWO)
+		}
+
 		return &r, diags
 	}
 
@@ -587,12 +639,14 @@ func (m credentialModel) Expand(ctx context.Context) (result any, diags diag.Dia
 }
 
 type apiKeyCredentialModel struct {
-	ApiKey types.String `tfsdk:"api_key"`
+	ApiKey   types.String `tfsdk:"api_key"`
+	ApiKeyWO types.String `tfsdk:"api_key_wo"`
 }
 
 type oauth2CredentialModel struct {
-	ClientId     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
+	ClientId       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	ClientSecretWO types.String `tfsdk:"client_secret_wo"`
 }
 
 type tenantModel struct {
@@ -10,9 +10,12 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/appfabric/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/go-version"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfappfabric "github.com/hashicorp/terraform-provider-aws/internal/service/appfabric"
@@ -156,6 +159,53 @@ func testAccAppAuthorization_apiKeyUpdate(t *testing.T) {
 	})
 }
 
+func testAccAppAuthorization_apiKeyWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appfabric_app_authorization.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var appauthorization types.AppAuthorization
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID, endpoints.ApNortheast1RegionID, endpoints.EuWest1RegionID)
+			testAccPreCheck(ctx, t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAuthorizationConfig_apiKeyWriteOnly(rName, "ApiExampleKey", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName, &appauthorization),
+					resource.TestCheckResourceAttr(resourceName, "credential.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "credential.0.api_key_credential.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "credential.0.api_key_credential.0.api_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "credential.0.api_key_credential.0.api_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "credential_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccAppAuthorizationConfig_apiKeyWriteOnly(rName, "updatedApiExampleKey", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName, &appauthorization),
+					resource.TestCheckNoResourceAttr(resourceName, "credential.0.api_key_credential.0.api_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "credential_wo_version", "2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccAppAuthorization_oath2Update(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_appfabric_app_authorization.test"
@@ -317,6 +367,33 @@ resource "aws_appfabric_app_authorization" "test" {
 `, rName)
 }
 
+func testAccAppAuthorizationConfig_apiKeyWriteOnly(rName, apiKey string, credentialVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_appfabric_app_bundle" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_appfabric_app_authorization" "test" {
+  app_bundle_arn        = aws_appfabric_app_bundle.test.arn
+  app                   = "TERRAFORMCLOUD"
+  auth_type             = "apiKey"
+  credential_wo_version = %[3]d
+
+  credential {
+    api_key_credential {
+      api_key_wo = %[2]q
+    }
+  }
+  tenant {
+    tenant_display_name = "test"
+    tenant_identifier   = "test"
+  }
+}
+`, rName, apiKey, credentialVersion)
+}
+
 func testAccAppAuthorizationConfig_oath2(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_appfabric_app_bundle" "test" {
@@ -30,6 +30,7 @@ func TestAccAppFabric_serial(t *testing.T) {
 			acctest.CtBasic:      testAccAppAuthorization_basic,
 			acctest.CtDisappears: testAccAppAuthorization_disappears,
 			"apiKeyUpdate":       testAccAppAuthorization_apiKeyUpdate,
+			"apiKeyWriteOnly":    testAccAppAuthorization_apiKeyWriteOnly,
 			"oath2Update":        testAccAppAuthorization_oath2Update,
 			"tags":               testAccAppFabricAppAuthorization_tagsSerial,
 		},
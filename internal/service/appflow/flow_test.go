@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/appflow"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -321,19 +322,33 @@ func TestAccAppFlowFlow_metadataCatalog(t *testing.T) {
 		CheckDestroy:             testAccCheckFlowDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccFlowConfig_metadata_catalog(rName),
+				Config: testAccFlowConfig_metadata_catalog(rName, "test_prefix"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFlowExists(ctx, resourceName, &flowOutput),
 					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.0.glue_data_catalog.0.table_prefix", "test_prefix"),
 					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.0.destination_connector_properties.0.s3.0.s3_output_format_config.0.prefix_config.0.prefix_hierarchy.0", "SCHEMA_VERSION"),
 					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.0.destination_connector_properties.0.s3.0.s3_output_format_config.0.prefix_config.0.prefix_hierarchy.1", "EXECUTION_ID"),
 					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.0.destination_connector_properties.0.s3.0.s3_output_format_config.0.prefix_config.0.prefix_hierarchy.#", "2"),
 				),
 			},
 			{
-				Config:   testAccFlowConfig_metadata_catalog(rName),
+				Config:   testAccFlowConfig_metadata_catalog(rName, "test_prefix"),
 				PlanOnly: true,
 			},
+			{
+				Config: testAccFlowConfig_metadata_catalog(rName, "test_prefix_updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &flowOutput),
+					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_catalog_config.0.glue_data_catalog.0.table_prefix", "test_prefix_updated"),
+				),
+			},
 		},
 	})
 }
@@ -791,7 +806,7 @@ resource "aws_appflow_flow" "test" {
 	)
 }
 
-func testAccFlowConfig_metadata_catalog(rName string) string {
+func testAccFlowConfig_metadata_catalog(rName, tablePrefix string) string {
 	return acctest.ConfigCompose(
 		testAccFlowConfig_base(rName),
 		fmt.Sprintf(`
@@ -862,7 +877,7 @@ resource "aws_appflow_flow" "test" {
   metadata_catalog_config {
     glue_data_catalog {
       database_name = "testdb_name"
-      table_prefix  = "test_prefix"
+      table_prefix  = %[2]q
       role_arn      = aws_iam_role.test.arn
     }
   }
@@ -871,7 +886,7 @@ resource "aws_appflow_flow" "test" {
     trigger_type = "OnDemand"
   }
 }
-`, rName),
+`, rName, tablePrefix),
 	)
 }
 
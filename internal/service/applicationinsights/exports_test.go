@@ -6,6 +6,10 @@ package applicationinsights
 // Exports for use in tests only.
 var (
 	ResourceApplication = resourceApplication
+	ResourceComponent   = resourceComponent
+	ResourceLogPattern  = resourceLogPattern
 
-	FindApplicationByName = findApplicationByName
+	FindApplicationByName        = findApplicationByName
+	FindComponentByTwoPartKey    = findComponentByTwoPartKey
+	FindLogPatternByThreePartKey = findLogPatternByThreePartKey
 )
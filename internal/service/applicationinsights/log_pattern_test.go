@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationinsights/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationinsights "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationInsightsLogPattern_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LogPattern
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_log_pattern.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogPatternDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogPatternConfig_basic(rName, "ERROR", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogPatternExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "pattern_name", "errors"),
+					resource.TestCheckResourceAttr(resourceName, "pattern_set_name", "tfacctest"),
+					resource.TestCheckResourceAttr(resourceName, "rank", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_group_name", "aws_applicationinsights_application.test", "resource_group_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLogPatternConfig_basic(rName, "FATAL", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogPatternExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "FATAL"),
+					resource.TestCheckResourceAttr(resourceName, "rank", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationInsightsLogPattern_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LogPattern
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_log_pattern.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogPatternDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogPatternConfig_basic(rName, "ERROR", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogPatternExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfapplicationinsights.ResourceLogPattern(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLogPatternDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationinsights_log_pattern" {
+				continue
+			}
+
+			_, err := tfapplicationinsights.FindLogPatternByThreePartKey(ctx, conn, rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["pattern_set_name"], rs.Primary.Attributes["pattern_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ApplicationInsights Log Pattern %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLogPatternExists(ctx context.Context, n string, v *awstypes.LogPattern) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		output, err := tfapplicationinsights.FindLogPatternByThreePartKey(ctx, conn, rs.Primary.Attributes["resource_group_name"], rs.Primary.Attributes["pattern_set_name"], rs.Primary.Attributes["pattern_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLogPatternConfig_basic(rName, pattern string, rank int) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_applicationinsights_log_pattern" "test" {
+  resource_group_name = aws_applicationinsights_application.test.resource_group_name
+  pattern_set_name    = "tfacctest"
+  pattern_name        = "errors"
+  pattern             = %[1]q
+  rank                = %[2]d
+}
+`, pattern, rank))
+}
@@ -36,6 +36,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceComponent,
+			TypeName: "aws_applicationinsights_component",
+			Name:     "Component",
+		},
+		{
+			Factory:  resourceLogPattern,
+			TypeName: "aws_applicationinsights_log_pattern",
+			Name:     "Log Pattern",
+		},
 	}
 }
 
@@ -5,7 +5,6 @@ package appstream
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -20,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -84,14 +84,8 @@ func resourceUserStackAssociationCreate(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating AppStream User Stack Association (%s): %s", id, err)
 	}
-	if len(output.Errors) > 0 {
-		var errs []error
-
-		for _, err := range output.Errors {
-			errs = append(errs, fmt.Errorf("%s: %s", string(err.ErrorCode), aws.ToString(err.ErrorMessage)))
-		}
-
-		return sdkdiag.AppendErrorf(diags, "creating AppStream User Stack Association (%s): %s", id, errors.Join(errs...))
+	if err := userStackAssociationsError(output.Errors); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppStream User Stack Association (%s): %s", id, err)
 	}
 
 	d.SetId(id)
@@ -163,17 +157,43 @@ func resourceUserStackAssociationDelete(ctx context.Context, d *schema.ResourceD
 	input := appstream.BatchDisassociateUserStackInput{
 		UserStackAssociations: []awstypes.UserStackAssociation{userStackAssociation},
 	}
-	_, err = conn.BatchDisassociateUserStack(ctx, &input)
+	output, err := conn.BatchDisassociateUserStack(ctx, &input)
+
+	if err == nil {
+		err = userStackAssociationsError(output.Errors)
+	}
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.AllItemsMatch(err, errs.ItemCodeEquals(
+		string(awstypes.UserStackAssociationErrorCodeStackNotFound),
+		string(awstypes.UserStackAssociationErrorCodeUserNameNotFound),
+	)) {
+		return diags
+	}
 
 	if err != nil {
-		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-			return diags
-		}
 		return sdkdiag.AppendErrorf(diags, "deleting AppStream User Stack Association (%s): %s", d.Id(), err)
 	}
+
 	return diags
 }
 
+func userStackAssociationsError(apiObjects []awstypes.UserStackAssociationError) error {
+	items := tfslices.ApplyToAll(apiObjects, func(apiObject awstypes.UserStackAssociationError) errs.ItemError {
+		item := errs.ItemError{
+			Code:    string(apiObject.ErrorCode),
+			Message: aws.ToString(apiObject.ErrorMessage),
+		}
+
+		if v := apiObject.UserStackAssociation; v != nil {
+			item.ID = EncodeUserStackAssociationID(aws.ToString(v.UserName), string(v.AuthenticationType), aws.ToString(v.StackName))
+		}
+
+		return item
+	})
+
+	return errs.NewBatchError(items)
+}
+
 func EncodeUserStackAssociationID(userName, authType, stackName string) string {
 	return fmt.Sprintf("%s/%s/%s", userName, authType, stackName)
 }
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_athena_capacity_assignment", name="Capacity Assignment")
+func newCapacityAssignmentResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &capacityAssignmentResource{}
+
+	return r, nil
+}
+
+type capacityAssignmentResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *capacityAssignmentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"capacity_reservation_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"workgroup_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *capacityAssignmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data capacityAssignmentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	name := data.CapacityReservationName.ValueString()
+	if err := putCapacityAssignmentConfiguration(ctx, conn, name, fwflex.ExpandFrameworkStringValueSet(ctx, data.WorkGroupNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Athena Capacity Assignment (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *capacityAssignmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data capacityAssignmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	output, err := findCapacityAssignmentConfigurationByReservationName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Athena Capacity Assignment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var workGroupNames []string
+	for _, v := range output.CapacityAssignments {
+		workGroupNames = append(workGroupNames, v.WorkGroupNames...)
+	}
+
+	data.CapacityReservationName = fwflex.StringToFramework(ctx, output.CapacityReservationName)
+	data.WorkGroupNames = fwflex.FlattenFrameworkStringValueSet(ctx, workGroupNames)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *capacityAssignmentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new capacityAssignmentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	if !new.WorkGroupNames.Equal(old.WorkGroupNames) {
+		name := new.ID.ValueString()
+		if err := putCapacityAssignmentConfiguration(ctx, conn, name, fwflex.ExpandFrameworkStringValueSet(ctx, new.WorkGroupNames)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Athena Capacity Assignment (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *capacityAssignmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data capacityAssignmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AthenaClient(ctx)
+
+	// Removing all assignments releases the workgroups from the capacity reservation.
+	name := data.ID.ValueString()
+	err := putCapacityAssignmentConfiguration(ctx, conn, name, []string{})
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "does not exist") {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Athena Capacity Assignment (%s)", name), err.Error())
+
+		return
+	}
+}
+
+type capacityAssignmentResourceModel struct {
+	CapacityReservationName types.String `tfsdk:"capacity_reservation_name"`
+	ID                      types.String `tfsdk:"id"`
+	WorkGroupNames          types.Set    `tfsdk:"workgroup_names"`
+}
+
+func putCapacityAssignmentConfiguration(ctx context.Context, conn *athena.Client, name string, workGroupNames []string) error {
+	input := &athena.PutCapacityAssignmentConfigurationInput{
+		CapacityAssignments:     []awstypes.CapacityAssignment{},
+		CapacityReservationName: aws.String(name),
+	}
+
+	if len(workGroupNames) > 0 {
+		input.CapacityAssignments = append(input.CapacityAssignments, awstypes.CapacityAssignment{
+			WorkGroupNames: workGroupNames,
+		})
+	}
+
+	_, err := conn.PutCapacityAssignmentConfiguration(ctx, input)
+
+	return err
+}
+
+func findCapacityAssignmentConfigurationByReservationName(ctx context.Context, conn *athena.Client, name string) (*awstypes.CapacityAssignmentConfiguration, error) {
+	input := &athena.GetCapacityAssignmentConfigurationInput{
+		CapacityReservationName: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityAssignmentConfiguration(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "does not exist") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityAssignmentConfiguration == nil || len(output.CapacityAssignmentConfiguration.CapacityAssignments) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CapacityAssignmentConfiguration, nil
+}
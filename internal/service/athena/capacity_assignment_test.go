@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAthenaCapacityAssignment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_assignment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityAssignmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityAssignmentConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityAssignmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "capacity_reservation_name", "aws_athena_capacity_reservation.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "workgroup_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "workgroup_names.*", "aws_athena_workgroup.test.0", names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityAssignmentConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityAssignmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workgroup_names.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "workgroup_names.*", "aws_athena_workgroup.test.0", names.AttrName),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "workgroup_names.*", "aws_athena_workgroup.test.1", names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityAssignmentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_athena_capacity_assignment" {
+				continue
+			}
+
+			_, err := tfathena.FindCapacityAssignmentConfigurationByReservationName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Athena Capacity Assignment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCapacityAssignmentExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaClient(ctx)
+
+		_, err := tfathena.FindCapacityAssignmentConfigurationByReservationName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCapacityAssignmentConfig_basic(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = 24
+}
+
+resource "aws_athena_workgroup" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_athena_capacity_assignment" "test" {
+  capacity_reservation_name = aws_athena_capacity_reservation.test.name
+  workgroup_names           = aws_athena_workgroup.test[*].name
+}
+`, rName, count)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/athena"
 	"github.com/aws/aws-sdk-go-v2/service/athena/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -41,7 +42,10 @@ func resourceDataCatalog() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			validateDataCatalogParameters,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -209,6 +213,33 @@ func resourceDataCatalogDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// validateDataCatalogParameters checks the parameters required by the FEDERATED catalog type.
+// A federated catalog uses either an existing Glue connection ("connection-arn") or
+// creates a new one ("connection-type" and "connection-properties"), but not both.
+func validateDataCatalogParameters(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if types.DataCatalogType(d.Get(names.AttrType).(string)) != types.DataCatalogTypeFederated {
+		return nil
+	}
+
+	// Parameters may not be known until apply.
+	if !d.NewValueKnown(names.AttrParameters) {
+		return nil
+	}
+
+	parameters := d.Get(names.AttrParameters).(map[string]interface{})
+	_, hasConnectionARN := parameters["connection-arn"]
+	_, hasConnectionType := parameters["connection-type"]
+
+	switch {
+	case hasConnectionARN && hasConnectionType:
+		return fmt.Errorf(`%q must contain only one of "connection-arn" or "connection-type" when %q is %q`, names.AttrParameters, names.AttrType, types.DataCatalogTypeFederated)
+	case !hasConnectionARN && !hasConnectionType:
+		return fmt.Errorf(`%q must contain "connection-arn" or "connection-type" when %q is %q`, names.AttrParameters, names.AttrType, types.DataCatalogTypeFederated)
+	}
+
+	return nil
+}
+
 func findDataCatalogByName(ctx context.Context, conn *athena.Client, name string) (*types.DataCatalog, error) {
 	input := &athena.GetDataCatalogInput{
 		Name: aws.String(name),
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -215,6 +216,28 @@ func TestAccAthenaDataCatalog_type_glue(t *testing.T) {
 	})
 }
 
+func TestAccAthenaDataCatalog_type_federatedValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := "tf-test-" + sdkacctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCatalogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataCatalogConfig_typeFederated(rName, `"connection-type" = "DYNAMODB"`+"\n"+`    "connection-arn" = "arn:aws:glue:us-east-1:123456789012:connection/test"`), //lintignore:AWSAT003,AWSAT005
+				ExpectError: regexache.MustCompile(`must contain only one of "connection-arn" or "connection-type"`),
+			},
+			{
+				Config:      testAccDataCatalogConfig_typeFederated(rName, `"catalog-id" = "123456789012"`),
+				ExpectError: regexache.MustCompile(`must contain "connection-arn" or "connection-type"`),
+			},
+		},
+	})
+}
+
 func TestAccAthenaDataCatalog_parameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := "tf-test-" + sdkacctest.RandString(8)
@@ -395,6 +418,20 @@ resource "aws_athena_data_catalog" "test" {
 `, rName)
 }
 
+func testAccDataCatalogConfig_typeFederated(rName, parameters string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_data_catalog" "test" {
+  name        = %[1]q
+  description = "A test data catalog using a federated connection"
+  type        = "FEDERATED"
+
+  parameters = {
+    %[2]s
+  }
+}
+`, rName, parameters)
+}
+
 func testAccDataCatalogConfig_parameters(rName string) string {
 	//lintignore:AWSAT003,AWSAT005
 	return fmt.Sprintf(`
@@ -5,16 +5,19 @@ package athena
 
 // Exports for use in tests only.
 var (
-	FindDataCatalogByName             = findDataCatalogByName
-	FindDatabaseByName                = findDatabaseByName
-	FindNamedQueryByID                = findNamedQueryByID
-	FindPreparedStatementByTwoPartKey = findPreparedStatementByTwoPartKey
-	FindWorkGroupByName               = findWorkGroupByName
-	QueryExecutionResult              = queryExecutionResult
+	FindCapacityAssignmentConfigurationByReservationName = findCapacityAssignmentConfigurationByReservationName
+	FindCapacityReservationByName                        = findCapacityReservationByName
+	FindDataCatalogByName                                = findDataCatalogByName
+	FindDatabaseByName                                   = findDatabaseByName
+	FindNamedQueryByID                                   = findNamedQueryByID
+	FindPreparedStatementByTwoPartKey                    = findPreparedStatementByTwoPartKey
+	FindWorkGroupByName                                  = findWorkGroupByName
+	QueryExecutionResult                                 = queryExecutionResult
 
-	ResourceDataCatalog       = resourceDataCatalog
-	ResourceDatabase          = resourceDatabase
-	ResourceNamedQuery        = resourceNamedQuery
-	ResourcePreparedStatement = resourcePreparedStatement
-	ResourceWorkGroup         = resourceWorkGroup
+	ResourceCapacityReservation = newCapacityReservationResource
+	ResourceDataCatalog         = resourceDataCatalog
+	ResourceDatabase            = resourceDatabase
+	ResourceNamedQuery          = resourceNamedQuery
+	ResourcePreparedStatement   = resourcePreparedStatement
+	ResourceWorkGroup           = resourceWorkGroup
 )
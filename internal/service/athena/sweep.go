@@ -12,10 +12,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
+	resource.AddTestSweepers("aws_athena_capacity_reservation", &resource.Sweeper{
+		Name: "aws_athena_capacity_reservation",
+		F:    sweepCapacityReservations,
+	})
+
 	resource.AddTestSweepers("aws_athena_data_catalog", &resource.Sweeper{
 		Name: "aws_athena_data_catalog",
 		F:    sweepDataCatalogs,
@@ -33,11 +39,50 @@ func RegisterSweepers() {
 		Name: "aws_athena_workgroup",
 		F:    sweepWorkGroups,
 		Dependencies: []string{
+			"aws_athena_capacity_reservation",
 			"aws_athena_data_catalog",
 		},
 	})
 }
 
+func sweepCapacityReservations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.AthenaClient(ctx)
+	input := &athena.ListCapacityReservationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := athena.NewListCapacityReservationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Athena Capacity Reservation sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Athena Capacity Reservations (%s): %w", region, err)
+		}
+
+		for _, v := range page.CapacityReservations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newCapacityReservationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name))))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Athena Capacity Reservations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepDataCatalogs(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -329,6 +329,7 @@ func (r *resourceAssessment) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 		resp.Diagnostics.Append(state.refreshFromOutput(ctx, out.Assessment)...)
+		plan.RolesAll = state.RolesAll
 		plan.Status = flex.StringValueToFramework(ctx, out.Assessment.Metadata.Status)
 	} else {
 		plan.Status = state.Status
@@ -367,6 +368,23 @@ func (r *resourceAssessment) ImportState(ctx context.Context, req resource.Impor
 
 func (r *resourceAssessment) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resourceAssessmentData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Roles are updated in-place, so the computed set of all roles with access
+	// to the assessment is only known after apply.
+	if !plan.Roles.Equal(state.Roles) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("roles_all"), types.SetUnknown(types.ObjectType{AttrTypes: assessmentRolesAttrTypes}))...)
+	}
 }
 
 func FindAssessmentByID(ctx context.Context, conn *auditmanager.Client, id string) (*awstypes.Assessment, error) {
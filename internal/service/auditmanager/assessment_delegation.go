@@ -21,8 +21,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -36,6 +38,10 @@ const (
 	ResNameAssessmentDelegation = "AssessmentDelegation"
 )
 
+const (
+	errCodeResourceNotFoundException = "ResourceNotFoundException"
+)
+
 type resourceAssessmentDelegation struct {
 	framework.ResourceWithConfigure
 }
@@ -145,6 +151,13 @@ func (r *resourceAssessmentDelegation) Create(ctx context.Context, req resource.
 		)
 		return
 	}
+	if out != nil && len(out.Errors) > 0 {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameAssessmentDelegation, plan.RoleARN.String(), nil),
+			batchCreateDelegationByAssessmentError(out.Errors).Error(),
+		)
+		return
+	}
 	if out == nil || len(out.Delegations) == 0 {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameAssessmentDelegation, plan.RoleARN.String(), nil),
@@ -219,7 +232,13 @@ func (r *resourceAssessmentDelegation) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	_, err := conn.BatchDeleteDelegationByAssessment(ctx, &auditmanager.BatchDeleteDelegationByAssessmentInput{
+	// Delegations are removed along with their assessment. If the assessment has
+	// already been deleted there is nothing left to do.
+	if _, err := FindAssessmentByID(ctx, conn, state.AssessmentID.ValueString()); tfresource.NotFound(err) {
+		return
+	}
+
+	out, err := conn.BatchDeleteDelegationByAssessment(ctx, &auditmanager.BatchDeleteDelegationByAssessmentInput{
 		AssessmentId:  state.AssessmentID.ValueStringPointer(),
 		DelegationIds: []string{state.DelegationID.ValueString()},
 	})
@@ -232,6 +251,22 @@ func (r *resourceAssessmentDelegation) Delete(ctx context.Context, req resource.
 			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameAssessmentDelegation, state.ID.String(), nil),
 			err.Error(),
 		)
+		return
+	}
+
+	// Per-delegation failures are reported in the response rather than as an error.
+	// A delegation which no longer exists is not considered a failure.
+	if out == nil {
+		return
+	}
+	err = batchDeleteDelegationByAssessmentError(tfslices.Filter(out.Errors, func(v awstypes.BatchDeleteDelegationByAssessmentError) bool {
+		return aws.ToString(v.ErrorCode) != errCodeResourceNotFoundException
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameAssessmentDelegation, state.ID.String(), nil),
+			err.Error(),
+		)
 	}
 }
 
@@ -279,6 +314,31 @@ func getMatchingDelegation(out []awstypes.Delegation, roleARN, controlSetID stri
 	return nil, errors.New("no matching delegations in response")
 }
 
+func batchCreateDelegationByAssessmentError(apiObjects []awstypes.BatchCreateDelegationByAssessmentError) error {
+	return errs.NewBatchError(tfslices.ApplyToAll(apiObjects, func(apiObject awstypes.BatchCreateDelegationByAssessmentError) errs.ItemError {
+		item := errs.ItemError{
+			Code:    aws.ToString(apiObject.ErrorCode),
+			Message: aws.ToString(apiObject.ErrorMessage),
+		}
+
+		if v := apiObject.CreateDelegationRequest; v != nil {
+			item.ID = aws.ToString(v.RoleArn)
+		}
+
+		return item
+	}))
+}
+
+func batchDeleteDelegationByAssessmentError(apiObjects []awstypes.BatchDeleteDelegationByAssessmentError) error {
+	return errs.NewBatchError(tfslices.ApplyToAll(apiObjects, func(apiObject awstypes.BatchDeleteDelegationByAssessmentError) errs.ItemError {
+		return errs.ItemError{
+			ID:      aws.ToString(apiObject.DelegationId),
+			Code:    aws.ToString(apiObject.ErrorCode),
+			Message: aws.ToString(apiObject.ErrorMessage),
+		}
+	}))
+}
+
 func fromID(id string) (string, string, string) {
 	parts := strings.Split(id, ",")
 	if len(parts) != 3 {
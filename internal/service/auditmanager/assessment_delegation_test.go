@@ -83,6 +83,34 @@ func TestAccAuditManagerAssessmentDelegation_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAuditManagerAssessmentDelegation_disappears_Assessment(t *testing.T) {
+	ctx := acctest.Context(t)
+	var delegation types.DelegationMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_delegation.test"
+	assessmentResourceName := "aws_auditmanager_assessment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentDelegationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentDelegationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentDelegationExists(ctx, resourceName, &delegation),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfauditmanager.ResourceAssessment, assessmentResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccAuditManagerAssessmentDelegation_optional(t *testing.T) {
 	ctx := acctest.Context(t)
 	var delegation types.DelegationMetadata
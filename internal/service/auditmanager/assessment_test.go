@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -181,6 +182,49 @@ func TestAccAuditManagerAssessment_optional(t *testing.T) {
 	})
 }
 
+func TestAccAuditManagerAssessment_roles(t *testing.T) {
+	ctx := acctest.Context(t)
+	var assessment1, assessment2 types.Assessment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentConfig_roles(rName, "PROCESS_OWNER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentExists(ctx, resourceName, &assessment1),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "roles.*", map[string]string{
+						"role_type": "PROCESS_OWNER",
+					}),
+				),
+			},
+			{
+				Config: testAccAssessmentConfig_roles(rName, "RESOURCE_OWNER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentExists(ctx, resourceName, &assessment2),
+					testAccCheckAssessmentNotRecreated(&assessment1, &assessment2),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "roles.*", map[string]string{
+						"role_type": "RESOURCE_OWNER",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "roles_all.*", map[string]string{
+						"role_type": "RESOURCE_OWNER",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAssessmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient(ctx)
@@ -229,6 +273,16 @@ func testAccCheckAssessmentExists(ctx context.Context, name string, assessment *
 	}
 }
 
+func testAccCheckAssessmentNotRecreated(before, after *types.Assessment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Metadata.Id), aws.ToString(after.Metadata.Id); before != after {
+			return fmt.Errorf("Audit Manager Assessment (%s) recreated", before)
+		}
+
+		return nil
+	}
+}
+
 func testAccAssessmentConfigBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -412,3 +466,34 @@ resource "aws_auditmanager_assessment" "test" {
 }
 `, rName, description))
 }
+
+func testAccAssessmentConfig_roles(rName, roleType string) string {
+	return acctest.ConfigCompose(
+		testAccAssessmentConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_auditmanager_assessment" "test" {
+  name = %[1]q
+
+  assessment_reports_destination {
+    destination      = "s3://${aws_s3_bucket.test.id}"
+    destination_type = "S3"
+  }
+
+  framework_id = aws_auditmanager_framework.test.id
+
+  roles {
+    role_arn  = aws_iam_role.test.arn
+    role_type = %[2]q
+  }
+
+  scope {
+    aws_accounts {
+      id = data.aws_caller_identity.current.account_id
+    }
+    aws_services {
+      service_name = "S3"
+    }
+  }
+}
+`, rName, roleType))
+}
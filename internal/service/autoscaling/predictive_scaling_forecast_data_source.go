@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_autoscaling_predictive_scaling_forecast", name="Predictive Scaling Forecast")
+func newPredictiveScalingForecastDataSource(_ context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &predictiveScalingForecastDataSource{}
+
+	return d, nil
+}
+
+type predictiveScalingForecastDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *predictiveScalingForecastDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	forecastAttributes := map[string]schema.Attribute{
+		"timestamps": schema.ListAttribute{
+			ElementType: timetypes.RFC3339Type{},
+			Computed:    true,
+		},
+		names.AttrValues: schema.ListAttribute{
+			ElementType: types.Float64Type,
+			Computed:    true,
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"autoscaling_group_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			},
+			"policy_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"policy_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			},
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"capacity_forecast": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[forecastModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: forecastAttributes,
+				},
+			},
+			"load_forecast": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[loadForecastModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"metric_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"timestamps":     forecastAttributes["timestamps"],
+						names.AttrValues: forecastAttributes[names.AttrValues],
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *predictiveScalingForecastDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data predictiveScalingForecastDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AutoScalingClient(ctx)
+
+	policyARN := data.PolicyARN.ValueString()
+	asgName, policyName, err := predictiveScalingPolicyARNParts(policyARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Auto Scaling Predictive Scaling Forecast (%s)", policyARN), err.Error())
+
+		return
+	}
+
+	startTime, diags := data.StartTime.ValueRFC3339Time()
+	response.Diagnostics.Append(diags...)
+	endTime, diags := data.EndTime.ValueRFC3339Time()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := autoscaling.GetPredictiveScalingForecastInput{
+		AutoScalingGroupName: aws.String(asgName),
+		EndTime:              aws.Time(endTime),
+		PolicyName:           aws.String(policyName),
+		StartTime:            aws.Time(startTime),
+	}
+
+	output, err := conn.GetPredictiveScalingForecast(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Auto Scaling Predictive Scaling Forecast (%s)", policyARN), err.Error())
+
+		return
+	}
+
+	data.AutoScalingGroupName = types.StringValue(asgName)
+	data.PolicyName = types.StringValue(policyName)
+	if v := output.UpdateTime; v != nil {
+		data.UpdateTime = timetypes.NewRFC3339TimeValue(aws.ToTime(v))
+	} else {
+		data.UpdateTime = timetypes.NewRFC3339Null()
+	}
+
+	capacityForecast := []*forecastModel{}
+	if v := output.CapacityForecast; v != nil {
+		capacityForecast = append(capacityForecast, &forecastModel{
+			Timestamps: flattenForecastTimestamps(v.Timestamps),
+			Values:     flattenForecastValues(v.Values),
+		})
+	}
+	data.CapacityForecast = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, capacityForecast)
+
+	loadForecast := []*loadForecastModel{}
+	for _, v := range output.LoadForecast {
+		loadForecast = append(loadForecast, &loadForecastModel{
+			MetricType: types.StringValue(predictiveScalingLoadMetricType(v.MetricSpecification)),
+			Timestamps: flattenForecastTimestamps(v.Timestamps),
+			Values:     flattenForecastValues(v.Values),
+		})
+	}
+	data.LoadForecast = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, loadForecast)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// predictiveScalingPolicyARNParts returns the Auto Scaling group name and policy name encoded in a scaling policy ARN.
+// Scaling policy ARNs have the resource form "scalingPolicy:<id>:autoScalingGroupName/<group>:policyName/<policy>".
+func predictiveScalingPolicyARNParts(s string) (string, string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	m := regexache.MustCompile(`^scalingPolicy:[^:]+:autoScalingGroupName/(.+):policyName/(.+)$`).FindStringSubmatch(v.Resource)
+
+	if m == nil {
+		return "", "", fmt.Errorf("unexpected format for scaling policy ARN resource (%s), expected scalingPolicy:<id>:autoScalingGroupName/<group>:policyName/<policy>", v.Resource)
+	}
+
+	return m[1], m[2], nil
+}
+
+// predictiveScalingLoadMetricType returns a label for the load metric that produced a forecast.
+func predictiveScalingLoadMetricType(apiObject *awstypes.PredictiveScalingMetricSpecification) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	if v := apiObject.PredefinedMetricPairSpecification; v != nil {
+		return string(v.PredefinedMetricType)
+	}
+
+	if v := apiObject.PredefinedLoadMetricSpecification; v != nil {
+		return string(v.PredefinedMetricType)
+	}
+
+	if apiObject.CustomizedLoadMetricSpecification != nil {
+		return "Customized"
+	}
+
+	return ""
+}
+
+func flattenForecastTimestamps(apiObjects []time.Time) types.List {
+	elements := make([]attr.Value, 0, len(apiObjects))
+	for _, v := range apiObjects {
+		elements = append(elements, timetypes.NewRFC3339TimeValue(v))
+	}
+
+	return types.ListValueMust(timetypes.RFC3339Type{}, elements)
+}
+
+func flattenForecastValues(apiObjects []float64) types.List {
+	elements := make([]attr.Value, 0, len(apiObjects))
+	for _, v := range apiObjects {
+		elements = append(elements, types.Float64Value(v))
+	}
+
+	return types.ListValueMust(types.Float64Type, elements)
+}
+
+type predictiveScalingForecastDataSourceModel struct {
+	AutoScalingGroupName types.String                                       `tfsdk:"autoscaling_group_name"`
+	CapacityForecast     fwtypes.ListNestedObjectValueOf[forecastModel]     `tfsdk:"capacity_forecast"`
+	EndTime              timetypes.RFC3339                                  `tfsdk:"end_time"`
+	LoadForecast         fwtypes.ListNestedObjectValueOf[loadForecastModel] `tfsdk:"load_forecast"`
+	PolicyARN            fwtypes.ARN                                        `tfsdk:"policy_arn"`
+	PolicyName           types.String                                       `tfsdk:"policy_name"`
+	StartTime            timetypes.RFC3339                                  `tfsdk:"start_time"`
+	UpdateTime           timetypes.RFC3339                                  `tfsdk:"update_time"`
+}
+
+type forecastModel struct {
+	Timestamps types.List `tfsdk:"timestamps"`
+	Values     types.List `tfsdk:"values"`
+}
+
+type loadForecastModel struct {
+	MetricType types.String `tfsdk:"metric_type"`
+	Timestamps types.List   `tfsdk:"timestamps"`
+	Values     types.List   `tfsdk:"values"`
+}
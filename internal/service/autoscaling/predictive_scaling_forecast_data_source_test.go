@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAutoScalingPredictiveScalingForecastDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_autoscaling_predictive_scaling_forecast.test"
+	resourceName := "aws_autoscaling_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {
+				Source:            "hashicorp/time",
+				VersionConstraint: "0.12.1",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredictiveScalingForecastDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "autoscaling_group_name", resourceName, "autoscaling_group_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy_name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "capacity_forecast.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "load_forecast.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPredictiveScalingForecastDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_predictiveScalingPredefined(rName), `
+resource "time_static" "test" {}
+
+data "aws_autoscaling_predictive_scaling_forecast" "test" {
+  policy_arn = aws_autoscaling_policy.test.arn
+  start_time = time_static.test.rfc3339
+  end_time   = timeadd(time_static.test.rfc3339, "24h")
+}
+`)
+}
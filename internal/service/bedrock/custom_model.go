@@ -18,8 +18,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -49,6 +51,8 @@ import (
 func newCustomModelResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &customModelResource{}
 
+	r.SetDefaultCreateTimeout(240 * time.Minute)
+	r.SetDefaultUpdateTimeout(240 * time.Minute)
 	r.SetDefaultDeleteTimeout(120 * time.Minute)
 
 	return r, nil
@@ -138,6 +142,11 @@ func (r *customModelResource) Schema(ctx context.Context, request resource.Schem
 			names.AttrTagsAll:    tftags.TagsAttributeComputedOnly(),
 			"training_metrics":   framework.ResourceComputedListOfObjectsAttribute[trainingMetricsModel](ctx),
 			"validation_metrics": framework.ResourceComputedListOfObjectsAttribute[validatorMetricModel](ctx),
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"output_data_config": schema.ListNestedBlock{
@@ -166,6 +175,7 @@ func (r *customModelResource) Schema(ctx context.Context, request resource.Schem
 			},
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
 			"training_data_config": schema.ListNestedBlock{
@@ -310,6 +320,17 @@ func (r *customModelResource) Create(ctx context.Context, request resource.Creat
 	data.ValidationMetrics = fwtypes.NewListNestedObjectValueOfNull[validatorMetricModel](ctx)
 	data.setID()
 
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+	if response.Diagnostics.HasError() || !data.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	// The customization job is already recorded in state so that a failed or timed out wait taints the resource.
+	response.Diagnostics.Append(r.waitForCompletion(ctx, conn, &data, r.CreateTimeout(ctx, data.Timeouts))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -353,6 +374,9 @@ func (r *customModelResource) Read(ctx context.Context, request resource.ReadReq
 	data.CustomModelKmsKeyID = fwflex.StringToFrameworkARN(ctx, outputGJ.OutputModelKmsKeyArn)
 	data.CustomModelName = fwflex.StringToFramework(ctx, outputGJ.OutputModelName)
 	data.JobStatus = fwtypes.StringEnumValue(outputGJ.Status)
+	if data.WaitForCompletion.IsNull() {
+		data.WaitForCompletion = types.BoolValue(false)
+	}
 	// The base model ARN in GetCustomModelOutput can contain the model version and parameter count.
 	baseModelARN := fwflex.StringFromFramework(ctx, data.BaseModelIdentifier)
 	data.BaseModelIdentifier = fwflex.StringToFrameworkARN(ctx, outputGJ.BaseModelArn)
@@ -410,13 +434,23 @@ func (r *customModelResource) Update(ctx context.Context, request resource.Updat
 		return
 	}
 
-	// Update is only called when `tags` are updated.
+	// Update is only called when `tags` or `wait_for_completion` are updated.
 	// Set unknowns to the old (in state) values.
 	new.CustomModelARN = old.CustomModelARN
 	new.JobStatus = old.JobStatus
 	new.TrainingMetrics = old.TrainingMetrics
 	new.ValidationMetrics = old.ValidationMetrics
 
+	// Enabling `wait_for_completion` waits for a customization job that is still in progress.
+	if new.WaitForCompletion.ValueBool() && !old.WaitForCompletion.ValueBool() && new.JobStatus.ValueEnum() == awstypes.ModelCustomizationJobStatusInProgress {
+		conn := r.Meta().BedrockClient(ctx)
+
+		response.Diagnostics.Append(r.waitForCompletion(ctx, conn, &new, r.UpdateTimeout(ctx, new.Timeouts))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -476,6 +510,46 @@ func (r *customModelResource) ModifyPlan(ctx context.Context, request resource.M
 	r.SetTagsAll(ctx, request, response)
 }
 
+// waitForCompletion waits for the customization job to complete and sets the output model's properties.
+func (r *customModelResource) waitForCompletion(ctx context.Context, conn *bedrock.Client, data *customModelResourceModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	jobARN := data.JobARN.ValueString()
+	job, err := waitModelCustomizationJobCompleted(ctx, conn, jobARN, timeout)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("waiting for Bedrock Custom Model customization job (%s) complete", jobARN), err.Error())
+
+		return diags
+	}
+
+	data.CustomModelARN = fwflex.StringToFramework(ctx, job.OutputModelArn)
+	data.JobStatus = fwtypes.StringEnumValue(job.Status)
+
+	if job.OutputModelArn != nil {
+		customModelARN := aws.ToString(job.OutputModelArn)
+		outputGM, err := findCustomModelByID(ctx, conn, customModelARN)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading Bedrock Custom Model (%s)", customModelARN), err.Error())
+
+			return diags
+		}
+
+		var dataFromGetCustomModel customModelResourceModel
+		diags.Append(fwflex.Flatten(ctx, outputGM, &dataFromGetCustomModel)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		data.CustomModelARN = fwflex.StringToFramework(ctx, outputGM.ModelArn)
+		data.TrainingMetrics = dataFromGetCustomModel.TrainingMetrics
+		data.ValidationMetrics = dataFromGetCustomModel.ValidationMetrics
+	}
+
+	return diags
+}
+
 func findCustomModelByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetCustomModelOutput, error) {
 	input := &bedrock.GetCustomModelInput{
 		ModelIdentifier: aws.String(id),
@@ -621,6 +695,7 @@ type customModelResourceModel struct {
 	ValidationDataConfig fwtypes.ListNestedObjectValueOf[validationDataConfigModel] `tfsdk:"validation_data_config"`
 	ValidationMetrics    fwtypes.ListNestedObjectValueOf[validatorMetricModel]      `tfsdk:"validation_metrics"`
 	VPCConfig            fwtypes.ListNestedObjectValueOf[vpcConfigModel]            `tfsdk:"vpc_config"`
+	WaitForCompletion    types.Bool                                                 `tfsdk:"wait_for_completion"`
 }
 
 func (data *customModelResourceModel) InitFromID() error {
@@ -39,13 +39,13 @@ func newProvisionedModelThroughputResource(context.Context) (resource.ResourceWi
 	r := &resourceProvisionedModelThroughput{}
 
 	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
 
 	return r, nil
 }
 
 type resourceProvisionedModelThroughput struct {
 	framework.ResourceWithConfigure
-	framework.WithNoOpUpdate[provisionedModelThroughputResourceModel]
 	framework.WithImportByID
 	framework.WithTimeouts
 }
@@ -64,9 +64,6 @@ func (r *resourceProvisionedModelThroughput) Schema(ctx context.Context, request
 			"model_arn": schema.StringAttribute{
 				Required:   true,
 				CustomType: fwtypes.ARNType,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"model_units": schema.Int64Attribute{
 				Required: true,
@@ -77,9 +74,6 @@ func (r *resourceProvisionedModelThroughput) Schema(ctx context.Context, request
 			"provisioned_model_arn": framework.ARNAttributeComputedOnly(),
 			"provisioned_model_name": schema.StringAttribute{
 				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
@@ -87,6 +81,7 @@ func (r *resourceProvisionedModelThroughput) Schema(ctx context.Context, request
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 			}),
 		},
 	}
@@ -172,6 +167,50 @@ func (r *resourceProvisionedModelThroughput) Read(ctx context.Context, request r
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+func (r *resourceProvisionedModelThroughput) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new provisionedModelThroughputResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	if !new.ModelARN.Equal(old.ModelARN) || !new.ProvisionedModelName.Equal(old.ProvisionedModelName) {
+		input := bedrock.UpdateProvisionedModelThroughputInput{
+			ProvisionedModelId: fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		if !new.ModelARN.Equal(old.ModelARN) {
+			input.DesiredModelId = fwflex.StringFromFramework(ctx, new.ModelARN)
+		}
+
+		if !new.ProvisionedModelName.Equal(old.ProvisionedModelName) {
+			input.DesiredProvisionedModelName = fwflex.StringFromFramework(ctx, new.ProvisionedModelName)
+		}
+
+		_, err := conn.UpdateProvisionedModelThroughput(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Provisioned Model Throughput (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitProvisionedModelThroughputUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Provisioned Model Throughput (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
 func (r *resourceProvisionedModelThroughput) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	var data provisionedModelThroughputResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
@@ -261,6 +300,25 @@ func waitProvisionedModelThroughputCreated(ctx context.Context, conn *bedrock.Cl
 	return nil, err
 }
 
+func waitProvisionedModelThroughputUpdated(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetProvisionedModelThroughputOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProvisionedModelStatusUpdating),
+		Target:  enum.Slice(awstypes.ProvisionedModelStatusInService),
+		Refresh: statusProvisionedModelThroughput(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetProvisionedModelThroughputOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 type provisionedModelThroughputResourceModel struct {
 	CommitmentDuration   fwtypes.StringEnum[awstypes.CommitmentDuration] `tfsdk:"commitment_duration"`
 	ID                   types.String                                    `tfsdk:"id"`
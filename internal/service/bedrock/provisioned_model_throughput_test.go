@@ -68,6 +68,44 @@ func TestAccBedrockProvisionedModelThroughput_basic(t *testing.T) {
 	})
 }
 
+func TestAccBedrockProvisionedModelThroughput_update(t *testing.T) {
+	acctest.Skip(t, "Bedrock Provisioned Model Throughput has a minimum 1 month commitment and costs > $10K/month")
+
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_provisioned_model_throughput.test"
+	var v bedrock.GetProvisionedModelThroughputOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedModelThroughputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedModelThroughputConfig_basic(rName1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_model_name", rName1),
+				),
+			},
+			{
+				Config: testAccProvisionedModelThroughputConfig_basic(rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_model_name", rName2),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
 // TODO TestAccBedrockProvisionedModelThroughput_disappears
 // TODO TestAccBedrockProvisionedModelThroughput_tags
 
@@ -76,7 +114,7 @@ func testAccCheckProvisionedModelThroughputDestroy(ctx context.Context) resource
 		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "aws_bedrock_custom_model" {
+			if rs.Type != "aws_bedrock_provisioned_model_throughput" {
 				continue
 			}
 
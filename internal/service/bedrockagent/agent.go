@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -150,6 +151,10 @@ func (r *agentResource) Schema(ctx context.Context, request resource.SchemaReque
 					AttrTypes: fwtypes.AttributeTypesMust[promptOverrideConfigurationModel](ctx),
 				},
 			},
+			"prepared_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
 			"prepare_agent": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -369,6 +374,7 @@ func (r *agentResource) Update(ctx context.Context, request resource.UpdateReque
 		}
 	} else {
 		new.AgentVersion = old.AgentVersion
+		new.PreparedAt = old.PreparedAt
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
@@ -682,6 +688,7 @@ type agentResourceModel struct {
 	IdleSessionTTLInSeconds     types.Int64                                                       `tfsdk:"idle_session_ttl_in_seconds"`
 	Instruction                 types.String                                                      `tfsdk:"instruction"`
 	PrepareAgent                types.Bool                                                        `tfsdk:"prepare_agent"`
+	PreparedAt                  timetypes.RFC3339                                                 `tfsdk:"prepared_at"`
 	PromptOverrideConfiguration fwtypes.ListNestedObjectValueOf[promptOverrideConfigurationModel] `tfsdk:"prompt_override_configuration"`
 	SkipResourceInUseCheck      types.Bool                                                        `tfsdk:"skip_resource_in_use_check"`
 	Tags                        tftags.Map                                                        `tfsdk:"tags"`
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -206,6 +207,49 @@ func TestAccBedrockAgentAgentActionGroup_update(t *testing.T) {
 	})
 }
 
+func TestAccBedrockAgentAgentActionGroup_preparedAt(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_action_group.test"
+	agentResourceName := "aws_bedrockagent_agent.test"
+	var v awstypes.AgentActionGroup
+	var agent1, agent2 awstypes.Agent
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentActionGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentActionGroupConfig_description(rName, "Agent Action One"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName, &v),
+					testAccCheckAgentExists(ctx, agentResourceName, &agent1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Agent Action One"),
+					resource.TestCheckResourceAttr(resourceName, "prepare_agent", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(agentResourceName, "prepared_at"),
+				),
+			},
+			{
+				Config: testAccAgentActionGroupConfig_description(rName, "Agent Action Two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName, &v),
+					testAccCheckAgentExists(ctx, agentResourceName, &agent2),
+					testAccCheckAgentPreparedAtAdvanced(&agent1, &agent2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Agent Action Two"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(agentResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccBedrockAgentAgentActionGroup_FunctionSchema_memberFunctions(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -305,6 +349,21 @@ func testAccCheckAgentActionGroupDestroy(ctx context.Context) resource.TestCheck
 		return nil
 	}
 }
+
+func testAccCheckAgentPreparedAtAdvanced(before, after *awstypes.Agent) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.PreparedAt == nil || after.PreparedAt == nil {
+			return fmt.Errorf("Bedrock Agent (%s) has not been prepared", aws.ToString(after.AgentId))
+		}
+
+		if !after.PreparedAt.After(aws.ToTime(before.PreparedAt)) {
+			return fmt.Errorf("Bedrock Agent (%s) prepared_at did not advance: %s", aws.ToString(after.AgentId), after.PreparedAt)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAgentActionGroupExists(ctx context.Context, n string, v *awstypes.AgentActionGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -346,6 +405,26 @@ resource "aws_bedrockagent_agent_action_group" "test" {
 `, rName))
 }
 
+func testAccAgentActionGroupConfig_description(rName, description string) string {
+	return acctest.ConfigCompose(testAccAgentConfig_basic(rName, "anthropic.claude-v2", "basic claude"),
+		testAccAgentActionGroupConfig_lambda(rName),
+		fmt.Sprintf(`
+resource "aws_bedrockagent_agent_action_group" "test" {
+  action_group_name          = %[1]q
+  agent_id                   = aws_bedrockagent_agent.test.agent_id
+  agent_version              = "DRAFT"
+  description                = %[2]q
+  skip_resource_in_use_check = true
+  action_group_executor {
+    lambda = aws_lambda_function.test_lambda.arn
+  }
+  api_schema {
+    payload = file("${path.module}/test-fixtures/api_schema.yaml")
+  }
+}
+`, rName, description))
+}
+
 func testAccAgentActionGroupConfig_APISchema_s3(rName string) string {
 	return acctest.ConfigCompose(testAccAgentConfig_basic(rName, "anthropic.claude-v2", "basic claude"),
 		testAccAgentActionGroupConfig_lambda(rName),
@@ -226,12 +226,12 @@ func (r *agentAliasResource) Update(ctx context.Context, request resource.Update
 		_, err := conn.UpdateAgentAlias(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Alias (%s)", new.ID.String()), err.Error())
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Alias (%s)", new.ID.ValueString()), err.Error())
 
 			return
 		}
 
-		if _, err := waitAgentAliasUpdated(ctx, conn, new.AgentAliasID.ValueString(), new.AgentID.ValueString(), r.CreateTimeout(ctx, new.Timeouts)); err != nil {
+		if _, err := waitAgentAliasUpdated(ctx, conn, new.AgentAliasID.ValueString(), new.AgentID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent Alias (%s) update", new.ID.ValueString()), err.Error())
 
 			return
@@ -13,8 +13,11 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -37,13 +40,13 @@ func newAgentKnowledgeBaseAssociationResource(context.Context) (resource.Resourc
 
 	r.SetDefaultCreateTimeout(5 * time.Minute)
 	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
 
 	return r, nil
 }
 
 type agentKnowledgeBaseAssociationResource struct {
 	framework.ResourceWithConfigure
-	framework.WithImportByID
 	framework.WithTimeouts
 }
 
@@ -84,11 +87,20 @@ func (r *agentKnowledgeBaseAssociationResource) Schema(ctx context.Context, requ
 				Required:   true,
 				CustomType: fwtypes.StringEnumType[awstypes.KnowledgeBaseState](),
 			},
+			"prepare_agent": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
+				Delete: true,
 			}),
 		},
 	}
@@ -124,11 +136,12 @@ func (r *agentKnowledgeBaseAssociationResource) Create(ctx context.Context, requ
 	}
 	data.ID = types.StringValue(id)
 
-	_, err = prepareAgent(ctx, conn, data.AgentID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
-	if err != nil {
-		response.Diagnostics.AddError("preparing Agent", err.Error())
+	if data.PrepareAgent.ValueBool() {
+		if _, err := prepareAgent(ctx, conn, data.AgentID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError("preparing Agent", err.Error())
 
-		return
+			return
+		}
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
@@ -185,24 +198,28 @@ func (r *agentKnowledgeBaseAssociationResource) Update(ctx context.Context, requ
 
 	conn := r.Meta().BedrockAgentClient(ctx)
 
-	input := &bedrockagent.UpdateAgentKnowledgeBaseInput{}
-	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
+	if !new.Description.Equal(old.Description) || !new.KnowledgeBaseState.Equal(old.KnowledgeBaseState) {
+		input := &bedrockagent.UpdateAgentKnowledgeBaseInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 
-	_, err := conn.UpdateAgentKnowledgeBase(ctx, input)
+		_, err := conn.UpdateAgentKnowledgeBase(ctx, input)
 
-	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Knowledge Base Association (%s)", new.ID.ValueString()), err.Error())
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Knowledge Base Association (%s)", new.ID.ValueString()), err.Error())
 
-		return
-	}
-	_, err = prepareAgent(ctx, conn, new.AgentID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
-	if err != nil {
-		response.Diagnostics.AddError("preparing Agent", err.Error())
+			return
+		}
 
-		return
+		if new.PrepareAgent.ValueBool() {
+			if _, err := prepareAgent(ctx, conn, new.AgentID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError("preparing Agent", err.Error())
+
+				return
+			}
+		}
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
@@ -233,6 +250,20 @@ func (r *agentKnowledgeBaseAssociationResource) Delete(ctx context.Context, requ
 
 		return
 	}
+
+	if data.PrepareAgent.ValueBool() {
+		if _, err := prepareAgent(ctx, conn, data.AgentID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError("preparing Agent", err.Error())
+
+			return
+		}
+	}
+}
+
+func (r *agentKnowledgeBaseAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), req.ID)...)
+	// Set prepare_agent to default value on import
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prepare_agent"), true)...)
 }
 
 func findAgentKnowledgeBaseAssociationByThreePartKey(ctx context.Context, conn *bedrockagent.Client, agentID, agentVersion, knowledgeBaseID string) (*awstypes.AgentKnowledgeBase, error) {
@@ -269,6 +300,7 @@ type agentKnowledgeBaseAssociationResourceModel struct {
 	ID                 types.String                                    `tfsdk:"id"`
 	KnowledgeBaseID    types.String                                    `tfsdk:"knowledge_base_id"`
 	KnowledgeBaseState fwtypes.StringEnum[awstypes.KnowledgeBaseState] `tfsdk:"knowledge_base_state"`
+	PrepareAgent       types.Bool                                      `tfsdk:"prepare_agent"`
 	Timeouts           timeouts.Value                                  `tfsdk:"timeouts"`
 }
 
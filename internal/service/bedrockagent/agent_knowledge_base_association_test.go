@@ -58,6 +58,7 @@ func TestAccBedrockAgentAgentKnowledgeBaseAssociation_basic(t *testing.T) {
 					testAccCheckAgentKnowledgeBaseAssociationExists(ctx, resourceName, &agentknowledgebaseassociation),
 					resource.TestCheckResourceAttr(resourceName, "knowledge_base_state", "ENABLED"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test desc"),
+					resource.TestCheckResourceAttr(resourceName, "prepare_agent", acctest.CtTrue),
 				),
 			},
 			{
@@ -6,6 +6,9 @@ package ce
 import (
 	"context"
 	"log"
+	"reflect"
+	"slices"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
@@ -356,11 +359,13 @@ func resourceCostCategoryRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("effective_end", costCategory.EffectiveEnd)
 	d.Set("effective_start", costCategory.EffectiveStart)
 	d.Set(names.AttrName, costCategory.Name)
-	if err = d.Set(names.AttrRule, flattenCostCategoryRules(costCategory.Rules)); err != nil {
+	if err = d.Set(names.AttrRule, flattenCostCategoryRules(costCategoryRulesInConfiguredOrder(d.Get(names.AttrRule).([]interface{}), costCategory.Rules))); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 	}
 	d.Set("rule_version", costCategory.RuleVersion)
-	if err = d.Set("split_charge_rule", flattenCostCategorySplitChargeRules(costCategory.SplitChargeRules)); err != nil {
+	splitChargeRules := flattenCostCategorySplitChargeRules(costCategory.SplitChargeRules)
+	preserveEquivalentSplitChargeRuleParameterValues(d.Get("split_charge_rule").(*schema.Set).List(), splitChargeRules)
+	if err = d.Set("split_charge_rule", splitChargeRules); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting split_charge_rule: %s", err)
 	}
 
@@ -444,6 +449,94 @@ func findCostCategoryByARN(ctx context.Context, conn *costexplorer.Client, arn s
 	return output.CostCategory, nil
 }
 
+// costCategoryRulesInConfiguredOrder returns the rules in the order in which they are
+// configured if the API returned exactly the configured rules, e.g. INHERITED_VALUE rules
+// may be returned in a different position. Otherwise the API order is kept.
+func costCategoryRulesInConfiguredOrder(tfList []interface{}, apiObjects []awstypes.CostCategoryRule) []awstypes.CostCategoryRule {
+	configured := expandCostCategoryRules(tfList)
+
+	if len(configured) != len(apiObjects) {
+		return apiObjects
+	}
+
+	remaining := slices.Clone(apiObjects)
+	ordered := make([]awstypes.CostCategoryRule, 0, len(apiObjects))
+
+	for _, v := range configured {
+		tfMap := flattenCostCategoryRule(&v)
+		i := slices.IndexFunc(remaining, func(apiObject awstypes.CostCategoryRule) bool {
+			return reflect.DeepEqual(tfMap, flattenCostCategoryRule(&apiObject))
+		})
+
+		if i == -1 {
+			return apiObjects
+		}
+
+		ordered = append(ordered, remaining[i])
+		remaining = slices.Delete(remaining, i, i+1)
+	}
+
+	return ordered
+}
+
+// preserveEquivalentSplitChargeRuleParameterValues keeps the configured representation of
+// split charge rule parameter values that are numerically equal to those returned by the
+// API, which normalizes them (e.g. "0.50" is returned as "0.5").
+func preserveEquivalentSplitChargeRuleParameterValues(tfList []interface{}, flattened []map[string]interface{}) {
+	values := make(map[float64]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap[names.AttrParameter].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			v, ok := tfMap[names.AttrValues].([]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, v := range v {
+				if v, ok := v.(string); ok {
+					if f, err := strconv.ParseFloat(v, 64); err == nil {
+						values[f] = v
+					}
+				}
+			}
+		}
+	}
+
+	for _, tfMap := range flattened {
+		for _, tfMap := range tfMap[names.AttrParameter].([]map[string]interface{}) {
+			v, ok := tfMap[names.AttrValues].([]string)
+			if !ok {
+				continue
+			}
+
+			v = slices.Clone(v)
+			for i, value := range v {
+				if f, err := strconv.ParseFloat(value, 64); err == nil {
+					if configured, ok := values[f]; ok {
+						v[i] = configured
+					}
+				}
+			}
+			tfMap[names.AttrValues] = v
+		}
+	}
+}
+
 func expandCostCategoryRule(tfMap map[string]interface{}) *awstypes.CostCategoryRule {
 	apiObject := &awstypes.CostCategoryRule{}
 
@@ -222,6 +222,42 @@ func TestAccCECostCategory_splitCharge(t *testing.T) {
 	})
 }
 
+func TestAccCECostCategory_splitChargeFixedInheritedValue(t *testing.T) {
+	ctx := acctest.Context(t)
+	var output awstypes.CostCategory
+	resourceName := "aws_ce_cost_category.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckPayerAccount(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCostCategoryDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryConfig_splitChargeFixedInheritedValue(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(ctx, resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.type", "REGULAR"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.type", "INHERITED_VALUE"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.inherited_value.0.dimension_name", "LINKED_ACCOUNT_NAME"),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "split_charge_rule.*.parameter.*", map[string]string{
+						names.AttrType: "ALLOCATION_PERCENTAGES",
+						"values.#":     "1",
+						"values.0":     "100.00",
+					}),
+				),
+			},
+			{
+				Config:   testAccCostCategoryConfig_splitChargeFixedInheritedValue(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccCECostCategory_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var output awstypes.CostCategory
@@ -523,6 +559,86 @@ resource "aws_ce_cost_category" "test" {
 `, rName, method)
 }
 
+func testAccCostCategoryConfig_splitChargeFixedInheritedValue(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test1" {
+  name         = "%[1]s-1"
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+
+    type = "REGULAR"
+  }
+}
+
+resource "aws_ce_cost_category" "test2" {
+  name         = "%[1]s-2"
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "staging"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-stg"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+
+    type = "REGULAR"
+  }
+}
+
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+
+    type = "REGULAR"
+  }
+
+  rule {
+    inherited_value {
+      dimension_name = "LINKED_ACCOUNT_NAME"
+    }
+
+    type = "INHERITED_VALUE"
+  }
+
+  split_charge_rule {
+    method  = "FIXED"
+    source  = aws_ce_cost_category.test1.id
+    targets = [aws_ce_cost_category.test2.id]
+
+    parameter {
+      type   = "ALLOCATION_PERCENTAGES"
+      values = ["100.00"]
+    }
+  }
+}
+`, rName)
+}
+
 func testAccCostCategoryConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ce_cost_category" "test" {
@@ -95,6 +95,7 @@ func ResourceMediaInsightsPipelineConfiguration() *schema.Resource {
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"resource_access_role_arn": {
 				Type:         schema.TypeString,
@@ -550,10 +551,8 @@ func resourceMediaInsightsPipelineConfigurationRead(ctx context.Context, d *sche
 	if err := d.Set("elements", flattenElements(out.Elements)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting elements: %s", err)
 	}
-	if out.RealTimeAlertConfiguration != nil {
-		if err := d.Set("real_time_alert_configuration", flattenRealTimeAlertConfiguration(out.RealTimeAlertConfiguration)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting real time alert configuration: %s", err)
-		}
+	if err := d.Set("real_time_alert_configuration", flattenRealTimeAlertConfiguration(out.RealTimeAlertConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting real time alert configuration: %s", err)
 	}
 
 	return diags
@@ -1047,11 +1046,8 @@ func flattenRealTimeAlertConfiguration(apiConfiguration *awstypes.RealTimeAlertC
 	}
 	tfMap := map[string]interface{}{}
 	tfMap["disabled"] = apiConfiguration.Disabled
-	if apiConfiguration.Rules == nil {
-		return []interface{}{}
-	}
-	var tfRules []interface{}
 
+	var tfRules []interface{}
 	for _, apiRule := range apiConfiguration.Rules {
 		if apiRule == (awstypes.RealTimeAlertRule{}) {
 			continue
@@ -1067,27 +1063,39 @@ func flattenRealTimeAlertRule(apiRule awstypes.RealTimeAlertRule) interface{} {
 		return nil
 	}
 	tfMap := map[string]interface{}{}
-	tfMap[names.AttrType] = string(apiRule.Type)
+	ruleType := apiRule.Type
 
 	configuration := map[string]interface{}{}
 
+	// The rule is a union; key off the populated member so the rule type survives a read even if Type is omitted.
 	switch {
-	case apiRule.Type == awstypes.RealTimeAlertRuleTypeIssueDetection:
+	case apiRule.IssueDetectionConfiguration != nil:
 		issueDetectionConfiguration := apiRule.IssueDetectionConfiguration
-		configuration["rule_name"] = issueDetectionConfiguration.RuleName
+		configuration["rule_name"] = aws.ToString(issueDetectionConfiguration.RuleName)
 		tfMap["issue_detection_configuration"] = []interface{}{configuration}
-	case apiRule.Type == awstypes.RealTimeAlertRuleTypeKeywordMatch:
+		if ruleType == "" {
+			ruleType = awstypes.RealTimeAlertRuleTypeIssueDetection
+		}
+	case apiRule.KeywordMatchConfiguration != nil:
 		keywordMatchConfiguration := apiRule.KeywordMatchConfiguration
-		configuration["rule_name"] = keywordMatchConfiguration.RuleName
+		configuration["rule_name"] = aws.ToString(keywordMatchConfiguration.RuleName)
 		configuration["keywords"] = keywordMatchConfiguration.Keywords
 		configuration["negate"] = keywordMatchConfiguration.Negate
 		tfMap["keyword_match_configuration"] = []interface{}{configuration}
-	case apiRule.Type == awstypes.RealTimeAlertRuleTypeSentiment:
+		if ruleType == "" {
+			ruleType = awstypes.RealTimeAlertRuleTypeKeywordMatch
+		}
+	case apiRule.SentimentConfiguration != nil:
 		sentimentConfiguration := apiRule.SentimentConfiguration
-		configuration["rule_name"] = sentimentConfiguration.RuleName
-		configuration["sentiment_type"] = sentimentConfiguration.SentimentType
-		configuration["time_period"] = sentimentConfiguration.TimePeriod
+		configuration["rule_name"] = aws.ToString(sentimentConfiguration.RuleName)
+		configuration["sentiment_type"] = string(sentimentConfiguration.SentimentType)
+		configuration["time_period"] = aws.ToInt32(sentimentConfiguration.TimePeriod)
 		tfMap["sentiment_configuration"] = []interface{}{configuration}
+		if ruleType == "" {
+			ruleType = awstypes.RealTimeAlertRuleTypeSentiment
+		}
 	}
+
+	tfMap[names.AttrType] = string(ruleType)
 	return tfMap
 }
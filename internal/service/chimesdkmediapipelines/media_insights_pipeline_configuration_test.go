@@ -15,6 +15,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -198,6 +199,65 @@ func TestAccChimeSDKMediaPipelinesMediaInsightsPipelineConfiguration_updateAllPr
 	})
 }
 
+func TestAccChimeSDKMediaPipelinesMediaInsightsPipelineConfiguration_updateRealTimeAlertRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.MediaInsightsPipelineConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	streamName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_insights_pipeline_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ChimeSDKMediaPipelinesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChimeSDKMediaPipelinesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaInsightsPipelineConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaInsightsPipelineConfigurationConfig_keywordMatchRule(rName, roleName, streamName, "keyword1", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaInsightsPipelineConfigurationExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.0.type", "KeywordMatch"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.0.keyword_match_configuration.0.keywords.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.0.keyword_match_configuration.0.keywords.0", "keyword1"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.0.keyword_match_configuration.0.negate", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.1.type", "Sentiment"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.1.sentiment_configuration.0.sentiment_type", "NEGATIVE"),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "chime", fmt.Sprintf(`media-insights-pipeline-configuration/%s`, rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaInsightsPipelineConfigurationConfig_keywordMatchRule(rName, roleName, streamName, "keyword2", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaInsightsPipelineConfigurationExists(ctx, resourceName, &v2),
+					testAccCheckMediaInsightsPipelineConfigurationNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.0.type", "KeywordMatch"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.0.keyword_match_configuration.0.keywords.0", "keyword2"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.0.keyword_match_configuration.0.negate", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.1.type", "Sentiment"),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "chime", fmt.Sprintf(`media-insights-pipeline-configuration/%s`, rName)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccChimeSDKMediaPipelinesMediaInsightsPipelineConfiguration_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var mipc awstypes.MediaInsightsPipelineConfiguration
@@ -558,6 +618,52 @@ resource "aws_chimesdkmediapipelines_media_insights_pipeline_configuration" "tes
 `, rName))
 }
 
+func testAccMediaInsightsPipelineConfigurationConfig_keywordMatchRule(rName, roleName, streamName, keyword string, negate bool) string {
+	return acctest.ConfigCompose(
+		testAccMediaInsightsPipelineConfigurationConfigBase(roleName, streamName),
+		fmt.Sprintf(`
+resource "aws_chimesdkmediapipelines_media_insights_pipeline_configuration" "test" {
+  name                     = %[1]q
+  resource_access_role_arn = aws_iam_role.test.arn
+  elements {
+    type = "AmazonTranscribeCallAnalyticsProcessor"
+    amazon_transcribe_call_analytics_processor_configuration {
+      language_code = "en-US"
+    }
+  }
+
+  elements {
+    type = "KinesisDataStreamSink"
+    kinesis_data_stream_sink_configuration {
+      insights_target = aws_kinesis_stream.test.arn
+    }
+  }
+
+  real_time_alert_configuration {
+    disabled = false
+
+    rules {
+      type = "KeywordMatch"
+      keyword_match_configuration {
+        keywords  = [%[2]q]
+        negate    = %[3]t
+        rule_name = "MyKeywordMatchRule"
+      }
+    }
+
+    rules {
+      type = "Sentiment"
+      sentiment_configuration {
+        rule_name      = "MySentimentRule"
+        sentiment_type = "NEGATIVE"
+        time_period    = 60
+      }
+    }
+  }
+}
+`, rName, keyword, negate))
+}
+
 func testAccMediaInsightsPipelineConfigurationConfig_tags1(rName, roleName, streamName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccMediaInsightsPipelineConfigurationConfigBase(roleName, streamName),
@@ -0,0 +1,627 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameConfiguredTableAnalysisRule = "Configured Table Analysis Rule"
+
+	configuredTableAnalysisRuleIDPartCount = 2
+)
+
+// @FrameworkResource("aws_cleanrooms_configured_table_analysis_rule", name="Configured Table Analysis Rule")
+func newResourceConfiguredTableAnalysisRule(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceConfiguredTableAnalysisRule{}
+
+	return r, nil
+}
+
+type resourceConfiguredTableAnalysisRule struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	stringList := func(required bool) schema.ListAttribute {
+		return schema.ListAttribute{
+			CustomType:  fwtypes.ListOfStringType,
+			ElementType: types.StringType,
+			Required:    required,
+			Optional:    !required,
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"analysis_rule_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfiguredTableAnalysisRuleType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configured_table_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"configured_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrPolicy: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRulePolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aggregation": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleAggregationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("aggregation"),
+									path.MatchRelative().AtParent().AtName("custom"),
+									path.MatchRelative().AtParent().AtName("list"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"additional_analyses": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AdditionalAnalyses](),
+										Optional:   true,
+										Computed:   true,
+									},
+									"allowed_join_operators": schema.ListAttribute{
+										CustomType: fwtypes.ListOfStringEnumType[awstypes.JoinOperator](),
+										Optional:   true,
+										Computed:   true,
+									},
+									"dimension_columns": stringList(true),
+									"join_columns":      stringList(true),
+									"join_required": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.JoinRequiredOption](),
+										Optional:   true,
+									},
+									"scalar_functions": schema.ListAttribute{
+										CustomType: fwtypes.ListOfStringEnumType[awstypes.ScalarFunctions](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"aggregate_column": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[aggregateColumnModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"column_names": stringList(true),
+												"function": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.AggregateFunctionName](),
+													Required:   true,
+												},
+											},
+										},
+									},
+									"output_constraint": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[aggregationConstraintModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"column_name": schema.StringAttribute{
+													Required: true,
+												},
+												"minimum": schema.Int64Attribute{
+													Required: true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.AggregationType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"custom": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleCustomModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"additional_analyses": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AdditionalAnalyses](),
+										Optional:   true,
+										Computed:   true,
+									},
+									"allowed_analyses":           stringList(true),
+									"allowed_analysis_providers": stringList(false),
+									"disallowed_output_columns":  stringList(false),
+								},
+								Blocks: map[string]schema.Block{
+									"differential_privacy": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"column": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyColumnModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrName: schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"list": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleListModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"additional_analyses": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AdditionalAnalyses](),
+										Optional:   true,
+										Computed:   true,
+									},
+									"allowed_join_operators": schema.ListAttribute{
+										CustomType: fwtypes.ListOfStringEnumType[awstypes.JoinOperator](),
+										Optional:   true,
+										Computed:   true,
+									},
+									"join_columns": stringList(true),
+									"list_columns": stringList(true),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := flex.FlattenResourceId([]string{data.ConfiguredTableID.ValueString(), data.AnalysisRuleType.ValueString()}, configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionFlatteningResourceId, ResNameConfiguredTableAnalysisRule, data.ConfiguredTableID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	input := cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          data.AnalysisRuleType.ValueEnum(),
+		ConfiguredTableIdentifier: data.ConfiguredTableID.ValueStringPointer(),
+	}
+
+	input.AnalysisRulePolicy = expandConfiguredTableAnalysisRulePolicy(ctx, data.Policy, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateConfiguredTableAnalysisRule(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output.AnalysisRule)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionExpandingResourceId, ResNameConfiguredTableAnalysisRule, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Policy.Equal(state.Policy) {
+		input := cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+			AnalysisRuleType:          plan.AnalysisRuleType.ValueEnum(),
+			ConfiguredTableIdentifier: plan.ConfiguredTableID.ValueStringPointer(),
+		}
+
+		input.AnalysisRulePolicy = expandConfiguredTableAnalysisRulePolicy(ctx, plan.Policy, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateConfiguredTableAnalysisRule(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		response.Diagnostics.Append(plan.refreshFromOutput(ctx, output.AnalysisRule)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.UpdateTime = state.UpdateTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms Configured Table Analysis Rule", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          data.AnalysisRuleType.ValueEnum(),
+		ConfiguredTableIdentifier: data.ConfiguredTableID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteConfiguredTableAnalysisRule(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, data.ID.ValueString(), err),
+			err.Error(),
+		)
+	}
+}
+
+type resourceConfiguredTableAnalysisRuleData struct {
+	AnalysisRuleType   fwtypes.StringEnum[awstypes.ConfiguredTableAnalysisRuleType] `tfsdk:"analysis_rule_type"`
+	ConfiguredTableARN types.String                                                 `tfsdk:"configured_table_arn"`
+	ConfiguredTableID  types.String                                                 `tfsdk:"configured_table_id"`
+	CreateTime         timetypes.RFC3339                                            `tfsdk:"create_time"`
+	ID                 types.String                                                 `tfsdk:"id"`
+	Policy             fwtypes.ListNestedObjectValueOf[analysisRulePolicyModel]     `tfsdk:"policy"`
+	UpdateTime         timetypes.RFC3339                                            `tfsdk:"update_time"`
+}
+
+func (m *resourceConfiguredTableAnalysisRuleData) refreshFromOutput(ctx context.Context, output *awstypes.ConfiguredTableAnalysisRule) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, output, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.AnalysisRuleType = fwtypes.StringEnumValue(output.Type)
+
+	return diags
+}
+
+var (
+	_ fwflex.Expander  = analysisRulePolicyModel{}
+	_ fwflex.Flattener = (*analysisRulePolicyModel)(nil)
+)
+
+type analysisRulePolicyModel struct {
+	Aggregation fwtypes.ListNestedObjectValueOf[analysisRuleAggregationModel] `tfsdk:"aggregation"`
+	Custom      fwtypes.ListNestedObjectValueOf[analysisRuleCustomModel]      `tfsdk:"custom"`
+	List        fwtypes.ListNestedObjectValueOf[analysisRuleListModel]        `tfsdk:"list"`
+}
+
+type analysisRuleAggregationModel struct {
+	AdditionalAnalyses   fwtypes.StringEnum[awstypes.AdditionalAnalyses]                   `tfsdk:"additional_analyses"`
+	AggregateColumns     fwtypes.ListNestedObjectValueOf[aggregateColumnModel]             `tfsdk:"aggregate_column"`
+	AllowedJoinOperators fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.JoinOperator]]    `tfsdk:"allowed_join_operators"`
+	DimensionColumns     fwtypes.ListOfString                                              `tfsdk:"dimension_columns"`
+	JoinColumns          fwtypes.ListOfString                                              `tfsdk:"join_columns"`
+	JoinRequired         fwtypes.StringEnum[awstypes.JoinRequiredOption]                   `tfsdk:"join_required"`
+	OutputConstraints    fwtypes.ListNestedObjectValueOf[aggregationConstraintModel]       `tfsdk:"output_constraint"`
+	ScalarFunctions      fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.ScalarFunctions]] `tfsdk:"scalar_functions"`
+}
+
+type aggregateColumnModel struct {
+	ColumnNames fwtypes.ListOfString                               `tfsdk:"column_names"`
+	Function    fwtypes.StringEnum[awstypes.AggregateFunctionName] `tfsdk:"function"`
+}
+
+type aggregationConstraintModel struct {
+	ColumnName types.String                                 `tfsdk:"column_name"`
+	Minimum    types.Int64                                  `tfsdk:"minimum"`
+	Type       fwtypes.StringEnum[awstypes.AggregationType] `tfsdk:"type"`
+}
+
+type analysisRuleCustomModel struct {
+	AdditionalAnalyses       fwtypes.StringEnum[awstypes.AdditionalAnalyses]                        `tfsdk:"additional_analyses"`
+	AllowedAnalyses          fwtypes.ListOfString                                                   `tfsdk:"allowed_analyses"`
+	AllowedAnalysisProviders fwtypes.ListOfString                                                   `tfsdk:"allowed_analysis_providers"`
+	DifferentialPrivacy      fwtypes.ListNestedObjectValueOf[differentialPrivacyConfigurationModel] `tfsdk:"differential_privacy"`
+	DisallowedOutputColumns  fwtypes.ListOfString                                                   `tfsdk:"disallowed_output_columns"`
+}
+
+type differentialPrivacyConfigurationModel struct {
+	Columns fwtypes.ListNestedObjectValueOf[differentialPrivacyColumnModel] `tfsdk:"column"`
+}
+
+type differentialPrivacyColumnModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type analysisRuleListModel struct {
+	AdditionalAnalyses   fwtypes.StringEnum[awstypes.AdditionalAnalyses]                `tfsdk:"additional_analyses"`
+	AllowedJoinOperators fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.JoinOperator]] `tfsdk:"allowed_join_operators"`
+	JoinColumns          fwtypes.ListOfString                                           `tfsdk:"join_columns"`
+	ListColumns          fwtypes.ListOfString                                           `tfsdk:"list_columns"`
+}
+
+func (m analysisRulePolicyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	var v1 awstypes.ConfiguredTableAnalysisRulePolicyV1
+
+	switch {
+	case !m.Aggregation.IsNull():
+		data, d := m.Aggregation.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberAggregation
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		v1 = &r
+	case !m.Custom.IsNull():
+		data, d := m.Custom.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberCustom
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		v1 = &r
+	case !m.List.IsNull():
+		data, d := m.List.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberList
+		diags.Append(fwflex.Expand(ctx, data, &r.Value)...)
+		v1 = &r
+	default:
+		return nil, diags
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &awstypes.ConfiguredTableAnalysisRulePolicyMemberV1{Value: v1}, diags
+}
+
+func (m *analysisRulePolicyModel) Flatten(ctx context.Context, input any) (diags diag.Diagnostics) {
+	m.Aggregation = fwtypes.NewListNestedObjectValueOfNull[analysisRuleAggregationModel](ctx)
+	m.Custom = fwtypes.NewListNestedObjectValueOfNull[analysisRuleCustomModel](ctx)
+	m.List = fwtypes.NewListNestedObjectValueOfNull[analysisRuleListModel](ctx)
+
+	var v1 awstypes.ConfiguredTableAnalysisRulePolicyV1
+	switch t := input.(type) {
+	case awstypes.ConfiguredTableAnalysisRulePolicyMemberV1:
+		v1 = t.Value
+	case *awstypes.ConfiguredTableAnalysisRulePolicyMemberV1:
+		v1 = t.Value
+	default:
+		return diags
+	}
+
+	switch t := v1.(type) {
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+		var model analysisRuleAggregationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Aggregation = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberCustom:
+		var model analysisRuleCustomModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Custom = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberList:
+		var model analysisRuleListModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.List = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+func expandConfiguredTableAnalysisRulePolicy(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[analysisRulePolicyModel], diags *diag.Diagnostics) awstypes.ConfiguredTableAnalysisRulePolicy {
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil
+	}
+
+	v, d := data.Expand(ctx)
+	diags.Append(d...)
+	if diags.HasError() || v == nil {
+		return nil
+	}
+
+	return v.(awstypes.ConfiguredTableAnalysisRulePolicy)
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID, analysisRuleType string) (*awstypes.ConfiguredTableAnalysisRule, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          awstypes.ConfiguredTableAnalysisRuleType(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AnalysisRule, nil
+}
@@ -5,7 +5,11 @@ package cleanrooms
 
 // Exports for use in tests only.
 var (
-	ResourceMembership = newResourceMembership
+	ResourceConfiguredTableAnalysisRule = newResourceConfiguredTableAnalysisRule
+	ResourceConfiguredTableAssociation  = newResourceConfiguredTableAssociation
+	ResourceMembership                  = newResourceMembership
 
-	FindMembershipByID = findMembershipByID
+	FindConfiguredTableAnalysisRuleByTwoPartKey = findConfiguredTableAnalysisRuleByTwoPartKey
+	FindConfiguredTableAssociationByTwoPartKey  = findConfiguredTableAssociationByTwoPartKey
+	FindMembershipByID                          = findMembershipByID
 )
@@ -8,7 +8,9 @@ import (
 )
 
 const (
-	errCodeValidationError = "ValidationError"
+	errCodeTypeConfigurationNotFoundException = "TypeConfigurationNotFoundException"
+	errCodeTypeNotFoundException              = "TypeNotFoundException"
+	errCodeValidationError                    = "ValidationError"
 )
 
 func isRetryableIAMPropagationErr(err error) (bool, error) {
@@ -5,16 +5,18 @@ package cloudformation
 
 // Exports for use in tests only.
 var (
-	ResourceStack            = resourceStack
-	ResourceStackSet         = resourceStackSet
-	ResourceStackSetInstance = resourceStackSetInstance
-	ResourceStackInstances   = resourceStackInstances
-	ResourceType             = resourceType
+	ResourceStack             = resourceStack
+	ResourceStackSet          = resourceStackSet
+	ResourceStackSetInstance  = resourceStackSetInstance
+	ResourceStackInstances    = resourceStackInstances
+	ResourceType              = resourceType
+	ResourceTypeConfiguration = resourceTypeConfiguration
 
 	FindStackInstanceByFourPartKey          = findStackInstanceByFourPartKey
 	FindStackInstanceSummariesByFourPartKey = findStackInstanceSummariesByFourPartKey
 	FindStackSetByName                      = findStackSetByName
 	FindTypeByARN                           = findTypeByARN
+	FindTypeConfigurationByARN              = findTypeConfigurationByARN
 	FindStackInstancesByNameCallAs          = findStackInstancesByNameCallAs
 	StackSetInstanceResourceIDPartCount     = stackSetInstanceResourceIDPartCount
 	StackInstancesResourceIDPartCount       = stackInstancesResourceIDPartCount
@@ -72,6 +72,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_cloudformation_type",
 			Name:     "Type",
 		},
+		{
+			Factory:  resourceTypeConfiguration,
+			TypeName: "aws_cloudformation_type_configuration",
+			Name:     "Type Configuration",
+		},
 	}
 }
 
@@ -5,6 +5,7 @@ package cloudformation
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -33,6 +34,7 @@ func resourceType() *schema.Resource {
 		CreateWithoutTimeout: resourceTypeCreate,
 		DeleteWithoutTimeout: resourceTypeDelete,
 		ReadWithoutTimeout:   resourceTypeRead,
+		UpdateWithoutTimeout: resourceTypeUpdate,
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -98,6 +100,11 @@ func resourceType() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"set_as_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"source_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -178,6 +185,12 @@ func resourceTypeCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	// Type Version ARN is not available until after registration is complete
 	d.SetId(aws.ToString(registrationOutput.TypeVersionArn))
 
+	if d.Get("set_as_default").(bool) {
+		if err := setTypeDefaultVersion(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceTypeRead(ctx, d, meta)...)
 }
 
@@ -228,6 +241,20 @@ func resourceTypeRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	return diags
 }
 
+func resourceTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	// Unsetting the flag leaves the current default version in place.
+	if d.HasChange("set_as_default") && d.Get("set_as_default").(bool) {
+		if err := setTypeDefaultVersion(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceTypeRead(ctx, d, meta)...)
+}
+
 func resourceTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
@@ -294,6 +321,26 @@ func resourceTypeDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+func setTypeDefaultVersion(ctx context.Context, conn *cloudformation.Client, typeVersionARN string) error {
+	typeARN, versionID, err := typeVersionARNToTypeARNAndVersionID(typeVersionARN)
+	if err != nil {
+		return err
+	}
+
+	input := &cloudformation.SetTypeDefaultVersionInput{
+		Arn:       aws.String(typeARN),
+		VersionId: aws.String(versionID),
+	}
+
+	_, err = conn.SetTypeDefaultVersion(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("setting CloudFormation Type (%s) default version: %w", typeVersionARN, err)
+	}
+
+	return nil
+}
+
 func findTypeByARN(ctx context.Context, conn *cloudformation.Client, arn string) (*cloudformation.DescribeTypeOutput, error) {
 	input := &cloudformation.DescribeTypeInput{
 		Arn: aws.String(arn),
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudformation_type_configuration", name="Type Configuration")
+func resourceTypeConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTypeConfigurationCreate,
+		ReadWithoutTimeout:   resourceTypeConfigurationRead,
+		UpdateWithoutTimeout: resourceTypeConfigurationUpdate,
+		DeleteWithoutTimeout: resourceTypeConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrConfiguration: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"configuration_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ThirdPartyType](),
+				RequiredWith:     []string{"type_name"},
+			},
+			"type_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"type_arn", "type_name"},
+			},
+			"type_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{names.AttrType},
+			},
+		},
+	}
+}
+
+func resourceTypeConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	input := &cloudformation.SetTypeConfigurationInput{
+		Configuration: aws.String(d.Get(names.AttrConfiguration).(string)),
+	}
+
+	if v, ok := d.GetOk("configuration_alias"); ok {
+		input.ConfigurationAlias = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrType); ok {
+		input.Type = awstypes.ThirdPartyType(v.(string))
+	}
+
+	if v, ok := d.GetOk("type_arn"); ok {
+		input.TypeArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type_name"); ok {
+		input.TypeName = aws.String(v.(string))
+	}
+
+	output, err := conn.SetTypeConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting CloudFormation Type Configuration: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.ConfigurationArn))
+
+	return append(diags, resourceTypeConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceTypeConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	output, err := findTypeConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFormation Type Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation Type Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrConfiguration, output.Configuration)
+	d.Set("configuration_alias", output.Alias)
+	d.Set("type_arn", output.TypeArn)
+	d.Set("type_name", output.TypeName)
+
+	return diags
+}
+
+func resourceTypeConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	input := &cloudformation.SetTypeConfigurationInput{
+		Configuration:      aws.String(d.Get(names.AttrConfiguration).(string)),
+		ConfigurationAlias: aws.String(d.Get("configuration_alias").(string)),
+		TypeArn:            aws.String(d.Get("type_arn").(string)),
+	}
+
+	_, err := conn.SetTypeConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating CloudFormation Type Configuration (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTypeConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceTypeConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// There is no API to remove an extension's configuration.
+	log.Printf("[WARN] CloudFormation Type Configuration (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func findTypeConfigurationByARN(ctx context.Context, conn *cloudformation.Client, arn string) (*awstypes.TypeConfigurationDetails, error) {
+	input := &cloudformation.BatchDescribeTypeConfigurationsInput{
+		TypeConfigurationIdentifiers: []awstypes.TypeConfigurationIdentifier{{
+			TypeConfigurationArn: aws.String(arn),
+		}},
+	}
+
+	output, err := conn.BatchDescribeTypeConfigurations(ctx, input)
+
+	if errs.IsA[*awstypes.TypeConfigurationNotFoundException](err) || errs.IsA[*awstypes.TypeNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Configurations of a deregistered type are reported as per-item errors.
+	for _, v := range output.Errors {
+		switch code := aws.ToString(v.ErrorCode); code {
+		case errCodeTypeConfigurationNotFoundException, errCodeTypeNotFoundException:
+			return nil, &retry.NotFoundError{
+				LastRequest: input,
+				Message:     aws.ToString(v.ErrorMessage),
+			}
+		default:
+			return nil, fmt.Errorf("%s: %s", code, aws.ToString(v.ErrorMessage))
+		}
+	}
+
+	return tfresource.AssertSingleValueResult(output.TypeConfigurations)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationTypeConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	typeName := fmt.Sprintf("HashiCorp::TerraformAwsProvider::TfAccTest%s", sdkacctest.RandString(8))
+	zipPath := testAccTypeZipGenerator(t, typeName)
+	resourceName := "aws_cloudformation_type_configuration.test"
+	typeResourceName := "aws_cloudformation_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypeConfigurationConfig_basic(rName, zipPath, typeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTypeConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrConfiguration, "{}"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_alias", "default"),
+					resource.TestCheckResourceAttrPair(resourceName, "type_arn", typeResourceName, "type_arn"),
+					resource.TestCheckResourceAttr(resourceName, "type_name", typeName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationTypeConfiguration_typeDisappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	typeName := fmt.Sprintf("HashiCorp::TerraformAwsProvider::TfAccTest%s", sdkacctest.RandString(8))
+	zipPath := testAccTypeZipGenerator(t, typeName)
+	resourceName := "aws_cloudformation_type_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypeConfigurationConfig_basic(rName, zipPath, typeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTypeConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudformation.ResourceType(), "aws_cloudformation_type.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTypeConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationClient(ctx)
+
+		_, err := tfcloudformation.FindTypeConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccTypeConfigurationConfig_basic(rName string, zipPath string, typeName string) string {
+	return acctest.ConfigCompose(
+		testAccTypeConfig_name(rName, zipPath, typeName),
+		`
+resource "aws_cloudformation_type_configuration" "test" {
+  type_arn            = aws_cloudformation_type.test.type_arn
+  configuration       = jsonencode({})
+  configuration_alias = "default"
+}
+`)
+}
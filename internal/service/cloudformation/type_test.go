@@ -146,6 +146,33 @@ func TestAccCloudFormationType_logging(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationType_setAsDefault(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	typeName := fmt.Sprintf("HashiCorp::TerraformAwsProvider::TfAccTest%s", sdkacctest.RandString(8))
+	zipPath := testAccTypeZipGenerator(t, typeName)
+	resourceName := "aws_cloudformation_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypeConfig_setAsDefault(rName, zipPath, typeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTypeExists(ctx, resourceName),
+					testAccCheckTypeExists(ctx, "aws_cloudformation_type.test2"),
+					resource.TestCheckResourceAttr(resourceName, "is_default_version", acctest.CtFalse),
+					resource.TestCheckResourceAttr("aws_cloudformation_type.test2", "is_default_version", acctest.CtTrue),
+					resource.TestCheckResourceAttr("aws_cloudformation_type.test2", "set_as_default", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTypeExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -462,3 +489,18 @@ resource "aws_cloudformation_type" "test" {
 }
 `, typeName))
 }
+
+func testAccTypeConfig_setAsDefault(rName string, zipPath string, typeName string) string {
+	return acctest.ConfigCompose(
+		testAccTypeConfig_name(rName, zipPath, typeName),
+		fmt.Sprintf(`
+resource "aws_cloudformation_type" "test2" {
+  schema_handler_package = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  set_as_default         = true
+  type                   = "RESOURCE"
+  type_name              = %[1]q
+
+  depends_on = [aws_cloudformation_type.test]
+}
+`, typeName))
+}
@@ -7,11 +7,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -325,6 +329,11 @@ func resourceDistribution() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"logging_v2_delivery_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"logging_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -989,6 +998,19 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 	} else {
 		d.Set("logging_config", []interface{}{})
 	}
+	deliveryIDs, err := findLoggingV2DeliveryIDsByDistributionARN(ctx, meta.(*conns.AWSClient), aws.ToString(output.Distribution.ARN))
+	switch {
+	case errs.IsA[*logstypes.AccessDeniedException](err):
+		log.Printf("[WARN] reading CloudFront Distribution (%s) standard logging (v2) deliveries: %s", d.Id(), err)
+		d.Set("logging_v2_delivery_ids", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution (%s) standard logging (v2) deliveries: %s", d.Id(), err)
+	default:
+		d.Set("logging_v2_delivery_ids", deliveryIDs)
+		if len(deliveryIDs) > 0 && distributionConfig.Logging != nil && aws.ToBool(distributionConfig.Logging.Enabled) {
+			diags = sdkdiag.AppendWarningf(diags, "CloudFront Distribution (%s) has both legacy logging (logging_config) and standard logging (v2) deliveries %v enabled", d.Id(), deliveryIDs)
+		}
+	}
 	if distributionConfig.CacheBehaviors != nil {
 		if err := d.Set("ordered_cache_behavior", flattenCacheBehaviors(distributionConfig.CacheBehaviors)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting ordered_cache_behavior: %s", err)
@@ -1241,6 +1263,56 @@ func disableDistribution(ctx context.Context, conn *cloudfront.Client, id string
 	return nil
 }
 
+// findLoggingV2DeliveryIDsByDistributionARN returns the IDs of any CloudWatch Logs
+// vended log deliveries (CloudFront standard logging v2) for the distribution.
+// CloudFront delivery sources are only created in us-east-1 in the standard partition.
+func findLoggingV2DeliveryIDsByDistributionARN(ctx context.Context, c *conns.AWSClient, arn string) ([]string, error) {
+	conn := c.LogsClient(ctx)
+	optFn := func(o *cloudwatchlogs.Options) {
+		if c.Partition(ctx) == endpoints.AwsPartitionID {
+			o.Region = endpoints.UsEast1RegionID
+		}
+	}
+
+	sourceNames := make(map[string]struct{})
+	pages := cloudwatchlogs.NewDescribeDeliverySourcesPaginator(conn, &cloudwatchlogs.DescribeDeliverySourcesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFn)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DeliverySources {
+			if slices.Contains(v.ResourceArns, arn) {
+				sourceNames[aws.ToString(v.Name)] = struct{}{}
+			}
+		}
+	}
+
+	if len(sourceNames) == 0 {
+		return nil, nil
+	}
+
+	var ids []string
+	deliveryPages := cloudwatchlogs.NewDescribeDeliveriesPaginator(conn, &cloudwatchlogs.DescribeDeliveriesInput{})
+	for deliveryPages.HasMorePages() {
+		page, err := deliveryPages.NextPage(ctx, optFn)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Deliveries {
+			if _, ok := sourceNames[aws.ToString(v.DeliverySourceName)]; ok {
+				ids = append(ids, aws.ToString(v.Id))
+			}
+		}
+	}
+
+	return ids, nil
+}
+
 func findDistributionByID(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetDistributionOutput, error) {
 	input := &cloudfront.GetDistributionInput{
 		Id: aws.String(id),
@@ -5,7 +5,9 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -83,6 +86,21 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"test_event": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_object": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -113,6 +131,13 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.ToString(output.FunctionSummary.Name))
 
+	if v, ok := d.GetOk("test_event"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := testFunction(ctx, conn, d.Id(), aws.ToString(output.ETag), v.([]interface{})[0].(map[string]interface{})["event_object"].(string)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "testing CloudFront Function (%s): %s", d.Id(), err)
+			return append(diags, resourceFunctionRead(ctx, d, meta)...)
+		}
+	}
+
 	if d.Get("publish").(bool) {
 		input := &cloudfront.PublishFunctionInput{
 			Name:    aws.String(d.Id()),
@@ -209,6 +234,15 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		etag = aws.ToString(output.ETag)
 	}
 
+	if d.HasChanges("code", names.AttrComment, "key_value_store_associations", "runtime", "test_event") {
+		if v, ok := d.GetOk("test_event"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := testFunction(ctx, conn, d.Id(), etag, v.([]interface{})[0].(map[string]interface{})["event_object"].(string)); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "testing CloudFront Function (%s): %s", d.Id(), err)
+				return append(diags, resourceFunctionRead(ctx, d, meta)...)
+			}
+		}
+	}
+
 	if d.Get("publish").(bool) {
 		input := &cloudfront.PublishFunctionInput{
 			IfMatch: aws.String(etag),
@@ -273,6 +307,37 @@ func findFunctionByTwoPartKey(ctx context.Context, conn *cloudfront.Client, name
 	return output, nil
 }
 
+// testFunction runs the DEVELOPMENT stage of the specified function against the
+// supplied event object, returning an error if the function itself fails.
+func testFunction(ctx context.Context, conn *cloudfront.Client, name, etag, eventObject string) error {
+	input := &cloudfront.TestFunctionInput{
+		EventObject: []byte(eventObject),
+		IfMatch:     aws.String(etag),
+		Name:        aws.String(name),
+		Stage:       awstypes.FunctionStageDevelopment,
+	}
+
+	output, err := conn.TestFunction(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if output == nil || output.TestResult == nil {
+		return tfresource.NewEmptyResultError(input)
+	}
+
+	if v := aws.ToString(output.TestResult.FunctionErrorMessage); v != "" {
+		if logs := output.TestResult.FunctionExecutionLogs; len(logs) > 0 {
+			return fmt.Errorf("function error: %s\n\nexecution logs:\n%s", v, strings.Join(logs, "\n"))
+		}
+
+		return fmt.Errorf("function error: %s", v)
+	}
+
+	return nil
+}
+
 func expandKeyValueStoreAssociations(tfList []interface{}) *awstypes.KeyValueStoreAssociations {
 	if len(tfList) == 0 {
 		return nil
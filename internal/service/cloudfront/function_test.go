@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -334,6 +335,41 @@ func TestAccCloudFrontFunction_KeyValueStoreAssociations(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontFunction_testEvent(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf cloudfront.DescribeFunctionOutput
+	resourceName := "aws_cloudfront_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_testEvent(rName, "return event.request;"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "test_event.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "test_event.0.event_object"),
+					resource.TestCheckResourceAttrPair(resourceName, "live_stage_etag", resourceName, "etag"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "test_event"},
+			},
+			{
+				Config:      testAccFunctionConfig_testEvent(rName, "throw new Error('broken');"),
+				ExpectError: regexache.MustCompile(`function error:`),
+			},
+		},
+	})
+}
+
 func testAccCheckFunctionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
@@ -697,3 +733,36 @@ EOT
 }
 `, rName))
 }
+
+func testAccFunctionConfig_testEvent(rName, body string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-2.0"
+  code    = <<-EOT
+function handler(event) {
+	%[2]s
+}
+EOT
+
+  test_event {
+    event_object = jsonencode({
+      version = "1.0"
+      context = {
+        eventType = "viewer-request"
+      }
+      viewer = {
+        ip = "198.51.100.11"
+      }
+      request = {
+        method      = "GET"
+        uri         = "/index.html"
+        headers     = {}
+        cookies     = {}
+        querystring = {}
+      }
+    })
+  }
+}
+`, rName, body)
+}
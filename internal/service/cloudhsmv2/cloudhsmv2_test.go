@@ -14,10 +14,11 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Cluster": {
-			acctest.CtBasic:      testAccCluster_basic,
-			acctest.CtDisappears: testAccCluster_disappears,
-			"tags":               testAccCluster_tags,
-			"hsmType":            testAccCluster_hsmType,
+			acctest.CtBasic:         testAccCluster_basic,
+			acctest.CtDisappears:    testAccCluster_disappears,
+			"tags":                  testAccCluster_tags,
+			"hsmType":               testAccCluster_hsmType,
+			"backupRetentionPolicy": testAccCluster_backupRetentionPolicy,
 		},
 		"Hsm": {
 			"availabilityZone":   testAccHSM_AvailabilityZone,
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -48,6 +49,26 @@ func resourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"backup_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.BackupRetentionType](),
+						},
+						names.AttrValue: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 379),
+						},
+					},
+				},
+			},
 			"cluster_certificates": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -134,6 +155,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		TagList:   getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BackupRetentionPolicy = expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrMode); ok && v != "" {
 		input.Mode = types.ClusterMode(v.(string))
 	}
@@ -178,6 +203,13 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading CloudHSMv2 Cluster (%s): %s", d.Id(), err)
 	}
 
+	if cluster.BackupRetentionPolicy != nil {
+		if err := d.Set("backup_retention_policy", []interface{}{flattenBackupRetentionPolicy(cluster.BackupRetentionPolicy)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting backup_retention_policy: %s", err)
+		}
+	} else {
+		d.Set("backup_retention_policy", nil)
+	}
 	if err := d.Set("cluster_certificates", flattenCertificates(cluster)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cluster_certificates: %s", err)
 	}
@@ -197,8 +229,22 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
 
-	// Tags only.
+	if d.HasChange("backup_retention_policy") {
+		if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &cloudhsmv2.ModifyClusterInput{
+				BackupRetentionPolicy: expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{})),
+				ClusterId:             aws.String(d.Id()),
+			}
+
+			_, err := conn.ModifyCluster(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+			}
+		}
+	}
 
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
@@ -367,14 +413,22 @@ func waitClusterUninitialized(ctx context.Context, conn *cloudhsmv2.Client, id s
 func flattenCertificates(apiObject *types.Cluster) []map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
-	if apiObject, clusterState := apiObject.Certificates, apiObject.State; apiObject != nil {
-		if clusterState == types.ClusterStateUninitialized {
-			tfMap["cluster_csr"] = aws.ToString(apiObject.ClusterCsr)
-			tfMap["aws_hardware_certificate"] = aws.ToString(apiObject.AwsHardwareCertificate)
-			tfMap["hsm_certificate"] = aws.ToString(apiObject.HsmCertificate)
-			tfMap["manufacturer_hardware_certificate"] = aws.ToString(apiObject.ManufacturerHardwareCertificate)
-		} else if clusterState == types.ClusterStateActive {
-			tfMap["cluster_certificate"] = aws.ToString(apiObject.ClusterCertificate)
+	// Export every certificate the cluster reports, whatever its state.
+	if apiObject := apiObject.Certificates; apiObject != nil {
+		if v := apiObject.AwsHardwareCertificate; v != nil {
+			tfMap["aws_hardware_certificate"] = aws.ToString(v)
+		}
+		if v := apiObject.ClusterCertificate; v != nil {
+			tfMap["cluster_certificate"] = aws.ToString(v)
+		}
+		if v := apiObject.ClusterCsr; v != nil {
+			tfMap["cluster_csr"] = aws.ToString(v)
+		}
+		if v := apiObject.HsmCertificate; v != nil {
+			tfMap["hsm_certificate"] = aws.ToString(v)
+		}
+		if v := apiObject.ManufacturerHardwareCertificate; v != nil {
+			tfMap["manufacturer_hardware_certificate"] = aws.ToString(v)
 		}
 	}
 
@@ -384,3 +438,37 @@ func flattenCertificates(apiObject *types.Cluster) []map[string]interface{} {
 
 	return []map[string]interface{}{}
 }
+
+func expandBackupRetentionPolicy(tfMap map[string]interface{}) *types.BackupRetentionPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.BackupRetentionPolicy{}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = types.BackupRetentionType(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(int); ok && v != 0 {
+		apiObject.Value = aws.String(strconv.Itoa(v))
+	}
+
+	return apiObject
+}
+
+func flattenBackupRetentionPolicy(apiObject *types.BackupRetentionPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrType: apiObject.Type,
+	}
+
+	if v, err := strconv.Atoi(aws.ToString(apiObject.Value)); err == nil {
+		tfMap[names.AttrValue] = v
+	}
+
+	return tfMap
+}
@@ -260,12 +260,18 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	// TODO: Status.RequiresIndexDocuments = true?
+	output, err := waitDomainActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 
-	if _, err := waitDomainActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for CloudSearch Domain (%s) create: %s", d.Id(), err)
 	}
 
+	if aws.ToBool(output.RequiresIndexDocuments) {
+		if err := indexDocuments(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "indexing CloudSearch Domain (%s) documents: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceDomainRead(ctx, d, meta)...)
 }
 
@@ -462,10 +468,19 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	if _, err := waitDomainActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	output, err := waitDomainActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for CloudSearch Domain (%s) update: %s", d.Id(), err)
 	}
 
+	// Some option changes, e.g. scaling parameters, only flag the domain as requiring indexing once processed.
+	if aws.ToBool(output.RequiresIndexDocuments) {
+		if err := indexDocuments(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "indexing CloudSearch Domain (%s) documents: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceDomainRead(ctx, d, meta)...)
 }
 
@@ -549,6 +564,22 @@ func defineIndexFields(ctx context.Context, conn *cloudsearch.Client, domainName
 	return nil
 }
 
+func indexDocuments(ctx context.Context, conn *cloudsearch.Client, name string, timeout time.Duration) error {
+	input := &cloudsearch.IndexDocumentsInput{
+		DomainName: aws.String(name),
+	}
+
+	if _, err := conn.IndexDocuments(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitDomainActive(ctx, conn, name, timeout); err != nil {
+		return fmt.Errorf("waiting for indexing: %w", err)
+	}
+
+	return nil
+}
+
 func findDomainByName(ctx context.Context, conn *cloudsearch.Client, name string) (*types.DomainStatus, error) {
 	input := &cloudsearch.DescribeDomainsInput{
 		DomainNames: []string{name},
@@ -702,7 +733,7 @@ func statusDomainProcessing(ctx context.Context, conn *cloudsearch.Client, name
 	}
 }
 
-func waitDomainActive(ctx context.Context, conn *cloudsearch.Client, name string, timeout time.Duration) (*types.DomainStatus, error) { //nolint:unparam
+func waitDomainActive(ctx context.Context, conn *cloudsearch.Client, name string, timeout time.Duration) (*types.DomainStatus, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{"true"},
 		Target:  []string{"false"},
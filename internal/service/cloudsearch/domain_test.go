@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudsearch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudsearch/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -283,6 +284,7 @@ func TestAccCloudSearchDomain_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.0.desired_instance_type", "search.small"),
 					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.0.desired_partition_count", "1"),
 					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.0.desired_replication_count", "1"),
+					testAccCheckDomainNotRequiresIndexDocuments(&v),
 				),
 			},
 			{
@@ -312,6 +314,7 @@ func TestAccCloudSearchDomain_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.0.desired_instance_type", "search.medium"),
 					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.0.desired_partition_count", "1"),
 					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.0.desired_replication_count", "2"),
+					testAccCheckDomainNotRequiresIndexDocuments(&v),
 				),
 			},
 		},
@@ -360,6 +363,16 @@ func testAccDomainExists(ctx context.Context, n string, v *types.DomainStatus) r
 	}
 }
 
+func testAccCheckDomainNotRequiresIndexDocuments(v *types.DomainStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToBool(v.RequiresIndexDocuments) {
+			return fmt.Errorf("CloudSearch Domain (%s) requires indexing", aws.ToString(v.DomainName))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDomainDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
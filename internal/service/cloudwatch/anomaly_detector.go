@@ -0,0 +1,666 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	anomalyDetectorMetricMathIDPrefix = "metric-math-"
+	anomalyDetectorIDDimensionsSep    = "="
+)
+
+// @SDKResource("aws_cloudwatch_anomaly_detector", name="Anomaly Detector")
+func resourceAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnomalyDetectorCreate,
+		ReadWithoutTimeout:   resourceAnomalyDetectorRead,
+		UpdateWithoutTimeout: resourceAnomalyDetectorUpdate,
+		DeleteWithoutTimeout: resourceAnomalyDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrConfiguration: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_time_range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validation.IsRFC3339Time,
+										DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+									},
+									names.AttrStartTime: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validation.IsRFC3339Time,
+										DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+									},
+								},
+							},
+						},
+						"metric_timezone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+					},
+				},
+			},
+			"metric_characteristics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"periodic_spikes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"metric_math_anomaly_detector": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"metric_math_anomaly_detector", "single_metric_anomaly_detector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_query": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAccountID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									names.AttrExpression: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2048),
+									},
+									names.AttrID: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"label": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"metric": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												names.AttrMetricName: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												names.AttrNamespace: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"period": {
+													Type:     schema.TypeInt,
+													Required: true,
+													ForceNew: true,
+													ValidateFunc: validation.Any(
+														validation.IntInSlice([]int{1, 5, 10, 30}),
+														validation.IntDivisibleBy(60),
+													),
+												},
+												"stat": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												names.AttrUnit: {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.StandardUnit](),
+												},
+											},
+										},
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"return_data": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"single_metric_anomaly_detector": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAccountID: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrMetricName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 255),
+								validation.StringDoesNotContainAny(","),
+							),
+						},
+						names.AttrNamespace: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 255),
+								validation.StringDoesNotContainAny(","),
+							),
+						},
+						"stat": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 255),
+								validation.StringDoesNotContainAny(","),
+							),
+						},
+					},
+				},
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAnomalyDetectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	input := expandPutAnomalyDetectorInput(d)
+
+	_, err := conn.PutAnomalyDetector(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Anomaly Detector: %s", err)
+	}
+
+	if input.SingleMetricAnomalyDetector != nil {
+		d.SetId(anomalyDetectorSingleMetricCreateResourceID(input.SingleMetricAnomalyDetector))
+	} else {
+		d.SetId(anomalyDetectorMetricMathCreateResourceID(input.MetricMathAnomalyDetector))
+	}
+
+	return append(diags, resourceAnomalyDetectorRead(ctx, d, meta)...)
+}
+
+func resourceAnomalyDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	detector, err := findAnomalyDetectorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set(names.AttrConfiguration, flattenAnomalyDetectorConfiguration(detector.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	if err := d.Set("metric_characteristics", flattenMetricCharacteristics(detector.MetricCharacteristics)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting metric_characteristics: %s", err)
+	}
+	if err := d.Set("metric_math_anomaly_detector", flattenMetricMathAnomalyDetector(detector.MetricMathAnomalyDetector)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting metric_math_anomaly_detector: %s", err)
+	}
+	if err := d.Set("single_metric_anomaly_detector", flattenSingleMetricAnomalyDetector(detector.SingleMetricAnomalyDetector)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting single_metric_anomaly_detector: %s", err)
+	}
+	d.Set(names.AttrState, detector.StateValue)
+
+	return diags
+}
+
+func resourceAnomalyDetectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	// PutAnomalyDetector overwrites the configuration of an existing detector with the same identity.
+	input := expandPutAnomalyDetectorInput(d)
+
+	_, err := conn.PutAnomalyDetector(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating CloudWatch Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAnomalyDetectorRead(ctx, d, meta)...)
+}
+
+func resourceAnomalyDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	input := &cloudwatch.DeleteAnomalyDetectorInput{}
+	if v, ok := d.GetOk("single_metric_anomaly_detector"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SingleMetricAnomalyDetector = expandSingleMetricAnomalyDetector(v.([]interface{})[0].(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("metric_math_anomaly_detector"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MetricMathAnomalyDetector = expandMetricMathAnomalyDetector(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Deleting CloudWatch Anomaly Detector: %s", d.Id())
+	_, err := conn.DeleteAnomalyDetector(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// anomalyDetectorSingleMetricCreateResourceID returns an ID of the form
+// "namespace,metric_name,stat[,dimension_name=dimension_value...]" with the dimensions sorted by name.
+func anomalyDetectorSingleMetricCreateResourceID(apiObject *types.SingleMetricAnomalyDetector) string {
+	parts := []string{aws.ToString(apiObject.Namespace), aws.ToString(apiObject.MetricName), aws.ToString(apiObject.Stat)}
+
+	for _, v := range sortedDimensions(apiObject.Dimensions) {
+		parts = append(parts, aws.ToString(v.Name)+anomalyDetectorIDDimensionsSep+aws.ToString(v.Value))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func anomalyDetectorSingleMetricParseResourceID(id string) (*types.SingleMetricAnomalyDetector, error) {
+	parts := strings.Split(id, ",")
+
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected NAMESPACE,METRIC_NAME,STAT[,DIMENSION_NAME=DIMENSION_VALUE...] or %[2]sHASH", id, anomalyDetectorMetricMathIDPrefix)
+	}
+
+	apiObject := &types.SingleMetricAnomalyDetector{
+		Namespace:  aws.String(parts[0]),
+		MetricName: aws.String(parts[1]),
+		Stat:       aws.String(parts[2]),
+	}
+
+	for _, v := range parts[3:] {
+		name, value, ok := strings.Cut(v, anomalyDetectorIDDimensionsSep)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("unexpected format for dimension (%s) in ID (%s), expected DIMENSION_NAME=DIMENSION_VALUE", v, id)
+		}
+
+		apiObject.Dimensions = append(apiObject.Dimensions, types.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		})
+	}
+
+	return apiObject, nil
+}
+
+// anomalyDetectorMetricMathCreateResourceID returns an ID derived from the identifying fields of the metric math queries,
+// as the API does not assign metric math anomaly detectors an identifier of their own.
+func anomalyDetectorMetricMathCreateResourceID(apiObject *types.MetricMathAnomalyDetector) string {
+	var sb strings.Builder
+
+	for _, v := range apiObject.MetricDataQueries {
+		fmt.Fprintf(&sb, "%s|%s|%s|%d;", aws.ToString(v.Id), aws.ToString(v.AccountId), aws.ToString(v.Expression), aws.ToInt32(v.Period))
+
+		if v := v.MetricStat; v != nil {
+			fmt.Fprintf(&sb, "%s|%d|%s;", aws.ToString(v.Stat), aws.ToInt32(v.Period), v.Unit)
+
+			if v := v.Metric; v != nil {
+				fmt.Fprintf(&sb, "%s|%s;", aws.ToString(v.Namespace), aws.ToString(v.MetricName))
+
+				for _, v := range sortedDimensions(v.Dimensions) {
+					fmt.Fprintf(&sb, "%s=%s;", aws.ToString(v.Name), aws.ToString(v.Value))
+				}
+			}
+		}
+	}
+
+	return anomalyDetectorMetricMathIDPrefix + strconv.Itoa(create.StringHashcode(sb.String()))
+}
+
+func sortedDimensions(apiObjects []types.Dimension) []types.Dimension {
+	return slices.SortedFunc(slices.Values(apiObjects), func(a, b types.Dimension) int {
+		return strings.Compare(aws.ToString(a.Name), aws.ToString(b.Name))
+	})
+}
+
+func findAnomalyDetectorByID(ctx context.Context, conn *cloudwatch.Client, id string) (*types.AnomalyDetector, error) {
+	if strings.HasPrefix(id, anomalyDetectorMetricMathIDPrefix) {
+		input := &cloudwatch.DescribeAnomalyDetectorsInput{
+			AnomalyDetectorTypes: []types.AnomalyDetectorType{types.AnomalyDetectorTypeMetricMath},
+		}
+
+		return findAnomalyDetector(ctx, conn, input, func(v *types.AnomalyDetector) bool {
+			return v.MetricMathAnomalyDetector != nil && anomalyDetectorMetricMathCreateResourceID(v.MetricMathAnomalyDetector) == id
+		})
+	}
+
+	apiObject, err := anomalyDetectorSingleMetricParseResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &cloudwatch.DescribeAnomalyDetectorsInput{
+		AnomalyDetectorTypes: []types.AnomalyDetectorType{types.AnomalyDetectorTypeSingleMetric},
+		Dimensions:           apiObject.Dimensions,
+		MetricName:           apiObject.MetricName,
+		Namespace:            apiObject.Namespace,
+	}
+
+	return findAnomalyDetector(ctx, conn, input, func(v *types.AnomalyDetector) bool {
+		return v.SingleMetricAnomalyDetector != nil && anomalyDetectorSingleMetricCreateResourceID(v.SingleMetricAnomalyDetector) == id
+	})
+}
+
+func findAnomalyDetector(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.DescribeAnomalyDetectorsInput, filter tfslices.Predicate[*types.AnomalyDetector]) (*types.AnomalyDetector, error) {
+	output, err := findAnomalyDetectors(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findAnomalyDetectors(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.DescribeAnomalyDetectorsInput, filter tfslices.Predicate[*types.AnomalyDetector]) ([]types.AnomalyDetector, error) {
+	var output []types.AnomalyDetector
+
+	pages := cloudwatch.NewDescribeAnomalyDetectorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AnomalyDetectors {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func expandPutAnomalyDetectorInput(d *schema.ResourceData) *cloudwatch.PutAnomalyDetectorInput {
+	input := &cloudwatch.PutAnomalyDetectorInput{}
+
+	if v, ok := d.GetOk(names.AttrConfiguration); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandAnomalyDetectorConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("metric_characteristics"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MetricCharacteristics = expandMetricCharacteristics(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("metric_math_anomaly_detector"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MetricMathAnomalyDetector = expandMetricMathAnomalyDetector(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("single_metric_anomaly_detector"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SingleMetricAnomalyDetector = expandSingleMetricAnomalyDetector(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return input
+}
+
+func expandAnomalyDetectorConfiguration(tfMap map[string]interface{}) *types.AnomalyDetectorConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AnomalyDetectorConfiguration{}
+
+	if v, ok := tfMap["excluded_time_range"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			startTime, _ := time.Parse(time.RFC3339, tfMap[names.AttrStartTime].(string))
+			endTime, _ := time.Parse(time.RFC3339, tfMap["end_time"].(string))
+
+			apiObject.ExcludedTimeRanges = append(apiObject.ExcludedTimeRanges, types.Range{
+				EndTime:   aws.Time(endTime),
+				StartTime: aws.Time(startTime),
+			})
+		}
+	}
+
+	if v, ok := tfMap["metric_timezone"].(string); ok && v != "" {
+		apiObject.MetricTimezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMetricCharacteristics(tfMap map[string]interface{}) *types.MetricCharacteristics {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MetricCharacteristics{}
+
+	if v, ok := tfMap["periodic_spikes"].(bool); ok {
+		apiObject.PeriodicSpikes = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandMetricMathAnomalyDetector(tfMap map[string]interface{}) *types.MetricMathAnomalyDetector {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MetricMathAnomalyDetector{}
+
+	if v, ok := tfMap["metric_query"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MetricDataQueries = expandMetricAlarmMetrics(v)
+	}
+
+	return apiObject
+}
+
+func expandSingleMetricAnomalyDetector(tfMap map[string]interface{}) *types.SingleMetricAnomalyDetector {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SingleMetricAnomalyDetector{}
+
+	if v, ok := tfMap[names.AttrAccountID].(string); ok && v != "" {
+		apiObject.AccountId = aws.String(v)
+	}
+
+	if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Dimensions = expandMetricAlarmDimensions(v)
+	}
+
+	if v, ok := tfMap[names.AttrMetricName].(string); ok && v != "" {
+		apiObject.MetricName = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrNamespace].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		apiObject.Stat = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAnomalyDetectorConfiguration(apiObject *types.AnomalyDetectorConfiguration) []interface{} {
+	if apiObject == nil || (len(apiObject.ExcludedTimeRanges) == 0 && apiObject.MetricTimezone == nil) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"metric_timezone": aws.ToString(apiObject.MetricTimezone),
+	}
+
+	var tfList []interface{}
+	for _, v := range apiObject.ExcludedTimeRanges {
+		tfList = append(tfList, map[string]interface{}{
+			"end_time":          aws.ToTime(v.EndTime).Format(time.RFC3339),
+			names.AttrStartTime: aws.ToTime(v.StartTime).Format(time.RFC3339),
+		})
+	}
+	tfMap["excluded_time_range"] = tfList
+
+	return []interface{}{tfMap}
+}
+
+func flattenMetricCharacteristics(apiObject *types.MetricCharacteristics) []interface{} {
+	if apiObject == nil || apiObject.PeriodicSpikes == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"periodic_spikes": aws.ToBool(apiObject.PeriodicSpikes),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMetricMathAnomalyDetector(apiObject *types.MetricMathAnomalyDetector) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"metric_query": flattenMetricAlarmMetrics(apiObject.MetricDataQueries),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSingleMetricAnomalyDetector(apiObject *types.SingleMetricAnomalyDetector) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrAccountID:  aws.ToString(apiObject.AccountId),
+		"dimensions":         flattenMetricAlarmDimensions(apiObject.Dimensions),
+		names.AttrMetricName: aws.ToString(apiObject.MetricName),
+		names.AttrNamespace:  aws.ToString(apiObject.Namespace),
+		"stat":               aws.ToString(apiObject.Stat),
+	}
+
+	return []interface{}{tfMap}
+}
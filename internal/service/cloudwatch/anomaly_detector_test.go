@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchAnomalyDetector_singleMetric(t *testing.T) {
+	ctx := acctest.Context(t)
+	var detector types.AnomalyDetector
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_singleMetric(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, fmt.Sprintf("AWS/EC2,CPUUtilization,Average,InstanceId=%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.dimensions.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.dimensions.InstanceId", rName),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.namespace", "AWS/EC2"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.stat", "Average"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrState),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_singleMetricConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.end_time", "2024-01-02T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.start_time", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "Europe/London"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAnomalyDetector_metricMath(t *testing.T) {
+	ctx := acctest.Context(t)
+	var detector types.AnomalyDetector
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_metricMath(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_query.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_query.2.expression", "m1+m2"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_query.2.return_data", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAnomalyDetector_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var detector types.AnomalyDetector
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_singleMetric(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &detector),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudwatch.ResourceAnomalyDetector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalyDetectorExists(ctx context.Context, n string, v *types.AnomalyDetector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		output, err := tfcloudwatch.FindAnomalyDetectorByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAnomalyDetectorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_anomaly_detector" {
+				continue
+			}
+
+			_, err := tfcloudwatch.FindAnomalyDetectorByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Anomaly Detector %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAnomalyDetectorConfig_singleMetric(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  single_metric_anomaly_detector {
+    namespace   = "AWS/EC2"
+    metric_name = "CPUUtilization"
+    stat        = "Average"
+
+    dimensions = {
+      InstanceId = %[1]q
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAnomalyDetectorConfig_singleMetricConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  single_metric_anomaly_detector {
+    namespace   = "AWS/EC2"
+    metric_name = "CPUUtilization"
+    stat        = "Average"
+
+    dimensions = {
+      InstanceId = %[1]q
+    }
+  }
+
+  configuration {
+    excluded_time_range {
+      start_time = "2024-01-01T00:00:00Z"
+      end_time   = "2024-01-02T00:00:00Z"
+    }
+
+    metric_timezone = "Europe/London"
+  }
+}
+`, rName)
+}
+
+func testAccAnomalyDetectorConfig_metricMath(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  metric_math_anomaly_detector {
+    metric_query {
+      id = "m1"
+
+      metric {
+        namespace   = "AWS/EC2"
+        metric_name = "NetworkIn"
+        period      = 300
+        stat        = "Sum"
+
+        dimensions = {
+          InstanceId = %[1]q
+        }
+      }
+    }
+
+    metric_query {
+      id = "m2"
+
+      metric {
+        namespace   = "AWS/EC2"
+        metric_name = "NetworkOut"
+        period      = 300
+        stat        = "Sum"
+
+        dimensions = {
+          InstanceId = %[1]q
+        }
+      }
+    }
+
+    metric_query {
+      id          = "e1"
+      expression  = "m1+m2"
+      label       = "NetworkTotal"
+      return_data = true
+    }
+  }
+}
+`, rName)
+}
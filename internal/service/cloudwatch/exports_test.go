@@ -5,6 +5,7 @@ package cloudwatch
 
 // Exports for use in tests only.
 var (
+	ResourceAnomalyDetector               = resourceAnomalyDetector
 	ResourceCompositeAlarm                = resourceCompositeAlarm
 	ResourceDashboard                     = resourceDashboard
 	ResourceMetricAlarm                   = resourceMetricAlarm
@@ -12,6 +13,7 @@ var (
 	ResourceContributorInsightRule        = newResourceContributorInsightRule
 	ResourceContributorManagedInsightRule = newResourceContributorManagedInsightRule
 
+	FindAnomalyDetectorByID                                    = findAnomalyDetectorByID
 	FindCompositeAlarmByName                                   = findCompositeAlarmByName
 	FindDashboardByName                                        = findDashboardByName
 	FindMetricAlarmByName                                      = findMetricAlarmByName
@@ -5,9 +5,13 @@ package codeconnections
 
 // Exports for use in tests only.
 var (
-	ResourceConnection = newConnectionResource
-	ResourceHost       = newHostResource
+	ResourceConnection        = newConnectionResource
+	ResourceHost              = newHostResource
+	ResourceRepositoryLink    = newRepositoryLinkResource
+	ResourceSyncConfiguration = newSyncConfigurationResource
 
-	FindConnectionByARN = findConnectionByARN
-	FindHostByARN       = findHostByARN
+	FindConnectionByARN               = findConnectionByARN
+	FindHostByARN                     = findHostByARN
+	FindRepositoryLinkByID            = findRepositoryLinkByID
+	FindSyncConfigurationByTwoPartKey = findSyncConfigurationByTwoPartKey
 )
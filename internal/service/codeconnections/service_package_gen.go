@@ -36,6 +36,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newRepositoryLinkResource,
+			TypeName: "aws_codeconnections_repository_link",
+			Name:     "Repository Link",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newSyncConfigurationResource,
+			TypeName: "aws_codeconnections_sync_configuration",
+			Name:     "Sync Configuration",
+		},
 	}
 }
 
@@ -5,7 +5,9 @@ package codeguruprofiler
 
 // Exports for use in tests only.
 var (
-	ResourceProfilingGroup = newResourceProfilingGroup
+	ResourceNotificationChannel = newResourceNotificationChannel
+	ResourceProfilingGroup      = newResourceProfilingGroup
 
-	FindProfilingGroupByName = findProfilingGroupByName
+	FindNotificationChannelByTwoPartKey = findNotificationChannelByTwoPartKey
+	FindProfilingGroupByName            = findProfilingGroupByName
 )
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_codeguruprofiler_notification_channel", name="Notification Channel")
+func newResourceNotificationChannel(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceNotificationChannel{}
+
+	return r, nil
+}
+
+const (
+	ResNameNotificationChannel = "Notification Channel"
+
+	notificationChannelIDPartCount = 2
+)
+
+type resourceNotificationChannel struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (r *resourceNotificationChannel) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"profiling_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sns_topic_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceNotificationChannel) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	var plan resourceNotificationChannelData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profilingGroupName, uri := plan.ProfilingGroupName.ValueString(), plan.SNSTopicARN.ValueString()
+	in := &codeguruprofiler.AddNotificationChannelsInput{
+		Channels: []awstypes.Channel{{
+			EventPublishers: []awstypes.EventPublisher{awstypes.EventPublisherAnomalyDetection},
+			Uri:             aws.String(uri),
+		}},
+		ProfilingGroupName: aws.String(profilingGroupName),
+	}
+
+	out, err := conn.AddNotificationChannels(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionCreating, ResNameNotificationChannel, uri, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The response contains every channel of the profiling group.
+	var channel *awstypes.Channel
+	if out != nil && out.NotificationConfiguration != nil {
+		channel = findChannel(out.NotificationConfiguration.Channels, func(v awstypes.Channel) bool {
+			return aws.ToString(v.Uri) == uri
+		})
+	}
+
+	if channel == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionCreating, ResNameNotificationChannel, uri, nil),
+			"empty output",
+		)
+		return
+	}
+
+	id, err := intflex.FlattenResourceId([]string{profilingGroupName, aws.ToString(channel.Id)}, notificationChannelIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionCreating, ResNameNotificationChannel, uri, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ChannelID = flex.StringToFramework(ctx, channel.Id)
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceNotificationChannel) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	var state resourceNotificationChannelData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(state.ID.ValueString(), notificationChannelIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionSetting, ResNameNotificationChannel, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findNotificationChannelByTwoPartKey(ctx, conn, parts[0], parts[1])
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionSetting, ResNameNotificationChannel, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ChannelID = flex.StringToFramework(ctx, out.Id)
+	state.ProfilingGroupName = types.StringValue(parts[0])
+	state.SNSTopicARN = fwtypes.ARNValue(aws.ToString(out.Uri))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceNotificationChannel) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().CodeGuruProfilerClient(ctx)
+
+	var state resourceNotificationChannelData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &codeguruprofiler.RemoveNotificationChannelInput{
+		ChannelId:          state.ChannelID.ValueStringPointer(),
+		ProfilingGroupName: state.ProfilingGroupName.ValueStringPointer(),
+	}
+
+	_, err := conn.RemoveNotificationChannel(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionDeleting, ResNameNotificationChannel, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findNotificationChannelByTwoPartKey(ctx context.Context, conn *codeguruprofiler.Client, profilingGroupName, channelID string) (*awstypes.Channel, error) {
+	in := &codeguruprofiler.GetNotificationConfigurationInput{
+		ProfilingGroupName: aws.String(profilingGroupName),
+	}
+
+	out, err := conn.GetNotificationConfiguration(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.NotificationConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	channel := findChannel(out.NotificationConfiguration.Channels, func(v awstypes.Channel) bool {
+		return aws.ToString(v.Id) == channelID
+	})
+
+	if channel == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: in,
+		}
+	}
+
+	return channel, nil
+}
+
+func findChannel(channels []awstypes.Channel, filter func(awstypes.Channel) bool) *awstypes.Channel {
+	for _, v := range channels {
+		if filter(v) {
+			return &v
+		}
+	}
+
+	return nil
+}
+
+type resourceNotificationChannelData struct {
+	ChannelID          types.String `tfsdk:"channel_id"`
+	ID                 types.String `tfsdk:"id"`
+	ProfilingGroupName types.String `tfsdk:"profiling_group_name"`
+	SNSTopicARN        fwtypes.ARN  `tfsdk:"sns_topic_arn"`
+}
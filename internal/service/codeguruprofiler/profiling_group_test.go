@@ -135,6 +135,41 @@ func TestAccCodeGuruProfilerProfilingGroup_update(t *testing.T) {
 	})
 }
 
+func TestAccCodeGuruProfilerProfilingGroup_computePlatformAWSLambda(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var profilinggroup awstypes.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_computePlatform(rName, "AWSLambda"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "AWSLambda"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCodeGuruProfilerProfilingGroup_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -273,6 +308,19 @@ resource "aws_codeguruprofiler_profiling_group" "test" {
 `, rName, profilingEnabled)
 }
 
+func testAccProfilingGroupConfig_computePlatform(rName, computePlatform string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name             = %[1]q
+  compute_platform = %[2]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+`, rName, computePlatform)
+}
+
 func testAccProfilingGroupConfig_tags1(rName, key1, value1 string) string {
 	return fmt.Sprintf(`
 resource "aws_codeguruprofiler_profiling_group" "test" {
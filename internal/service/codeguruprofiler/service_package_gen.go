@@ -26,6 +26,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newResourceNotificationChannel,
+			TypeName: "aws_codeguruprofiler_notification_channel",
+			Name:     "Notification Channel",
+		},
 		{
 			Factory:  newResourceProfilingGroup,
 			TypeName: "aws_codeguruprofiler_profiling_group",
@@ -654,8 +654,9 @@ func waitDocumentClassifierCreated(ctx context.Context, conn *comprehend.Client,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if output, ok := outputRaw.(*types.DocumentClassifierProperties); ok {
-		if output.Status == types.ModelStatusInError {
-			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		// The training job's validation errors are only reported in Message.
+		if status, message := output.Status, aws.ToString(output.Message); (status == types.ModelStatusInError || status == types.ModelStatusStopped) && message != "" {
+			tfresource.SetLastError(err, errors.New(message))
 		}
 		return output, err
 	}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+// Exports for use in tests only.
+var (
+	ResourceDomain   = newDomainResource
+	ResourceField    = newFieldResource
+	ResourceLayout   = newLayoutResource
+	ResourceTemplate = newTemplateResource
+
+	FindDomainByID           = findDomainByID
+	FindFieldByTwoPartKey    = findFieldByTwoPartKey
+	FindLayoutByTwoPartKey   = findLayoutByTwoPartKey
+	FindTemplateByTwoPartKey = findTemplateByTwoPartKey
+	NormalizeLayoutContent   = normalizeLayoutContent
+)
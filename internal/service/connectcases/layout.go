@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_layout", name="Layout")
+func newLayoutResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &layoutResource{}
+
+	return r, nil
+}
+
+type layoutResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *layoutResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrContent: schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"layout_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+		},
+	}
+}
+
+func (r *layoutResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data layoutResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	content, err := expandLayoutContent(data.Content.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("expanding Connect Cases Layout content", err.Error())
+
+		return
+	}
+
+	name := data.Name.ValueString()
+	input := &connectcases.CreateLayoutInput{
+		Content:  content,
+		DomainId: fwflex.StringFromFramework(ctx, data.DomainID),
+		Name:     aws.String(name),
+	}
+
+	output, err := conn.CreateLayout(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Layout (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.LayoutArn)
+	data.LayoutID = fwflex.StringToFramework(ctx, output.LayoutId)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID Connect Cases Layout", err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *layoutResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data layoutResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	output, err := findLayoutByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.LayoutID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Layout (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	content, err := flattenLayoutContent(output.Content)
+	if err != nil {
+		response.Diagnostics.AddError("flattening Connect Cases Layout content", err.Error())
+
+		return
+	}
+
+	// Keep the configured document when it describes the same layout, so that
+	// omitted empty values or formatting differences don't surface as drift.
+	if old, err := normalizeLayoutContent(data.Content.ValueString()); err != nil || old != content {
+		data.Content = jsontypes.NewNormalizedValue(content)
+	}
+	data.ARN = fwflex.StringToFramework(ctx, output.LayoutArn)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *layoutResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new layoutResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	input := &connectcases.UpdateLayoutInput{
+		DomainId: fwflex.StringFromFramework(ctx, new.DomainID),
+		LayoutId: fwflex.StringFromFramework(ctx, new.LayoutID),
+	}
+
+	if !new.Content.Equal(old.Content) {
+		content, err := expandLayoutContent(new.Content.ValueString())
+		if err != nil {
+			response.Diagnostics.AddError("expanding Connect Cases Layout content", err.Error())
+
+			return
+		}
+
+		input.Content = content
+	}
+
+	if !new.Name.Equal(old.Name) {
+		input.Name = fwflex.StringFromFramework(ctx, new.Name)
+	}
+
+	_, err := conn.UpdateLayout(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Connect Cases Layout (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *layoutResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data layoutResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	input := connectcases.DeleteLayoutInput{
+		DomainId: data.DomainID.ValueStringPointer(),
+		LayoutId: data.LayoutID.ValueStringPointer(),
+	}
+	_, err := conn.DeleteLayout(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Layout (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findLayoutByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, layoutID string) (*connectcases.GetLayoutOutput, error) {
+	input := &connectcases.GetLayoutInput{
+		DomainId: aws.String(domainID),
+		LayoutId: aws.String(layoutID),
+	}
+
+	output, err := conn.GetLayout(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if output.Deleted {
+		return nil, &retry.NotFoundError{
+			Message:     "deleted",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+type layoutResourceModel struct {
+	ARN      types.String         `tfsdk:"arn"`
+	Content  jsontypes.Normalized `tfsdk:"content"`
+	DomainID types.String         `tfsdk:"domain_id"`
+	ID       types.String         `tfsdk:"id"`
+	LayoutID types.String         `tfsdk:"layout_id"`
+	Name     types.String         `tfsdk:"name"`
+}
+
+const (
+	layoutResourceIDPartCount = 2
+)
+
+func (m *layoutResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), layoutResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.DomainID = types.StringValue(parts[0])
+	m.LayoutID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *layoutResourceModel) setID() (string, error) {
+	parts := []string{
+		m.DomainID.ValueString(),
+		m.LayoutID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, layoutResourceIDPartCount, false)
+}
+
+// The layout content document mirrors the API's JSON representation of the
+// LayoutContent union, e.g.
+//
+//	{"basic": {"topPanel": {"sections": [{"fieldGroup": {"name": "Summary", "fields": [{"id": "..."}]}}]}}}
+type layoutContentDocument struct {
+	Basic *basicLayoutDocument `json:"basic,omitempty"`
+}
+
+type basicLayoutDocument struct {
+	MoreInfo *layoutSectionsDocument `json:"moreInfo,omitempty"`
+	TopPanel *layoutSectionsDocument `json:"topPanel,omitempty"`
+}
+
+type layoutSectionsDocument struct {
+	Sections []layoutSectionDocument `json:"sections"`
+}
+
+type layoutSectionDocument struct {
+	FieldGroup *fieldGroupDocument `json:"fieldGroup,omitempty"`
+}
+
+type fieldGroupDocument struct {
+	Fields []fieldItemDocument `json:"fields"`
+	Name   string              `json:"name,omitempty"`
+}
+
+type fieldItemDocument struct {
+	ID string `json:"id"`
+}
+
+func expandLayoutContent(s string) (awstypes.LayoutContent, error) {
+	var doc layoutContentDocument
+
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, err
+	}
+
+	if doc.Basic == nil {
+		return nil, fmt.Errorf(`layout content must contain a "basic" layout`)
+	}
+
+	return &awstypes.LayoutContentMemberBasic{
+		Value: awstypes.BasicLayout{
+			MoreInfo: expandLayoutSections(doc.Basic.MoreInfo),
+			TopPanel: expandLayoutSections(doc.Basic.TopPanel),
+		},
+	}, nil
+}
+
+func expandLayoutSections(doc *layoutSectionsDocument) *awstypes.LayoutSections {
+	if doc == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.LayoutSections{
+		Sections: []awstypes.Section{},
+	}
+
+	for _, section := range doc.Sections {
+		if section.FieldGroup == nil {
+			continue
+		}
+
+		fieldGroup := awstypes.FieldGroup{
+			Fields: []awstypes.FieldItem{},
+		}
+
+		if v := section.FieldGroup.Name; v != "" {
+			fieldGroup.Name = aws.String(v)
+		}
+
+		for _, field := range section.FieldGroup.Fields {
+			fieldGroup.Fields = append(fieldGroup.Fields, awstypes.FieldItem{
+				Id: aws.String(field.ID),
+			})
+		}
+
+		apiObject.Sections = append(apiObject.Sections, &awstypes.SectionMemberFieldGroup{
+			Value: fieldGroup,
+		})
+	}
+
+	return apiObject
+}
+
+func flattenLayoutContent(apiObject awstypes.LayoutContent) (string, error) {
+	var doc layoutContentDocument
+
+	if v, ok := apiObject.(*awstypes.LayoutContentMemberBasic); ok {
+		doc.Basic = &basicLayoutDocument{
+			MoreInfo: flattenLayoutSections(v.Value.MoreInfo),
+			TopPanel: flattenLayoutSections(v.Value.TopPanel),
+		}
+	}
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func flattenLayoutSections(apiObject *awstypes.LayoutSections) *layoutSectionsDocument {
+	if apiObject == nil {
+		return nil
+	}
+
+	doc := &layoutSectionsDocument{
+		Sections: []layoutSectionDocument{},
+	}
+
+	for _, section := range apiObject.Sections {
+		v, ok := section.(*awstypes.SectionMemberFieldGroup)
+		if !ok {
+			continue
+		}
+
+		fieldGroup := &fieldGroupDocument{
+			Fields: []fieldItemDocument{},
+			Name:   aws.ToString(v.Value.Name),
+		}
+
+		for _, field := range v.Value.Fields {
+			fieldGroup.Fields = append(fieldGroup.Fields, fieldItemDocument{
+				ID: aws.ToString(field.Id),
+			})
+		}
+
+		doc.Sections = append(doc.Sections, layoutSectionDocument{
+			FieldGroup: fieldGroup,
+		})
+	}
+
+	return doc
+}
+
+// normalizeLayoutContent round-trips a layout content document through its API
+// representation so that it can be compared with the document read back from AWS.
+func normalizeLayoutContent(s string) (string, error) {
+	content, err := expandLayoutContent(s)
+	if err != nil {
+		return "", err
+	}
+
+	return flattenLayoutContent(content)
+}
@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_template", name="Template")
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateResource{}
+
+	return r, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 255),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"required_fields": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(100),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TemplateStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"layout_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[layoutConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"default_layout": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &connectcases.CreateTemplateInput{
+		Description:    fwflex.StringFromFramework(ctx, data.Description),
+		DomainId:       fwflex.StringFromFramework(ctx, data.DomainID),
+		Name:           aws.String(name),
+		RequiredFields: expandRequiredFields(fwflex.ExpandFrameworkStringValueSet(ctx, data.RequiredFields)),
+	}
+
+	if !data.Status.IsUnknown() {
+		input.Status = data.Status.ValueEnum()
+	}
+
+	layoutConfiguration, diags := data.LayoutConfiguration.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if layoutConfiguration != nil {
+		input.LayoutConfiguration = &awstypes.LayoutConfiguration{
+			DefaultLayout: fwflex.StringFromFramework(ctx, layoutConfiguration.DefaultLayout),
+		}
+	}
+
+	output, err := conn.CreateTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Template (%s)", name), err.Error())
+
+		return
+	}
+
+	data.TemplateID = fwflex.StringToFramework(ctx, output.TemplateId)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID Connect Cases Template", err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	template, err := findTemplateByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.TemplateID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.TemplateArn)
+	data.Status = fwtypes.StringEnumValue(template.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	output, err := findTemplateByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.TemplateID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.TemplateArn)
+	data.Description = fwflex.EmptyStringAsNull(fwflex.StringToFramework(ctx, output.Description))
+	if v := output.LayoutConfiguration; v != nil && v.DefaultLayout != nil {
+		data.LayoutConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &layoutConfigurationModel{
+			DefaultLayout: fwflex.StringToFramework(ctx, v.DefaultLayout),
+		})
+	} else {
+		data.LayoutConfiguration = fwtypes.NewListNestedObjectValueOfNull[layoutConfigurationModel](ctx)
+	}
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.RequiredFields = fwflex.FlattenFrameworkStringValueSet(ctx, flattenRequiredFields(output.RequiredFields))
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	// UpdateTemplate treats omitted values as unchanged, so send the complete
+	// configuration with explicit empty values for anything that was removed.
+	input := &connectcases.UpdateTemplateInput{
+		Description:         aws.String(new.Description.ValueString()),
+		DomainId:            fwflex.StringFromFramework(ctx, new.DomainID),
+		LayoutConfiguration: &awstypes.LayoutConfiguration{},
+		Name:                fwflex.StringFromFramework(ctx, new.Name),
+		RequiredFields:      []awstypes.RequiredField{},
+		TemplateId:          fwflex.StringFromFramework(ctx, new.TemplateID),
+	}
+
+	if v := expandRequiredFields(fwflex.ExpandFrameworkStringValueSet(ctx, new.RequiredFields)); v != nil {
+		input.RequiredFields = v
+	}
+
+	if !new.Status.IsUnknown() {
+		input.Status = new.Status.ValueEnum()
+	}
+
+	layoutConfiguration, diags := new.LayoutConfiguration.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if layoutConfiguration != nil {
+		input.LayoutConfiguration.DefaultLayout = fwflex.StringFromFramework(ctx, layoutConfiguration.DefaultLayout)
+	}
+
+	_, err := conn.UpdateTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Connect Cases Template (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if new.Status.IsUnknown() {
+		template, err := findTemplateByTwoPartKey(ctx, conn, new.DomainID.ValueString(), new.TemplateID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(template.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	input := connectcases.DeleteTemplateInput{
+		DomainId:   data.DomainID.ValueStringPointer(),
+		TemplateId: data.TemplateID.ValueStringPointer(),
+	}
+	_, err := conn.DeleteTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findTemplateByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, templateID string) (*connectcases.GetTemplateOutput, error) {
+	input := &connectcases.GetTemplateInput{
+		DomainId:   aws.String(domainID),
+		TemplateId: aws.String(templateID),
+	}
+
+	output, err := conn.GetTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if output.Deleted {
+		return nil, &retry.NotFoundError{
+			Message:     "deleted",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func expandRequiredFields(fieldIDs []string) []awstypes.RequiredField {
+	if len(fieldIDs) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]awstypes.RequiredField, 0, len(fieldIDs))
+
+	for _, v := range fieldIDs {
+		apiObjects = append(apiObjects, awstypes.RequiredField{
+			FieldId: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRequiredFields(apiObjects []awstypes.RequiredField) []string {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	fieldIDs := make([]string, 0, len(apiObjects))
+
+	for _, v := range apiObjects {
+		fieldIDs = append(fieldIDs, aws.ToString(v.FieldId))
+	}
+
+	return fieldIDs
+}
+
+type templateResourceModel struct {
+	ARN                 types.String                                              `tfsdk:"arn"`
+	Description         types.String                                              `tfsdk:"description"`
+	DomainID            types.String                                              `tfsdk:"domain_id"`
+	ID                  types.String                                              `tfsdk:"id"`
+	LayoutConfiguration fwtypes.ListNestedObjectValueOf[layoutConfigurationModel] `tfsdk:"layout_configuration"`
+	Name                types.String                                              `tfsdk:"name"`
+	RequiredFields      types.Set                                                 `tfsdk:"required_fields"`
+	Status              fwtypes.StringEnum[awstypes.TemplateStatus]               `tfsdk:"status"`
+	TemplateID          types.String                                              `tfsdk:"template_id"`
+}
+
+type layoutConfigurationModel struct {
+	DefaultLayout types.String `tfsdk:"default_layout"`
+}
+
+const (
+	templateResourceIDPartCount = 2
+)
+
+func (m *templateResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), templateResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.DomainID = types.StringValue(parts[0])
+	m.TemplateID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *templateResourceModel) setID() (string, error) {
+	parts := []string{
+		m.DomainID.ValueString(),
+		m.TemplateID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, templateResourceIDPartCount, false)
+}
@@ -28,6 +28,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceCalculatedAttributeDefinition,
+			TypeName: "aws_customerprofiles_calculated_attribute_definition",
+			Name:     "Calculated Attribute Definition",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceDomain,
 			TypeName: "aws_customerprofiles_domain",
@@ -89,10 +89,19 @@ func (r *resourceEnvironmentBlueprintConfiguration) Create(ctx context.Context,
 		return
 	}
 
+	environmentBlueprintID, err := findEnvironmentBlueprintIDByIdentifier(ctx, conn, plan.DomainId.ValueString(), plan.EnvironmentBlueprintId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEnvironmentBlueprintConfiguration, plan.EnvironmentBlueprintId.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
 	in := &datazone.PutEnvironmentBlueprintConfigurationInput{
 		DomainIdentifier:               plan.DomainId.ValueStringPointer(),
 		EnabledRegions:                 flex.ExpandFrameworkStringValueList(ctx, plan.EnabledRegions),
-		EnvironmentBlueprintIdentifier: plan.EnvironmentBlueprintId.ValueStringPointer(),
+		EnvironmentBlueprintIdentifier: aws.String(environmentBlueprintID),
 	}
 
 	if !plan.ManageAccessRoleArn.IsNull() {
@@ -142,7 +151,16 @@ func (r *resourceEnvironmentBlueprintConfiguration) Read(ctx context.Context, re
 		return
 	}
 
-	out, err := findEnvironmentBlueprintConfigurationByIDs(ctx, conn, state.DomainId.ValueString(), state.EnvironmentBlueprintId.ValueString())
+	environmentBlueprintID, err := findEnvironmentBlueprintIDByIdentifier(ctx, conn, state.DomainId.ValueString(), state.EnvironmentBlueprintId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameEnvironmentBlueprintConfiguration, state.EnvironmentBlueprintId.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findEnvironmentBlueprintConfigurationByIDs(ctx, conn, state.DomainId.ValueString(), environmentBlueprintID)
 	if tfresource.NotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -157,7 +175,10 @@ func (r *resourceEnvironmentBlueprintConfiguration) Read(ctx context.Context, re
 
 	state.DomainId = flex.StringToFramework(ctx, out.DomainId)
 	state.EnabledRegions = flattenEnabledRegions(ctx, out.EnabledRegions)
-	state.EnvironmentBlueprintId = flex.StringToFramework(ctx, out.EnvironmentBlueprintId)
+	// Keep an AWS-managed blueprint's configured name rather than replacing it with the ID.
+	if environmentBlueprintID == state.EnvironmentBlueprintId.ValueString() {
+		state.EnvironmentBlueprintId = flex.StringToFramework(ctx, out.EnvironmentBlueprintId)
+	}
 	state.ManageAccessRoleArn = flex.StringToFrameworkARN(ctx, out.ManageAccessRoleArn)
 	state.ProvisioningRoleArn = flex.StringToFrameworkARN(ctx, out.ProvisioningRoleArn)
 
@@ -182,10 +203,19 @@ func (r *resourceEnvironmentBlueprintConfiguration) Update(ctx context.Context,
 		!plan.ManageAccessRoleArn.Equal(state.ManageAccessRoleArn) ||
 		!plan.ProvisioningRoleArn.Equal(state.ProvisioningRoleArn) ||
 		!plan.RegionalParameters.Equal(state.RegionalParameters) {
+		environmentBlueprintID, err := findEnvironmentBlueprintIDByIdentifier(ctx, conn, plan.DomainId.ValueString(), plan.EnvironmentBlueprintId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameEnvironmentBlueprintConfiguration, plan.EnvironmentBlueprintId.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
 		in := &datazone.PutEnvironmentBlueprintConfigurationInput{
 			DomainIdentifier:               plan.DomainId.ValueStringPointer(),
 			EnabledRegions:                 flex.ExpandFrameworkStringValueList(ctx, plan.EnabledRegions),
-			EnvironmentBlueprintIdentifier: plan.EnvironmentBlueprintId.ValueStringPointer(),
+			EnvironmentBlueprintIdentifier: aws.String(environmentBlueprintID),
 		}
 
 		if !plan.ManageAccessRoleArn.IsNull() {
@@ -235,12 +265,21 @@ func (r *resourceEnvironmentBlueprintConfiguration) Delete(ctx context.Context,
 		return
 	}
 
+	environmentBlueprintID, err := findEnvironmentBlueprintIDByIdentifier(ctx, conn, state.DomainId.ValueString(), state.EnvironmentBlueprintId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameEnvironmentBlueprintConfiguration, state.EnvironmentBlueprintId.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
 	in := &datazone.DeleteEnvironmentBlueprintConfigurationInput{
 		DomainIdentifier:               state.DomainId.ValueStringPointer(),
-		EnvironmentBlueprintIdentifier: state.EnvironmentBlueprintId.ValueStringPointer(),
+		EnvironmentBlueprintIdentifier: aws.String(environmentBlueprintID),
 	}
 
-	_, err := conn.DeleteEnvironmentBlueprintConfiguration(ctx, in)
+	_, err = conn.DeleteEnvironmentBlueprintConfiguration(ctx, in)
 	if err != nil {
 		if isResourceMissing(err) {
 			return
@@ -262,16 +301,48 @@ func (r *resourceEnvironmentBlueprintConfiguration) ImportState(ctx context.Cont
 	domainId := parts[0]
 	environmentBlueprintId := parts[1]
 
-	environmentBlueprintConfiguration, err := findEnvironmentBlueprintConfigurationByIDs(ctx, r.Meta().DataZoneClient(ctx), domainId, environmentBlueprintId)
+	conn := r.Meta().DataZoneClient(ctx)
+
+	id, err := findEnvironmentBlueprintIDByIdentifier(ctx, conn, domainId, environmentBlueprintId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Importing Resource",
 			err.Error(),
 		)
+		return
+	}
+
+	environmentBlueprintConfiguration, err := findEnvironmentBlueprintConfigurationByIDs(ctx, conn, domainId, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing Resource",
+			err.Error(),
+		)
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), aws.ToString(environmentBlueprintConfiguration.DomainId))...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_blueprint_id"), aws.ToString(environmentBlueprintConfiguration.EnvironmentBlueprintId))...)
+	if id == environmentBlueprintId {
+		environmentBlueprintId = aws.ToString(environmentBlueprintConfiguration.EnvironmentBlueprintId)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_blueprint_id"), environmentBlueprintId)...)
+}
+
+// findEnvironmentBlueprintIDByIdentifier returns the ID of the AWS-managed environment
+// blueprint with the specified name (e.g. DefaultDataLake), or the identifier unchanged
+// if it does not name an AWS-managed blueprint.
+func findEnvironmentBlueprintIDByIdentifier(ctx context.Context, conn *datazone.Client, domainId, identifier string) (string, error) {
+	blueprint, err := findEnvironmentBlueprintByName(ctx, conn, domainId, identifier, true)
+
+	if tfresource.NotFound(err) {
+		return identifier, nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(blueprint.Id), nil
 }
 
 func findEnvironmentBlueprintConfigurationByIDs(ctx context.Context, conn *datazone.Client, domainId, environmentBlueprintId string) (*datazone.GetEnvironmentBlueprintConfigurationOutput, error) {
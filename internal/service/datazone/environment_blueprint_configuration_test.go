@@ -57,6 +57,41 @@ func TestAccDataZoneEnvironmentBlueprintConfiguration_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataZoneEnvironmentBlueprintConfiguration_managedBlueprintName(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var environmentblueprintconfiguration datazone.GetEnvironmentBlueprintConfigurationOutput
+	domainName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_blueprint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentBlueprintConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_managedBlueprintName(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(ctx, resourceName, &environmentblueprintconfiguration),
+					resource.TestCheckResourceAttr(resourceName, "environment_blueprint_id", "DefaultDataWarehouse"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_regions.#", "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccEnvironmentBlueprintConfigurationImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: "environment_blueprint_id",
+			},
+		},
+	})
+}
+
 func TestAccDataZoneEnvironmentBlueprintConfiguration_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -287,9 +322,15 @@ func testAccCheckEnvironmentBlueprintConfigurationExists(ctx context.Context, na
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		environmentBlueprintID, err := tfdatazone.FindEnvironmentBlueprintIDByIdentifier(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["environment_blueprint_id"])
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEnvironmentBlueprintConfiguration, rs.Primary.ID, err)
+		}
+
 		input := datazone.GetEnvironmentBlueprintConfigurationInput{
 			DomainIdentifier:               aws.String(rs.Primary.Attributes["domain_id"]),
-			EnvironmentBlueprintIdentifier: aws.String(rs.Primary.Attributes["environment_blueprint_id"]),
+			EnvironmentBlueprintIdentifier: aws.String(environmentBlueprintID),
 		}
 		resp, err := conn.GetEnvironmentBlueprintConfiguration(ctx, &input)
 
@@ -327,6 +368,19 @@ resource "aws_datazone_environment_blueprint_configuration" "test" {
 	)
 }
 
+func testAccEnvironmentBlueprintConfigurationConfig_managedBlueprintName(domainName string) string {
+	return acctest.ConfigCompose(
+		testAccDomainConfig_basic(domainName),
+		`
+resource "aws_datazone_environment_blueprint_configuration" "test" {
+  domain_id                = aws_datazone_domain.test.id
+  environment_blueprint_id = "DefaultDataWarehouse"
+  enabled_regions          = []
+}
+`,
+	)
+}
+
 func testAccEnvironmentBlueprintConfigurationConfig_enabled_regions(domainName, enabledRegion string) string {
 	return acctest.ConfigCompose(
 		testAccEnvironmentBlueprintDataSourceConfig_basic(domainName),
@@ -16,13 +16,14 @@ var (
 	ResourceProject                           = newResourceProject
 	ResourceUserProfile                       = newResourceUserProfile
 
-	FindAssetTypeByID          = findAssetTypeByID
-	FindEnvironmentByID        = findEnvironmentByID
-	FindEnvironmentProfileByID = findEnvironmentProfileByID
-	FindFormTypeByID           = findFormTypeByID
-	FindGlossaryByID           = findGlossaryByID
-	FindGlossaryTermByID       = findGlossaryTermByID
-	FindUserProfileByID        = findUserProfileByID
+	FindAssetTypeByID                      = findAssetTypeByID
+	FindEnvironmentBlueprintIDByIdentifier = findEnvironmentBlueprintIDByIdentifier
+	FindEnvironmentByID                    = findEnvironmentByID
+	FindEnvironmentProfileByID             = findEnvironmentProfileByID
+	FindFormTypeByID                       = findFormTypeByID
+	FindGlossaryByID                       = findGlossaryByID
+	FindGlossaryTermByID                   = findGlossaryTermByID
+	FindUserProfileByID                    = findUserProfileByID
 
 	IsResourceMissing = isResourceMissing
 )
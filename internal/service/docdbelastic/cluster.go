@@ -5,6 +5,7 @@ package docdbelastic
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -134,6 +136,26 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					int64validator.Between(1, 32),
 				},
 			},
+			"shard_instance_count": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 16),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.StatusActive, awstypes.StatusStopped)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrSubnetIDs: schema.SetAttribute{
 				CustomType: fwtypes.SetOfStringType,
 				Optional:   true,
@@ -218,12 +240,26 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
+	if plan.State.ValueString() == string(awstypes.StatusStopped) {
+		out, err = stopCluster(ctx, conn, state.ID.ValueString(), createTimeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &state, optionPrefix)...)
 
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	state.State = fwflex.StringValueToFramework(ctx, out.Status)
+
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -259,6 +295,8 @@ func (r *resourceCluster) Read(ctx context.Context, request resource.ReadRequest
 		return
 	}
 
+	state.State = fwflex.StringValueToFramework(ctx, out.Status)
+
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -278,12 +316,27 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 		return
 	}
 
-	diff, d := fwflex.Calculate(ctx, plan, state)
+	diff, d := fwflex.Calculate(ctx, plan, state, fwflex.WithIgnoredField("State"))
 	response.Diagnostics.Append(d...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+	shouldStart := !plan.State.IsUnknown() && !plan.State.Equal(state.State) && plan.State.ValueString() == string(awstypes.StatusActive)
+	shouldStop := !plan.State.IsUnknown() && !plan.State.Equal(state.State) && plan.State.ValueString() == string(awstypes.StatusStopped)
+
+	// A stopped cluster can't be modified, so start it before applying any other changes.
+	if shouldStart {
+		if _, err := startCluster(ctx, conn, state.ID.ValueString(), updateTimeout); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionUpdating, ResNameCluster, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	if diff.HasChanges() {
 		input := docdbelastic.UpdateClusterInput{}
 		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, diff.IgnoredFieldNamesOpts()...)...)
@@ -303,12 +356,31 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 			return
 		}
 
-		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
-		out, err := waitClusterUpdated(ctx, conn, state.ID.ValueString(), updateTimeout)
+		if _, err := waitClusterUpdated(ctx, conn, state.ID.ValueString(), updateTimeout); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForUpdate, ResNameCluster, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if shouldStop {
+		if _, err := stopCluster(ctx, conn, state.ID.ValueString(), updateTimeout); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionUpdating, ResNameCluster, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if diff.HasChanges() || shouldStart || shouldStop {
+		out, err := findClusterByID(ctx, conn, state.ID.ValueString())
 
 		if err != nil {
 			response.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForUpdate, ResNameCluster, state.ID.ValueString(), err),
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionReading, ResNameCluster, state.ID.ValueString(), err),
 				err.Error(),
 			)
 			return
@@ -320,7 +392,7 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 			return
 		}
 
-		response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+		plan.State = fwflex.StringValueToFramework(ctx, out.Status)
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
@@ -388,6 +460,8 @@ type resourceClusterData struct {
 	PreferredMaintenanceWindow fwtypes.OnceAWeekWindow           `tfsdk:"preferred_maintenance_window"`
 	ShardCapacity              types.Int64                       `tfsdk:"shard_capacity"`
 	ShardCount                 types.Int64                       `tfsdk:"shard_count"`
+	ShardInstanceCount         types.Int32                       `tfsdk:"shard_instance_count"`
+	State                      types.String                      `tfsdk:"state"`
 	SubnetIds                  fwtypes.SetValueOf[types.String]  `tfsdk:"subnet_ids"`
 	Tags                       tftags.Map                        `tfsdk:"tags"`
 	TagsAll                    tftags.Map                        `tfsdk:"tags_all"`
@@ -431,9 +505,43 @@ func waitClusterUpdated(ctx context.Context, conn *docdbelastic.Client, id strin
 	return nil, err
 }
 
+func waitClusterStarted(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.StatusStopped, awstypes.StatusStarting),
+		Target:                    enum.Slice(awstypes.StatusActive),
+		Refresh:                   statusCluster(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.Cluster); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitClusterStopped(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.StatusActive, awstypes.StatusStopping),
+		Target:                    enum.Slice(awstypes.StatusStopped),
+		Refresh:                   statusCluster(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.Cluster); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
 func waitClusterDeleted(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusDeleting),
+		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusStopped, awstypes.StatusDeleting),
 		Target:  []string{},
 		Refresh: statusCluster(ctx, conn, id),
 		Timeout: timeout,
@@ -462,6 +570,42 @@ func statusCluster(ctx context.Context, conn *docdbelastic.Client, id string) re
 	}
 }
 
+func startCluster(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	_, err := conn.StartCluster(ctx, &docdbelastic.StartClusterInput{
+		ClusterArn: aws.String(id),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("starting: %w", err)
+	}
+
+	out, err := waitClusterStarted(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for start: %w", err)
+	}
+
+	return out, nil
+}
+
+func stopCluster(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	_, err := conn.StopCluster(ctx, &docdbelastic.StopClusterInput{
+		ClusterArn: aws.String(id),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("stopping: %w", err)
+	}
+
+	out, err := waitClusterStopped(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for stop: %w", err)
+	}
+
+	return out, nil
+}
+
 func findClusterByID(ctx context.Context, conn *docdbelastic.Client, id string) (*awstypes.Cluster, error) {
 	in := &docdbelastic.GetClusterInput{
 		ClusterArn: aws.String(id),
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSQLClusterPeering_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v dsql.GetClusterOutput
+	resourceName := "aws_dsql_cluster_peering.test"
+	clusterResourceName := "aws_dsql_cluster.test"
+	peerClusterResourceName := "aws_dsql_cluster.alt"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 3)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSQLServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterPeeringConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, clusterResourceName, &v),
+					resource.TestCheckResourceAttr(clusterResourceName, "multi_region_properties.#", "1"),
+					resource.TestCheckResourceAttr(clusterResourceName, "multi_region_properties.0.witness_region", acctest.ThirdRegion()),
+					resource.TestCheckResourceAttr(resourceName, "clusters.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "clusters.*", peerClusterResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrIdentifier, clusterResourceName, names.AttrIdentifier),
+					resource.TestCheckResourceAttr(resourceName, "witness_region", acctest.ThirdRegion()),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccClusterPeeringConfig_basic() string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+resource "aws_dsql_cluster" "test" {
+  deletion_protection_enabled = false
+
+  multi_region_properties {
+    witness_region = %[1]q
+  }
+}
+
+resource "aws_dsql_cluster" "alt" {
+  provider = "awsalternate"
+
+  deletion_protection_enabled = false
+
+  multi_region_properties {
+    witness_region = %[1]q
+  }
+}
+
+resource "aws_dsql_cluster_peering" "test" {
+  identifier     = aws_dsql_cluster.test.identifier
+  clusters       = [aws_dsql_cluster.alt.arn]
+  witness_region = aws_dsql_cluster.test.multi_region_properties[0].witness_region
+}
+
+resource "aws_dsql_cluster_peering" "alt" {
+  provider = "awsalternate"
+
+  identifier     = aws_dsql_cluster.alt.identifier
+  clusters       = [aws_dsql_cluster.test.arn]
+  witness_region = aws_dsql_cluster.alt.multi_region_properties[0].witness_region
+}
+`, acctest.ThirdRegion()))
+}
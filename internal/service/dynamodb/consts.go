@@ -8,3 +8,8 @@ import "time"
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	// mrscReplicaCount is the number of replicas in a global table with multi-Region strong consistency.
+	mrscReplicaCount = 2
+)
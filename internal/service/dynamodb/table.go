@@ -126,6 +126,12 @@ func resourceTable() *schema.Resource {
 			customdiff.ForceNewIfChange("restore_source_table_arn", func(_ context.Context, old, new, meta interface{}) bool {
 				return old.(string) != new.(string) && new.(string) != ""
 			}),
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return validateWarmThroughputDecrease(diff)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return validateReplicaConsistencyMode(diff)
+			},
 			validateTTLCustomDiff,
 			verify.SetTagsDiff,
 		),
@@ -200,6 +206,7 @@ func resourceTable() *schema.Resource {
 							Optional: true,
 							Computed: true,
 						},
+						"warm_throughput": warmThroughputSchema(),
 						"write_capacity": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -286,6 +293,13 @@ func resourceTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"consistency_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          awstypes.MultiRegionConsistencyEventual,
+							ValidateDiagFunc: enum.Validate[awstypes.MultiRegionConsistency](),
+							// update is equivalent of force a new *replica*, not table
+						},
 						names.AttrKMSKeyARN: {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -491,6 +505,7 @@ func resourceTable() *schema.Resource {
 				},
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
+			"warm_throughput": warmThroughputSchema(),
 			"write_capacity": {
 				Type:          schema.TypeInt,
 				Computed:      true,
@@ -529,6 +544,31 @@ func onDemandThroughputSchema() *schema.Schema {
 	}
 }
 
+func warmThroughputSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"read_units_per_second": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(12000),
+				},
+				"write_units_per_second": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(4000),
+				},
+			},
+		},
+	}
+}
+
 func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
@@ -762,6 +802,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.TableClass = awstypes.TableClass(v.(string))
 		}
 
+		if v, ok := d.GetOk("warm_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.WarmThroughput = expandWarmThroughput(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		_, err := tfresource.RetryWhen(ctx, createTableTimeout, func() (interface{}, error) {
 			return conn.CreateTable(ctx, input)
 		}, func(err error) (bool, error) {
@@ -803,6 +847,15 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	// Neither RestoreTableToPointInTime nor ImportTable support setting warm throughput.
+	if nameOk || arnOk || d.Get("import_table.#").(int) > 0 {
+		if v, ok := d.GetOk("warm_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := updateWarmThroughput(ctx, conn, d.Id(), expandWarmThroughput(v.([]interface{})[0].(map[string]interface{})), d.Timeout(schema.TimeoutCreate)); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, d.Id(), fmt.Errorf("warm throughput: %w", err))
+			}
+		}
+	}
+
 	if d.Get("ttl.0.enabled").(bool) {
 		if err := updateTimeToLive(ctx, conn, d.Id(), d.Get("ttl").([]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, d.Id(), fmt.Errorf("enabling TTL: %w", err))
@@ -878,7 +931,10 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "local_secondary_index", err)
 	}
 
-	if err := d.Set("global_secondary_index", flattenTableGlobalSecondaryIndex(table.GlobalSecondaryIndexes)); err != nil {
+	gsis := flattenTableGlobalSecondaryIndex(table.GlobalSecondaryIndexes)
+	gsis = clearGSIWarmThroughputs(d.Get("global_secondary_index").(*schema.Set), gsis)
+
+	if err := d.Set("global_secondary_index", gsis); err != nil {
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "global_secondary_index", err)
 	}
 
@@ -915,6 +971,7 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	replicas = addReplicaTagPropagates(d.Get("replica").(*schema.Set), replicas)
+	replicas = addReplicaConsistencyModes(table.MultiRegionConsistency, replicas)
 	replicas = clearReplicaDefaultKeys(ctx, meta.(*conns.AWSClient), replicas)
 
 	if err := d.Set("replica", replicas); err != nil {
@@ -927,6 +984,10 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		d.Set("table_class", awstypes.TableClassStandard)
 	}
 
+	if err := d.Set("warm_throughput", flattenTableWarmThroughput(table.WarmThroughput)); err != nil {
+		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "warm_throughput", err)
+	}
+
 	describeBackupsInput := dynamodb.DescribeContinuousBackupsInput{
 		TableName: aws.String(d.Id()),
 	}
@@ -1080,7 +1141,7 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	// Must update all indexes when switching BillingMode from PAY_PER_REQUEST to PROVISIONED
 	if newBillingMode == awstypes.BillingModeProvisioned {
 		for _, gsiUpdate := range gsiUpdates {
-			if gsiUpdate.Update == nil {
+			if gsiUpdate.Update == nil || gsiUpdate.Update.WarmThroughput != nil {
 				continue
 			}
 
@@ -1125,6 +1186,35 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	// Warm throughput can't be changed concurrently with other values.
+	if d.HasChange("warm_throughput") {
+		if v, ok := d.GetOk("warm_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := updateWarmThroughput(ctx, conn, d.Id(), expandWarmThroughput(v.([]interface{})[0].(map[string]interface{})), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), fmt.Errorf("warm throughput: %w", err))
+			}
+		}
+	}
+
+	for _, gsiUpdate := range gsiUpdates {
+		if gsiUpdate.Update == nil || gsiUpdate.Update.WarmThroughput == nil {
+			continue
+		}
+
+		idxName := aws.ToString(gsiUpdate.Update.IndexName)
+		input := &dynamodb.UpdateTableInput{
+			GlobalSecondaryIndexUpdates: []awstypes.GlobalSecondaryIndexUpdate{gsiUpdate},
+			TableName:                   aws.String(d.Id()),
+		}
+
+		if _, err := conn.UpdateTable(ctx, input); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), fmt.Errorf("GSI (%s) warm throughput: %w", idxName, err))
+		}
+
+		if _, err := waitGSIWarmThroughputActive(ctx, conn, d.Id(), idxName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForUpdate, resNameTable, d.Id(), fmt.Errorf("GSI (%s) warm throughput: %w", idxName, err))
+		}
+	}
+
 	// Phase 3 of Global Secondary Index Operations: Create Only
 	// Only 1 online index can be created simultaneously per table
 	for _, gsiUpdate := range gsiUpdates {
@@ -1348,6 +1438,11 @@ func cycleStreamEnabled(ctx context.Context, conn *dynamodb.Client, id string, s
 }
 
 func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string, tfList []interface{}, create bool, timeout time.Duration) error {
+	// Replicas with multi-Region strong consistency must all be created in a single request.
+	if create && replicasConsistencyMode(tfList) == awstypes.MultiRegionConsistencyStrong {
+		return createStrongConsistencyReplicas(ctx, conn, tableName, tfList, timeout)
+	}
+
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
 
@@ -1450,6 +1545,109 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 	return nil
 }
 
+func createStrongConsistencyReplicas(ctx context.Context, conn *dynamodb.Client, tableName string, tfList []interface{}, timeout time.Duration) error {
+	input := &dynamodb.UpdateTableInput{
+		MultiRegionConsistency: awstypes.MultiRegionConsistencyStrong,
+		TableName:              aws.String(tableName),
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var replicaInput = &awstypes.CreateReplicationGroupMemberAction{}
+
+		if v, ok := tfMap["region_name"].(string); ok && v != "" {
+			replicaInput.RegionName = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrKMSKeyARN].(string); ok && v != "" {
+			replicaInput.KMSMasterKeyId = aws.String(v)
+		}
+
+		input.ReplicaUpdates = append(input.ReplicaUpdates, awstypes.ReplicationGroupUpdate{
+			Create: replicaInput,
+		})
+	}
+
+	_, err := tfresource.RetryWhen(ctx, max(replicaUpdateTimeout, timeout), func() (interface{}, error) {
+		return conn.UpdateTable(ctx, input)
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) {
+			return true, err
+		}
+		if errs.IsAErrorMessageContains[*awstypes.LimitExceededException](err, "can be created, updated, or deleted simultaneously") {
+			return true, err
+		}
+		if errs.IsA[*awstypes.ResourceInUseException](err) {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	if err != nil {
+		return fmt.Errorf("creating replicas with strong consistency: %w", err)
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		region := tfMap["region_name"].(string)
+
+		if _, err := waitReplicaActive(ctx, conn, tableName, region, timeout, replicaDelayDefault); err != nil {
+			return fmt.Errorf("waiting for replica (%s) creation: %w", region, err)
+		}
+
+		if err := updatePITR(ctx, conn, tableName, tfMap["point_in_time_recovery"].(bool), region, timeout); err != nil {
+			return fmt.Errorf("updating replica (%s) point in time recovery: %w", region, err)
+		}
+	}
+
+	return nil
+}
+
+// replicasConsistencyMode returns the multi-Region consistency requested by the specified replicas.
+func replicasConsistencyMode(tfList []interface{}) awstypes.MultiRegionConsistency {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["consistency_mode"].(string); ok && v == string(awstypes.MultiRegionConsistencyStrong) {
+			return awstypes.MultiRegionConsistencyStrong
+		}
+	}
+
+	return awstypes.MultiRegionConsistencyEventual
+}
+
+func updateWarmThroughput(ctx context.Context, conn *dynamodb.Client, tableName string, warmThroughput *awstypes.WarmThroughput, timeout time.Duration) error {
+	input := dynamodb.UpdateTableInput{
+		TableName:      aws.String(tableName),
+		WarmThroughput: warmThroughput,
+	}
+
+	if _, err := conn.UpdateTable(ctx, &input); err != nil {
+		return err
+	}
+
+	if _, err := waitTableWarmThroughputActive(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func updateReplicaTags(ctx context.Context, conn *dynamodb.Client, rn string, replicas []interface{}, newTags interface{}) error {
 	for _, tfMapRaw := range replicas {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -1611,8 +1809,8 @@ func updateReplica(ctx context.Context, conn *dynamodb.Client, d *schema.Resourc
 				continue
 			}
 
-			// like "ForceNew" for the replica - KMS change
-			if ma[names.AttrKMSKeyARN].(string) != mr[names.AttrKMSKeyARN].(string) {
+			// like "ForceNew" for the replica - KMS or consistency mode change
+			if ma[names.AttrKMSKeyARN].(string) != mr[names.AttrKMSKeyARN].(string) || ma["consistency_mode"].(string) != mr["consistency_mode"].(string) {
 				toRemove = append(toRemove, mr)
 				toAdd = append(toAdd, ma)
 				break
@@ -1690,6 +1888,10 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 				c.OnDemandThroughput = expandOnDemandThroughput(v[0].(map[string]any))
 			}
 
+			if v, ok := m["warm_throughput"].([]any); ok && len(v) > 0 && v[0] != nil {
+				c.WarmThroughput = expandWarmThroughput(v[0].(map[string]any))
+			}
+
 			ops = append(ops, awstypes.GlobalSecondaryIndexUpdate{
 				Create: &c,
 			})
@@ -1723,6 +1925,16 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 				onDemandThroughputChanged = true
 			}
 
+			var oldWarmThroughput, newWarmThroughput *awstypes.WarmThroughput
+			if v, ok := oldMap["warm_throughput"].([]any); ok && len(v) > 0 && v[0] != nil {
+				oldWarmThroughput = expandWarmThroughput(v[0].(map[string]any))
+			}
+
+			if v, ok := newMap["warm_throughput"].([]any); ok && len(v) > 0 && v[0] != nil {
+				newWarmThroughput = expandWarmThroughput(v[0].(map[string]any))
+			}
+			warmThroughputChanged := newWarmThroughput != nil && !reflect.DeepEqual(oldWarmThroughput, newWarmThroughput)
+
 			// pluck non_key_attributes from oldAttributes and newAttributes as reflect.DeepEquals will compare
 			// ordinal of elements in its equality (which we actually don't care about)
 			nonKeyAttributesChanged := checkIfNonKeyAttributesChanged(oldMap, newMap)
@@ -1739,6 +1951,10 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 			if err != nil {
 				return ops, err
 			}
+			oldAttributes, err = stripWarmThroughputAttributes(oldAttributes)
+			if err != nil {
+				return ops, err
+			}
 			newAttributes, err := stripCapacityAttributes(newMap)
 			if err != nil {
 				return ops, err
@@ -1751,6 +1967,10 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 			if err != nil {
 				return ops, err
 			}
+			newAttributes, err = stripWarmThroughputAttributes(newAttributes)
+			if err != nil {
+				return ops, err
+			}
 			otherAttributesChanged := nonKeyAttributesChanged || !reflect.DeepEqual(oldAttributes, newAttributes)
 
 			if capacityChanged && !otherAttributesChanged && billingMode == awstypes.BillingModeProvisioned {
@@ -1783,6 +2003,17 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 						KeySchema:             expandKeySchema(newMap),
 						ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
 						Projection:            expandProjection(newMap),
+						WarmThroughput:        newWarmThroughput,
+					},
+				})
+			}
+
+			// Warm throughput is updated separately from any other index change.
+			if warmThroughputChanged && !otherAttributesChanged {
+				ops = append(ops, awstypes.GlobalSecondaryIndexUpdate{
+					Update: &awstypes.UpdateGlobalSecondaryIndexAction{
+						IndexName:      aws.String(idxName),
+						WarmThroughput: newWarmThroughput,
 					},
 				})
 			}
@@ -2020,6 +2251,46 @@ func addReplicaTagPropagates(configReplicas *schema.Set, replicas []interface{})
 	return replicas
 }
 
+// addReplicaConsistencyModes sets each replica's consistency_mode from the table's multi-Region consistency.
+func addReplicaConsistencyModes(consistency awstypes.MultiRegionConsistency, replicas []interface{}) []interface{} {
+	if consistency == "" {
+		consistency = awstypes.MultiRegionConsistencyEventual
+	}
+
+	for i, replicaRaw := range replicas {
+		replica := replicaRaw.(map[string]interface{})
+		replica["consistency_mode"] = string(consistency)
+		replicas[i] = replica
+	}
+
+	return replicas
+}
+
+// clearGSIWarmThroughputs removes warm_throughput from indexes that don't have it in state.
+// DynamoDB reports a default warm throughput for every index, which would otherwise
+// change the hash of indexes configured without it and cause a perpetual diff.
+func clearGSIWarmThroughputs(stateGSIs *schema.Set, gsis []interface{}) []interface{} {
+	withWarmThroughput := make(map[string]bool)
+	for _, gsiRaw := range stateGSIs.List() {
+		gsi := gsiRaw.(map[string]interface{})
+
+		if v, ok := gsi["warm_throughput"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			withWarmThroughput[gsi[names.AttrName].(string)] = true
+		}
+	}
+
+	for i, gsiRaw := range gsis {
+		gsi := gsiRaw.(map[string]interface{})
+
+		if name, _ := gsi[names.AttrName].(string); !withWarmThroughput[name] {
+			delete(gsi, "warm_throughput")
+		}
+		gsis[i] = gsi
+	}
+
+	return gsis
+}
+
 // clearSSEDefaultKey sets the kms_key_arn to "" if it is the default key alias/aws/dynamodb.
 // Not clearing the key causes diff problems and sends the key to AWS when it should not be.
 func clearSSEDefaultKey(ctx context.Context, client *conns.AWSClient, sseList []interface{}) []interface{} {
@@ -2160,6 +2431,10 @@ func flattenTableGlobalSecondaryIndex(gsi []awstypes.GlobalSecondaryIndexDescrip
 			gsi["on_demand_throughput"] = flattenOnDemandThroughput(g.OnDemandThroughput)
 		}
 
+		if g.WarmThroughput != nil {
+			gsi["warm_throughput"] = flattenGSIWarmThroughput(g.WarmThroughput)
+		}
+
 		output = append(output, gsi)
 	}
 
@@ -2209,6 +2484,32 @@ func flattenOnDemandThroughput(apiObject *awstypes.OnDemandThroughput) []interfa
 	return []interface{}{m}
 }
 
+func flattenTableWarmThroughput(apiObject *awstypes.TableWarmThroughputDescription) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"read_units_per_second":  aws.ToInt64(apiObject.ReadUnitsPerSecond),
+		"write_units_per_second": aws.ToInt64(apiObject.WriteUnitsPerSecond),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenGSIWarmThroughput(apiObject *awstypes.GlobalSecondaryIndexWarmThroughputDescription) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"read_units_per_second":  aws.ToInt64(apiObject.ReadUnitsPerSecond),
+		"write_units_per_second": aws.ToInt64(apiObject.WriteUnitsPerSecond),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenReplicaDescription(apiObject *awstypes.ReplicaDescription) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -2336,6 +2637,10 @@ func expandGlobalSecondaryIndex(data map[string]interface{}, billingMode awstype
 		output.OnDemandThroughput = expandOnDemandThroughput(v[0].(map[string]any))
 	}
 
+	if v, ok := data["warm_throughput"].([]any); ok && len(v) > 0 && v[0] != nil {
+		output.WarmThroughput = expandWarmThroughput(v[0].(map[string]any))
+	}
+
 	return &output
 }
 
@@ -2463,6 +2768,24 @@ func expandOnDemandThroughput(tfMap map[string]interface{}) *awstypes.OnDemandTh
 	return apiObject
 }
 
+func expandWarmThroughput(tfMap map[string]interface{}) *awstypes.WarmThroughput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.WarmThroughput{}
+
+	if v, ok := tfMap["read_units_per_second"].(int); ok && v != 0 {
+		apiObject.ReadUnitsPerSecond = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["write_units_per_second"].(int); ok && v != 0 {
+		apiObject.WriteUnitsPerSecond = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
 func expandS3BucketSource(data map[string]interface{}) *awstypes.S3BucketSource {
 	if data == nil {
 		return nil
@@ -2551,6 +2874,65 @@ func validateTableAttributes(d *schema.ResourceDiff) error {
 	return errors.Join(errs...)
 }
 
+// validateWarmThroughputDecrease rejects decreases in table warm throughput, which can only be increased.
+// DynamoDB may also raise a table's warm throughput itself, in which case the configuration must be updated to match.
+func validateWarmThroughputDecrease(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("warm_throughput") || !d.GetRawPlan().GetAttr("warm_throughput").IsWhollyKnown() {
+		return nil
+	}
+
+	var errs []error
+	for _, k := range []string{"read_units_per_second", "write_units_per_second"} {
+		key := "warm_throughput.0." + k
+		if !d.HasChange(key) {
+			continue
+		}
+
+		o, n := d.GetChange(key)
+		if old, new := o.(int), n.(int); new < old {
+			errs = append(errs, fmt.Errorf("%s cannot be decreased (from %d to %d); set it to at least the current value", key, old, new))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateReplicaConsistencyMode checks that all replicas use the same consistency mode and that
+// multi-Region strong consistency is only requested for the supported number of Regions.
+func validateReplicaConsistencyMode(d *schema.ResourceDiff) error {
+	if !d.GetRawPlan().GetAttr("replica").IsWhollyKnown() {
+		return nil
+	}
+
+	replicas := d.Get("replica").(*schema.Set).List()
+	var nStrong int
+	for _, replicaRaw := range replicas {
+		replica, ok := replicaRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := replica["consistency_mode"].(string); ok && v == string(awstypes.MultiRegionConsistencyStrong) {
+			nStrong++
+		}
+	}
+
+	if nStrong == 0 {
+		return nil
+	}
+
+	if nStrong != len(replicas) {
+		return fmt.Errorf("all replicas must use the same consistency_mode")
+	}
+
+	// A multi-Region strongly consistent global table spans exactly three Regions.
+	if n := len(replicas); n != mrscReplicaCount {
+		return fmt.Errorf("consistency_mode %q requires exactly %d replicas, got %d", awstypes.MultiRegionConsistencyStrong, mrscReplicaCount, n)
+	}
+
+	return nil
+}
+
 func validateGSIProvisionedThroughput(data map[string]interface{}, billingMode awstypes.BillingMode) error {
 	// if billing mode is PAY_PER_REQUEST, don't need to validate the throughput settings
 	if billingMode == awstypes.BillingModePayPerRequest {
@@ -98,6 +98,22 @@ func dataSourceTable() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"warm_throughput": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"read_units_per_second": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"write_units_per_second": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"write_capacity": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -245,6 +261,22 @@ func dataSourceTable() *schema.Resource {
 					},
 				},
 			},
+			"warm_throughput": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_units_per_second": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"write_units_per_second": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"write_capacity": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -324,6 +356,10 @@ func dataSourceTableRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "setting replica: %s", err)
 	}
 
+	if err := d.Set("warm_throughput", flattenTableWarmThroughput(table.WarmThroughput)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting warm_throughput: %s", err)
+	}
+
 	if table.TableClassSummary != nil {
 		d.Set("table_class", table.TableClassSummary.TableClass)
 	} else {
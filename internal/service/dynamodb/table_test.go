@@ -22,6 +22,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -322,6 +323,50 @@ func TestUpdateDiffGSI(t *testing.T) {
 				},
 			},
 		},
+
+		{ // Update of warm throughput only
+			Old: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"warm_throughput": []interface{}{
+						map[string]interface{}{
+							"read_units_per_second":  12000,
+							"write_units_per_second": 4000,
+						},
+					},
+				},
+			},
+			New: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"warm_throughput": []interface{}{
+						map[string]interface{}{
+							"read_units_per_second":  13000,
+							"write_units_per_second": 5000,
+						},
+					},
+				},
+			},
+			ExpectedUpdates: []awstypes.GlobalSecondaryIndexUpdate{
+				{
+					Update: &awstypes.UpdateGlobalSecondaryIndexAction{
+						IndexName: aws.String("att1-index"),
+						WarmThroughput: &awstypes.WarmThroughput{
+							ReadUnitsPerSecond:  aws.Int64(13000),
+							WriteUnitsPerSecond: aws.Int64(5000),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -1010,6 +1055,68 @@ func TestAccDynamoDBTable_gsiOnDemandThroughput(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_warmThroughput(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_warmThroughput(rName, 12100, 4100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.read_units_per_second", "12100"),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.write_units_per_second", "4100"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:      "att1-index",
+						"warm_throughput.#": "1",
+						"warm_throughput.0.read_units_per_second":  "12100",
+						"warm_throughput.0.write_units_per_second": "4100",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Index warm throughput is only read back when it is in state.
+				ImportStateVerifyIgnore: []string{"global_secondary_index"},
+			},
+			{
+				Config: testAccTableConfig_warmThroughput(rName, 12200, 4200),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.read_units_per_second", "12200"),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.write_units_per_second", "4200"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						names.AttrName:      "att1-index",
+						"warm_throughput.#": "1",
+						"warm_throughput.0.read_units_per_second":  "12200",
+						"warm_throughput.0.write_units_per_second": "4200",
+					}),
+				),
+			},
+			{
+				Config:      testAccTableConfig_warmThroughput(rName, 12100, 4100),
+				ExpectError: regexache.MustCompile(`warm_throughput.0.read_units_per_second cannot be decreased`),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_streamSpecification(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.TableDescription
@@ -1954,6 +2061,7 @@ func TestAccDynamoDBTable_Replica_multiple(t *testing.T) {
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replica"), knownvalue.SetExact([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
 							names.AttrARN:            tfknownvalue.RegionalARNAlternateRegionExact("dynamodb", "table/"+rName),
+							"consistency_mode":       knownvalue.StringExact(string(awstypes.MultiRegionConsistencyEventual)),
 							names.AttrKMSKeyARN:      knownvalue.StringExact(""),
 							"point_in_time_recovery": knownvalue.Bool(false),
 							names.AttrPropagateTags:  knownvalue.Bool(false),
@@ -1963,6 +2071,7 @@ func TestAccDynamoDBTable_Replica_multiple(t *testing.T) {
 						}),
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
 							names.AttrARN:            tfknownvalue.RegionalARNThirdRegionExact("dynamodb", "table/"+rName),
+							"consistency_mode":       knownvalue.StringExact(string(awstypes.MultiRegionConsistencyEventual)),
 							names.AttrKMSKeyARN:      knownvalue.StringExact(""),
 							"point_in_time_recovery": knownvalue.Bool(false),
 							names.AttrPropagateTags:  knownvalue.Bool(false),
@@ -1997,6 +2106,7 @@ func TestAccDynamoDBTable_Replica_multiple(t *testing.T) {
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replica"), knownvalue.SetExact([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
 							names.AttrARN:            tfknownvalue.RegionalARNAlternateRegionExact("dynamodb", "table/"+rName),
+							"consistency_mode":       knownvalue.StringExact(string(awstypes.MultiRegionConsistencyEventual)),
 							names.AttrKMSKeyARN:      knownvalue.StringExact(""),
 							"point_in_time_recovery": knownvalue.Bool(false),
 							names.AttrPropagateTags:  knownvalue.Bool(false),
@@ -2006,6 +2116,7 @@ func TestAccDynamoDBTable_Replica_multiple(t *testing.T) {
 						}),
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
 							names.AttrARN:            tfknownvalue.RegionalARNThirdRegionExact("dynamodb", "table/"+rName),
+							"consistency_mode":       knownvalue.StringExact(string(awstypes.MultiRegionConsistencyEventual)),
 							names.AttrKMSKeyARN:      knownvalue.StringExact(""),
 							"point_in_time_recovery": knownvalue.Bool(false),
 							names.AttrPropagateTags:  knownvalue.Bool(false),
@@ -2026,6 +2137,70 @@ func TestAccDynamoDBTable_Replica_multiple(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_Replica_consistencyModeStrong(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var table awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 3),
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_replicaConsistencyMode(rName, string(awstypes.MultiRegionConsistencyStrong), string(awstypes.MultiRegionConsistencyStrong)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"consistency_mode": string(awstypes.MultiRegionConsistencyStrong),
+						"region_name":      acctest.AlternateRegion(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"consistency_mode": string(awstypes.MultiRegionConsistencyStrong),
+						"region_name":      acctest.ThirdRegion(),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_Replica_consistencyModeMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 3),
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableConfig_replicaConsistencyMode(rName, string(awstypes.MultiRegionConsistencyStrong), string(awstypes.MultiRegionConsistencyEventual)),
+				ExpectError: regexache.MustCompile(`all replicas must use the same consistency_mode`),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_Replica_single(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2058,6 +2233,7 @@ func TestAccDynamoDBTable_Replica_single(t *testing.T) {
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replica"), knownvalue.SetExact([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
 							names.AttrARN:            tfknownvalue.RegionalARNAlternateRegionExact("dynamodb", "table/"+rName),
+							"consistency_mode":       knownvalue.StringExact(string(awstypes.MultiRegionConsistencyEventual)),
 							names.AttrKMSKeyARN:      knownvalue.StringExact(""),
 							"point_in_time_recovery": knownvalue.Bool(false),
 							names.AttrPropagateTags:  knownvalue.Bool(false),
@@ -2093,6 +2269,7 @@ func TestAccDynamoDBTable_Replica_single(t *testing.T) {
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replica"), knownvalue.SetExact([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
 							names.AttrARN:            tfknownvalue.RegionalARNAlternateRegionExact("dynamodb", "table/"+rName),
+							"consistency_mode":       knownvalue.StringExact(string(awstypes.MultiRegionConsistencyEventual)),
 							names.AttrKMSKeyARN:      knownvalue.StringExact(""),
 							"point_in_time_recovery": knownvalue.Bool(false),
 							names.AttrPropagateTags:  knownvalue.Bool(false),
@@ -3918,6 +4095,42 @@ resource "aws_dynamodb_table" "test" {
 `, rName, read, write)
 }
 
+func testAccTableConfig_warmThroughput(rName string, read, write int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  warm_throughput {
+    read_units_per_second  = %[2]d
+    write_units_per_second = %[3]d
+  }
+
+  global_secondary_index {
+    name            = "att1-index"
+    hash_key        = "att1"
+    projection_type = "ALL"
+
+    warm_throughput {
+      read_units_per_second  = %[2]d
+      write_units_per_second = %[3]d
+    }
+  }
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "att1"
+    type = "S"
+  }
+}
+`, rName, read, write)
+}
+
 func testAccTableConfig_streamSpecification(rName string, enabled bool, viewType string) string {
 	if viewType != "null" {
 		viewType = fmt.Sprintf(`"%s"`, viewType)
@@ -4844,6 +5057,43 @@ resource "aws_dynamodb_table" "test" {
 `, rName))
 }
 
+func testAccTableConfig_replicaConsistencyMode(rName, mode1, mode2 string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(3),
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_region" "third" {
+  provider = "awsthird"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PAY_PER_REQUEST"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  replica {
+    region_name      = data.aws_region.alternate.name
+    consistency_mode = %[2]q
+  }
+
+  replica {
+    region_name      = data.aws_region.third.name
+    consistency_mode = %[3]q
+  }
+}
+`, rName, mode1, mode2))
+}
+
 func testAccTableConfig_replicaTagsNext1(rName string, region1 string, propagate1 bool) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3),
@@ -341,4 +341,8 @@ func verifiedAccessEndpointProtocol_Values() []string {
 
 const (
 	supportedRegionServiceStateAvailable = "Available"
+	supportedRegionServiceStateClosed    = "Closed"
+	supportedRegionServiceStateDeleting  = "Deleting"
+	supportedRegionServiceStateFailed    = "Failed"
+	supportedRegionServiceStatePending   = "Pending"
 )
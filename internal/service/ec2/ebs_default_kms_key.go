@@ -5,13 +5,19 @@ package ec2
 
 import (
 	"context"
+	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -20,12 +26,22 @@ func resourceEBSDefaultKMSKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEBSDefaultKMSKeyCreate,
 		ReadWithoutTimeout:   resourceEBSDefaultKMSKeyRead,
+		UpdateWithoutTimeout: resourceEBSDefaultKMSKeyUpdate,
 		DeleteWithoutTimeout: resourceEBSDefaultKMSKeyDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("fail_on_unusable_key", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
+			"fail_on_unusable_key": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"key_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -48,7 +64,17 @@ func resourceEBSDefaultKMSKeyCreate(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "creating EBS default KMS key: %s", err)
 	}
 
-	d.SetId(aws.ToString(resp.KmsKeyId))
+	keyARN := aws.ToString(resp.KmsKeyId)
+	d.SetId(keyARN)
+
+	// The setting is eventually consistent and may briefly report the previous key,
+	// e.g. when encryption by default is being enabled in the same apply.
+	_, err = tfresource.RetryUntilEqual(ctx, ec2PropagationTimeout, keyARN, func() (string, error) {
+		return findEBSDefaultKMSKeyID(ctx, conn)
+	})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS default KMS key (%s) create: %s", d.Id(), err)
+	}
 
 	return append(diags, resourceEBSDefaultKMSKeyRead(ctx, d, meta)...)
 }
@@ -57,17 +83,39 @@ func resourceEBSDefaultKMSKeyRead(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	input := ec2.GetEbsDefaultKmsKeyIdInput{}
-	resp, err := conn.GetEbsDefaultKmsKeyId(ctx, &input)
+	keyARN, err := findEBSDefaultKMSKeyID(ctx, conn)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EBS default KMS key: %s", err)
 	}
 
-	d.Set("key_arn", resp.KmsKeyId)
+	d.Set("key_arn", keyARN)
+
+	// A deleted or disabled default key silently breaks the creation of every new encrypted volume.
+	var reason string
+	if state, err := findKMSKeyState(ctx, meta.(*conns.AWSClient).KMSClient(ctx), keyARN); tfresource.NotFound(err) {
+		reason = "key not found"
+	} else if err != nil {
+		log.Printf("[WARN] Unable to determine the state of EBS default KMS key (%s): %s", keyARN, err)
+	} else if state != kmstypes.KeyStateEnabled {
+		reason = "key state is " + string(state)
+	}
+
+	if reason != "" {
+		if d.Get("fail_on_unusable_key").(bool) {
+			return sdkdiag.AppendErrorf(diags, "EBS default KMS key (%s) is not usable: %s", keyARN, reason)
+		}
+
+		diags = sdkdiag.AppendWarningf(diags, "EBS default KMS key (%s) is not usable: %s. New encrypted EBS volumes will fail to be created.", keyARN, reason)
+	}
 
 	return diags
 }
 
+func resourceEBSDefaultKMSKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only fail_on_unusable_key can be updated; it is not sent to AWS.
+	return resourceEBSDefaultKMSKeyRead(ctx, d, meta)
+}
+
 func resourceEBSDefaultKMSKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -80,3 +128,42 @@ func resourceEBSDefaultKMSKeyDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+func findEBSDefaultKMSKeyID(ctx context.Context, conn *ec2.Client) (string, error) {
+	input := ec2.GetEbsDefaultKmsKeyIdInput{}
+	output, err := conn.GetEbsDefaultKmsKeyId(ctx, &input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.KmsKeyId), nil
+}
+
+func findKMSKeyState(ctx context.Context, conn *kms.Client, keyID string) (kmstypes.KeyState, error) {
+	input := kms.DescribeKeyInput{
+		KeyId: aws.String(keyID),
+	}
+	output, err := conn.DescribeKey(ctx, &input)
+
+	if errs.IsA[*kmstypes.NotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.KeyMetadata == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return output.KeyMetadata.KeyState, nil
+}
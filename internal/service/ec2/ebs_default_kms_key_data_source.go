@@ -7,11 +7,11 @@ import (
 	"context"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // @SDKDataSource("aws_ebs_default_kms_key", name="EBS Default KMS Key")
@@ -28,6 +28,10 @@ func dataSourceEBSDefaultKMSKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -35,14 +39,21 @@ func dataSourceEBSDefaultKMSKeyRead(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	input := ec2.GetEbsDefaultKmsKeyIdInput{}
-	res, err := conn.GetEbsDefaultKmsKeyId(ctx, &input)
+	keyARN, err := findEBSDefaultKMSKeyID(ctx, conn)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EBS default KMS key: %s", err)
 	}
 
+	state, err := findKMSKeyState(ctx, meta.(*conns.AWSClient).KMSClient(ctx), keyARN)
+	if tfresource.NotFound(err) {
+		state = ""
+	} else if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS default KMS key (%s) state: %s", keyARN, err)
+	}
+
 	d.SetId(meta.(*conns.AWSClient).Region(ctx))
-	d.Set("key_arn", res.KmsKeyId)
+	d.Set("key_arn", keyARN)
+	d.Set("key_state", state)
 
 	return diags
 }
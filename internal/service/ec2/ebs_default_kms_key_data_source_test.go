@@ -22,6 +22,7 @@ func testAccEBSDefaultKMSKeyDataSource_basic(t *testing.T) {
 				Config: testAccEBSDefaultKMSKeyDataSourceConfig_basic,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEBSDefaultKMSKey(ctx, "data.aws_ebs_default_kms_key.current"),
+					resource.TestCheckResourceAttr("data.aws_ebs_default_kms_key.current", "key_state", "Enabled"),
 				),
 			},
 		},
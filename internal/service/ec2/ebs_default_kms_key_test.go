@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -46,6 +47,23 @@ func testAccEBSDefaultKMSKey_basic(t *testing.T) {
 	})
 }
 
+func testAccEBSDefaultKMSKey_unusableKey(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSDefaultKMSKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEBSDefaultKMSKeyConfig_unusableKey,
+				ExpectError: regexache.MustCompile(`EBS default KMS key \(.+\) is not usable: key state is Disabled`),
+			},
+		},
+	})
+}
+
 func testAccCheckEBSDefaultKMSKeyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		arn, err := testAccEBSManagedDefaultKey(ctx)
@@ -135,3 +153,14 @@ resource "aws_ebs_default_kms_key" "test" {
   key_arn = aws_kms_key.test.arn
 }
 `
+
+const testAccEBSDefaultKMSKeyConfig_unusableKey = `
+resource "aws_kms_key" "test" {
+  is_enabled = false
+}
+
+resource "aws_ebs_default_kms_key" "test" {
+  key_arn              = aws_kms_key.test.arn
+  fail_on_unusable_key = true
+}
+`
@@ -5,6 +5,8 @@ package ec2
 
 import (
 	"context"
+	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -28,6 +31,12 @@ func resourceEBSSnapshotBlockPublicAccess() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrState: {
 				Type:             schema.TypeString,
@@ -42,19 +51,37 @@ func resourceEBSSnapshotBlockPublicAccessPut(ctx context.Context, d *schema.Reso
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	state := d.Get(names.AttrState).(string)
-	input := ec2.EnableSnapshotBlockPublicAccessInput{
-		State: types.SnapshotBlockPublicAccessState(state),
-	}
-
-	_, err := conn.EnableSnapshotBlockPublicAccess(ctx, &input)
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "enabling EBS Snapshot Block Public Access (%s): %s", state, err)
+	state := types.SnapshotBlockPublicAccessState(d.Get(names.AttrState).(string))
+
+	switch state {
+	case types.SnapshotBlockPublicAccessStateUnblocked:
+		// "unblocked" can only be reached by disabling the setting.
+		input := ec2.DisableSnapshotBlockPublicAccessInput{}
+		_, err := conn.DisableSnapshotBlockPublicAccess(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling EBS Snapshot Block Public Access: %s", err)
+		}
+	default:
+		// Moving between "block-new-sharing" and "block-all-sharing" in either direction is a single enable call.
+		input := ec2.EnableSnapshotBlockPublicAccessInput{
+			State: state,
+		}
+		_, err := conn.EnableSnapshotBlockPublicAccess(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "enabling EBS Snapshot Block Public Access (%s): %s", state, err)
+		}
 	}
 
+	timeout := d.Timeout(schema.TimeoutUpdate)
 	if d.IsNewResource() {
 		d.SetId(meta.(*conns.AWSClient).Region(ctx))
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := waitSnapshotBlockPublicAccessState(ctx, conn, state, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot Block Public Access state (%s): %s", state, err)
 	}
 
 	return append(diags, resourceEBSSnapshotBlockPublicAccessRead(ctx, d, meta)...)
@@ -64,14 +91,19 @@ func resourceEBSSnapshotBlockPublicAccessRead(ctx context.Context, d *schema.Res
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	input := ec2.GetSnapshotBlockPublicAccessStateInput{}
-	output, err := conn.GetSnapshotBlockPublicAccessState(ctx, &input)
+	output, err := findSnapshotBlockPublicAccessState(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Snapshot Block Public Access %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot Block Public Access: %s", err)
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot Block Public Access (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrState, output.State)
+	d.Set(names.AttrState, output)
 
 	return diags
 }
@@ -88,5 +120,9 @@ func resourceEBSSnapshotBlockPublicAccessDelete(ctx context.Context, d *schema.R
 		return sdkdiag.AppendErrorf(diags, "disabling EBS Snapshot Block Public Access: %s", err)
 	}
 
+	if err := waitSnapshotBlockPublicAccessState(ctx, conn, types.SnapshotBlockPublicAccessStateUnblocked, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot Block Public Access (%s) disable: %s", d.Id(), err)
+	}
+
 	return diags
 }
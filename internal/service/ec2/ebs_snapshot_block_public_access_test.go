@@ -47,6 +47,20 @@ func TestAccEC2EBSSnapshotBlockPublicAccess_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrState, "block-new-sharing"),
 				),
 			},
+			{
+				ResourceName: resourceName,
+				Config:       testAccEBSSnapshotBlockPublicAccess_basic(string(types.SnapshotBlockPublicAccessStateUnblocked)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "unblocked"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				Config:       testAccEBSSnapshotBlockPublicAccess_basic(string(types.SnapshotBlockPublicAccessStateBlockAllSharing)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "block-all-sharing"),
+				),
+			},
 		},
 	})
 }
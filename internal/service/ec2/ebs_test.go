@@ -15,6 +15,7 @@ func TestAccEC2EBSDefaultKMSKey_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Resource": {
 			acctest.CtBasic: testAccEBSDefaultKMSKey_basic,
+			"unusableKey":   testAccEBSDefaultKMSKey_unusableKey,
 		},
 		"DataSource": {
 			acctest.CtBasic: testAccEBSDefaultKMSKeyDataSource_basic,
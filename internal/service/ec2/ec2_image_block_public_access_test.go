@@ -17,6 +17,7 @@ func TestAccEC2ImageBlockPublicAccess_serial(t *testing.T) {
 
 	testCases := map[string]func(t *testing.T){
 		acctest.CtBasic: testAccImageBlockPublicAccess_basic,
+		"dataSource":    testAccImageBlockPublicAccessDataSource_basic,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
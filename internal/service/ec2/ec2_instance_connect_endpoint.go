@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -258,6 +259,34 @@ func (r *instanceConnectEndpointResource) Delete(ctx context.Context, request re
 
 func (r *instanceConnectEndpointResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
+
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state instanceConnectEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The EC2 API has no operation to modify an Instance Connect Endpoint, so explain why the endpoint is being replaced.
+	if !plan.PreserveClientIp.IsUnknown() && !plan.PreserveClientIp.Equal(state.PreserveClientIp) {
+		response.Diagnostics.AddAttributeWarning(
+			path.Root("preserve_client_ip"),
+			"EC2 Instance Connect Endpoint replacement",
+			"The preservation of client IP addresses cannot be changed on an existing EC2 Instance Connect Endpoint. The endpoint will be replaced.",
+		)
+	}
+
+	if !plan.SecurityGroupIds.IsUnknown() && !plan.SecurityGroupIds.Equal(state.SecurityGroupIds) {
+		response.Diagnostics.AddAttributeWarning(
+			path.Root(names.AttrSecurityGroupIDs),
+			"EC2 Instance Connect Endpoint replacement",
+			"The security groups of an existing EC2 Instance Connect Endpoint cannot be changed. The endpoint will be replaced.",
+		)
+	}
 }
 
 // See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_Ec2InstanceConnectEndpoint.html.
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_tags", name="EC2 Resource Tags")
+func resourceTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTagsCreate,
+		ReadWithoutTimeout:   resourceTagsRead,
+		UpdateWithoutTimeout: resourceTagsUpdate,
+		DeleteWithoutTimeout: resourceTagsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrResourceID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	identifier := d.Get(names.AttrResourceID).(string)
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+	if err := createTags(ctx, conn, identifier, Tags(tags)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating %s resource (%s) tags: %s", names.EC2, identifier, err)
+	}
+
+	d.SetId(identifier)
+
+	return append(diags, resourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	tags, err := listTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading %s resource (%s) tags: %s", names.EC2, d.Id(), err)
+	}
+
+	// Only the keys managed by this resource are read back, so that other tags on the resource
+	// (e.g. those set by the owner of a shared resource) are left alone.
+	// On import all non-system tags are adopted.
+	if v := d.Get(names.AttrTags).(map[string]interface{}); len(v) > 0 {
+		tags = tags.Only(tftags.New(ctx, flex.ExpandStringValueMap(v)))
+	} else {
+		tags = tags.IgnoreSystem(names.EC2)
+	}
+
+	// DescribeTags returns no tags, rather than an error, for a resource that no longer exists.
+	if !d.IsNewResource() && len(tags) == 0 {
+		log.Printf("[WARN] %s resource (%s) tags not found, removing from state", names.EC2, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set(names.AttrResourceID, d.Id())
+	d.Set(names.AttrTags, tags.Map())
+
+	return diags
+}
+
+func resourceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChange(names.AttrTags) {
+		o, n := d.GetChange(names.AttrTags)
+
+		if err := updateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating %s resource (%s) tags: %s", names.EC2, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if err := updateTags(ctx, conn, d.Id(), d.Get(names.AttrTags), nil); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting %s resource (%s) tags: %s", names.EC2, d.Id(), err)
+	}
+
+	return diags
+}
@@ -117,6 +117,7 @@ const (
 	errCodeInvalidVPCEndpointServiceNotFound                       = "InvalidVpcEndpointService.NotFound"
 	errCodeInvalidVPCIDNotFound                                    = "InvalidVpcID.NotFound"
 	errCodeInvalidVPCPeeringConnectionIDNotFound                   = "InvalidVpcPeeringConnectionID.NotFound"
+	errCodeInvalidVPCState                                         = "InvalidVpcState"
 	errCodeInvalidVPNConnectionIDNotFound                          = "InvalidVpnConnectionID.NotFound"
 	errCodeInvalidVPNGatewayAttachmentNotFound                     = "InvalidVpnGatewayAttachment.NotFound"
 	errCodeInvalidVPNGatewayIDNotFound                             = "InvalidVpnGatewayID.NotFound"
@@ -78,6 +78,7 @@ var (
 	ResourceSubnet                                        = resourceSubnet
 	ResourceSubnetCIDRReservation                         = resourceSubnetCIDRReservation
 	ResourceTag                                           = resourceTag
+	ResourceTags                                          = resourceTags
 	ResourceTrafficMirrorFilter                           = resourceTrafficMirrorFilter
 	ResourceTrafficMirrorFilterRule                       = resourceTrafficMirrorFilterRule
 	ResourceTrafficMirrorSession                          = resourceTrafficMirrorSession
@@ -811,6 +811,33 @@ func statusVPCEndpointConnectionVPCEndpoint(ctx context.Context, conn *ec2.Clien
 	}
 }
 
+// statusVPCEndpointServiceSupportedRegions returns "Pending" while any supported region is still being added or removed.
+func statusVPCEndpointServiceSupportedRegions(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findVPCEndpointServiceConfigurationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		state := supportedRegionServiceStateAvailable
+		for _, v := range output.SupportedRegions {
+			switch aws.ToString(v.ServiceState) {
+			case supportedRegionServiceStateClosed, supportedRegionServiceStateFailed:
+				return output, supportedRegionServiceStateFailed, nil
+			case supportedRegionServiceStateDeleting, supportedRegionServiceStatePending:
+				state = supportedRegionServiceStatePending
+			}
+		}
+
+		return output, state, nil
+	}
+}
+
 func statusVPCEndpointServicePrivateDNSNameConfiguration(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findVPCEndpointServicePrivateDNSNameConfigurationByID(ctx, conn, id)
@@ -1180,6 +1207,22 @@ func statusImageBlockPublicAccess(ctx context.Context, conn *ec2.Client) retry.S
 	}
 }
 
+func statusSnapshotBlockPublicAccess(ctx context.Context, conn *ec2.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSnapshotBlockPublicAccessState(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output), nil
+	}
+}
+
 func statusTransitGateway(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findTransitGatewayByID(ctx, conn, id)
@@ -203,6 +203,8 @@ func resourceDefaultRouteTableCreate(ctx context.Context, d *schema.ResourceData
 
 		_, err := conn.DeleteRoute(ctx, input)
 
+		invalidateRouteTableCache(routeTableID)
+
 		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
 			continue
 		}
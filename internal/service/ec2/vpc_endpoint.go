@@ -462,13 +462,23 @@ func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 			VpcEndpointId: aws.String(d.Id()),
 		}
 
-		if d.HasChange("dns_options") {
+		privateDNSEnabled := d.Get("private_dns_enabled").(bool)
+		if d.HasChange("private_dns_enabled") {
+			input.PrivateDnsEnabled = aws.Bool(privateDNSEnabled)
+		}
+
+		// PrivateDnsOnlyForInboundResolverEndpoint is only supported for services
+		// that support both gateway and interface endpoints, i.e. S3.
+		// It can only be true while private DNS is enabled, so it is sent together with any private DNS change.
+		isS3 := isAmazonS3VPCEndpoint(d.Get(names.AttrServiceName).(string))
+		if d.HasChange("dns_options") || (isS3 && d.HasChange("private_dns_enabled")) {
 			if v, ok := d.GetOk("dns_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				tfMap := v.([]interface{})[0].(map[string]interface{})
-				// PrivateDnsOnlyForInboundResolverEndpoint is only supported for services
-				// that support both gateway and interface endpoints, i.e. S3.
-				if isAmazonS3VPCEndpoint(d.Get(names.AttrServiceName).(string)) {
+				if isS3 {
 					input.DnsOptions = expandDNSOptionsSpecificationWithPrivateDNSOnly(tfMap)
+					if !privateDNSEnabled {
+						input.DnsOptions.PrivateDnsOnlyForInboundResolverEndpoint = aws.Bool(false)
+					}
 				} else {
 					input.DnsOptions = expandDNSOptionsSpecification(tfMap)
 				}
@@ -479,11 +489,6 @@ func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.IpAddressType = awstypes.IpAddressType(d.Get(names.AttrIPAddressType).(string))
 		}
 
-		privateDNSEnabled := d.Get("private_dns_enabled").(bool)
-		if d.HasChange("private_dns_enabled") {
-			input.PrivateDnsEnabled = aws.Bool(privateDNSEnabled)
-		}
-
 		input.AddRouteTableIds, input.RemoveRouteTableIds = flattenAddAndRemoveStringValueLists(d, "route_table_ids")
 		input.AddSecurityGroupIds, input.RemoveSecurityGroupIds = flattenAddAndRemoveStringValueLists(d, names.AttrSecurityGroupIDs)
 		input.AddSubnetIds, input.RemoveSubnetIds = flattenAddAndRemoveStringValueLists(d, names.AttrSubnetIDs)
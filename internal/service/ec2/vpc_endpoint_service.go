@@ -203,6 +203,12 @@ func resourceVPCEndpointServiceCreate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC Endpoint Service (%s) create: %s", d.Id(), err)
 	}
 
+	if len(input.SupportedRegions) > 0 {
+		if _, err := waitVPCEndpointServiceSupportedRegionsAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC Endpoint Service (%s) supported regions create: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("allowed_principals"); ok && v.(*schema.Set).Len() > 0 {
 		input := &ec2.ModifyVpcEndpointServicePermissionsInput{
 			AddAllowedPrincipals: flex.ExpandStringValueSet(v.(*schema.Set)),
@@ -311,6 +317,12 @@ func resourceVPCEndpointServiceUpdate(ctx context.Context, d *schema.ResourceDat
 		if _, err := waitVPCEndpointServiceAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC Endpoint Service (%s) update: %s", d.Id(), err)
 		}
+
+		if d.HasChange("supported_regions") {
+			if _, err := waitVPCEndpointServiceSupportedRegionsAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC Endpoint Service (%s) supported regions update: %s", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChange("allowed_principals") {
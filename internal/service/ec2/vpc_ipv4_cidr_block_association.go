@@ -97,13 +97,21 @@ func resourceVPCIPv4CIDRBlockAssociationCreate(ctx context.Context, d *schema.Re
 		input.Ipv4NetmaskLength = aws.Int32(int32(v.(int)))
 	}
 
-	output, err := conn.AssociateVpcCidrBlock(ctx, input)
+	// Only one CIDR block can be associating or disassociating at a time.
+	// Serialize associations with the same VPC and wait for each to complete before releasing the lock.
+	mutexKey := vpcCIDRBlockAssociationMutexKey(vpcID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.AssociateVpcCidrBlock(ctx, input)
+	}, errCodeInvalidVPCState)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating EC2 VPC (%s) IPv4 CIDR Block Association: %s", vpcID, err)
 	}
 
-	d.SetId(aws.ToString(output.CidrBlockAssociation.AssociationId))
+	d.SetId(aws.ToString(outputRaw.(*ec2.AssociateVpcCidrBlockOutput).CidrBlockAssociation.AssociationId))
 
 	if _, err := waitVPCCIDRBlockAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC (%s) IPv4 CIDR block (%s) to become associated: %s", vpcID, d.Id(), err)
@@ -138,11 +146,17 @@ func resourceVPCIPv4CIDRBlockAssociationDelete(ctx context.Context, d *schema.Re
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
+	mutexKey := vpcCIDRBlockAssociationMutexKey(d.Get(names.AttrVPCID).(string))
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 	log.Printf("[DEBUG] Deleting EC2 VPC IPv4 CIDR Block Association: %s", d.Id())
 	input := ec2.DisassociateVpcCidrBlockInput{
 		AssociationId: aws.String(d.Id()),
 	}
-	_, err := conn.DisassociateVpcCidrBlock(ctx, &input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DisassociateVpcCidrBlock(ctx, &input)
+	}, errCodeInvalidVPCState)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPCCIDRBlockAssociationIDNotFound) {
 		return diags
@@ -158,3 +172,7 @@ func resourceVPCIPv4CIDRBlockAssociationDelete(ctx context.Context, d *schema.Re
 
 	return diags
 }
+
+func vpcCIDRBlockAssociationMutexKey(vpcID string) string {
+	return "vpc_cidr_block_association_" + vpcID
+}
@@ -109,6 +109,36 @@ func TestAccVPCIPv4CIDRBlockAssociation_ipamBasic(t *testing.T) {
 	})
 }
 
+func TestAccVPCIPv4CIDRBlockAssociation_ipamMultiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var association1, association2, association3 awstypes.VpcCidrBlockAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCIPv4CIDRBlockAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIPv4CIDRBlockAssociationConfig_ipamMultiple(rName, 28),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIPv4CIDRBlockAssociationExists(ctx, "aws_vpc_ipv4_cidr_block_association.test.0", &association1),
+					testAccCheckVPCAssociationCIDRPrefix(&association1, "28"),
+					testAccCheckVPCIPv4CIDRBlockAssociationExists(ctx, "aws_vpc_ipv4_cidr_block_association.test.1", &association2),
+					testAccCheckVPCAssociationCIDRPrefix(&association2, "28"),
+					testAccCheckVPCIPv4CIDRBlockAssociationExists(ctx, "aws_vpc_ipv4_cidr_block_association.test.2", &association3),
+					testAccCheckVPCAssociationCIDRPrefix(&association3, "28"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCIPv4CIDRBlockAssociation_ipamBasicExplicitCIDR(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -249,6 +279,28 @@ resource "aws_vpc_ipv4_cidr_block_association" "secondary_cidr" {
 `, rName, netmaskLength))
 }
 
+func testAccVPCIPv4CIDRBlockAssociationConfig_ipamMultiple(rName string, netmaskLength int) string {
+	return acctest.ConfigCompose(testAccVPCConfig_baseIPAMIPv4(rName), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_ipv4_cidr_block_association" "test" {
+  count = 3
+
+  ipv4_ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  ipv4_netmask_length = %[2]d
+  vpc_id              = aws_vpc.test.id
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`, rName, netmaskLength))
+}
+
 func testAccVPCIPv4CIDRBlockAssociationConfig_ipamExplicit(rName, cidr string) string {
 	return acctest.ConfigCompose(testAccVPCConfig_baseIPAMIPv4(rName), fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -25,6 +26,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// Maximum number of entries in a single CreateManagedPrefixList or ModifyManagedPrefixList request.
+	managedPrefixListEntriesMaxBatchSize = 100
+)
+
 // @SDKResource("aws_ec2_managed_prefix_list", name="Managed Prefix List")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
@@ -114,8 +120,13 @@ func resourceManagedPrefixListCreate(ctx context.Context, d *schema.ResourceData
 		TagSpecifications: getTagSpecificationsIn(ctx, awstypes.ResourceTypePrefixList),
 	}
 
+	// CreateManagedPrefixList accepts at most 100 entries, any remaining entries are added once the prefix list exists.
+	var addEntries []awstypes.AddPrefixListEntry
 	if v, ok := d.GetOk("entry"); ok && v.(*schema.Set).Len() > 0 {
-		input.Entries = expandAddPrefixListEntries(v.(*schema.Set).List())
+		addEntries = expandAddPrefixListEntries(v.(*schema.Set).List())
+		if n := min(len(addEntries), managedPrefixListEntriesMaxBatchSize); n > 0 {
+			input.Entries, addEntries = addEntries[:n], addEntries[n:]
+		}
 	}
 
 	output, err := conn.CreateManagedPrefixList(ctx, input)
@@ -130,6 +141,12 @@ func resourceManagedPrefixListCreate(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Managed Prefix List (%s) create: %s", d.Id(), err)
 	}
 
+	if len(addEntries) > 0 {
+		if err := updateManagedPrefixListEntries(ctx, conn, d.Id(), addEntries, nil); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 Managed Prefix List (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceManagedPrefixListRead(ctx, d, meta)...)
 }
 
@@ -196,100 +213,28 @@ func resourceManagedPrefixListUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "max_entries") {
-		input := &ec2.ModifyManagedPrefixListInput{
-			PrefixListId: aws.String(d.Id()),
+	if d.HasChange(names.AttrName) {
+		input := ec2.ModifyManagedPrefixListInput{
+			PrefixListId:   aws.String(d.Id()),
+			PrefixListName: aws.String(d.Get(names.AttrName).(string)),
 		}
+		_, err := conn.ModifyManagedPrefixList(ctx, &input)
 
-		input.PrefixListName = aws.String(d.Get(names.AttrName).(string))
-		currentVersion := int64(d.Get(names.AttrVersion).(int))
-		wait := false
-
-		oldAttr, newAttr := d.GetChange("entry")
-		os := oldAttr.(*schema.Set)
-		ns := newAttr.(*schema.Set)
-
-		if addEntries := ns.Difference(os); addEntries.Len() > 0 {
-			input.AddEntries = expandAddPrefixListEntries(addEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
-		}
-
-		if removeEntries := os.Difference(ns); removeEntries.Len() > 0 {
-			input.RemoveEntries = expandRemovePrefixListEntries(removeEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s): %s", d.Id(), err)
 		}
+	}
 
-		// Prevent the following error on description-only updates:
-		//   InvalidParameterValue: Request cannot contain Cidr #.#.#.#/# in both AddPrefixListEntries and RemovePrefixListEntries
-		// Attempting to just delete the RemoveEntries item causes:
-		//   InvalidRequest: The request received was invalid.
-		// Therefore it seems we must issue two ModifyManagedPrefixList calls,
-		// one with a collection of all description-only removals and the
-		// second one will add them all back.
-		if len(input.AddEntries) > 0 && len(input.RemoveEntries) > 0 {
-			descriptionOnlyRemovals := []awstypes.RemovePrefixListEntry{}
-			removals := []awstypes.RemovePrefixListEntry{}
-
-			for _, removeEntry := range input.RemoveEntries {
-				inAddAndRemove := false
-
-				for _, addEntry := range input.AddEntries {
-					if aws.ToString(addEntry.Cidr) == aws.ToString(removeEntry.Cidr) {
-						inAddAndRemove = true
-						break
-					}
-				}
-
-				if inAddAndRemove {
-					descriptionOnlyRemovals = append(descriptionOnlyRemovals, removeEntry)
-				} else {
-					removals = append(removals, removeEntry)
-				}
-			}
-
-			if len(descriptionOnlyRemovals) > 0 {
-				input := ec2.ModifyManagedPrefixListInput{
-					CurrentVersion: input.CurrentVersion,
-					PrefixListId:   aws.String(d.Id()),
-					RemoveEntries:  descriptionOnlyRemovals,
-				}
-				_, err := conn.ModifyManagedPrefixList(ctx, &input)
-
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s): %s", d.Id(), err)
-				}
-
-				managedPrefixList, err := waitManagedPrefixListModified(ctx, conn, d.Id())
-
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "waiting for EC2 Managed Prefix List (%s) update: %s", d.Id(), err)
-				}
-
-				input.CurrentVersion = managedPrefixList.Version
-			}
-
-			if len(removals) > 0 {
-				input.RemoveEntries = removals
-			} else {
-				// Prevent this error if RemoveEntries is list with no elements after removals:
-				//   InvalidRequest: The request received was invalid.
-				input.RemoveEntries = nil
-			}
-		}
+	if d.HasChange("entry") {
+		oldAttr, newAttr := d.GetChange("entry")
+		os, ns := oldAttr.(*schema.Set), newAttr.(*schema.Set)
 
-		_, err := conn.ModifyManagedPrefixList(ctx, input)
+		addEntries := expandAddPrefixListEntries(ns.Difference(os).List())
+		removeEntries := expandRemovePrefixListEntries(os.Difference(ns).List())
 
-		if err != nil {
+		if err := updateManagedPrefixListEntries(ctx, conn, d.Id(), addEntries, removeEntries); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s): %s", d.Id(), err)
 		}
-
-		if wait {
-			if _, err := waitManagedPrefixListModified(ctx, conn, d.Id()); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for EC2 Managed Prefix List (%s) update: %s", d.Id(), err)
-			}
-		}
 	}
 
 	// Only decrease MaxEntries after entry(s) have had opportunity to be removed
@@ -329,6 +274,96 @@ func resourceManagedPrefixListDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
+// updateManagedPrefixListEntries adds and removes the specified entries. The changes are sent in a single
+// ModifyManagedPrefixList request unless they exceed the request limits, in which case entries are removed
+// and then added in batches. The prefix list version is re-read before each request and the request is
+// retried if the prefix list was concurrently modified.
+func updateManagedPrefixListEntries(ctx context.Context, conn *ec2.Client, id string, addEntries []awstypes.AddPrefixListEntry, removeEntries []awstypes.RemovePrefixListEntry) error {
+	mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", id)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	// Prevent the following error on description-only updates:
+	//   InvalidParameterValue: Request cannot contain Cidr #.#.#.#/# in both AddPrefixListEntries and RemovePrefixListEntries
+	// The entries whose description changed are removed in a separate request and then added back.
+	var descriptionOnlyRemovals, removals []awstypes.RemovePrefixListEntry
+	for _, removeEntry := range removeEntries {
+		if slices.ContainsFunc(addEntries, func(addEntry awstypes.AddPrefixListEntry) bool {
+			return aws.ToString(addEntry.Cidr) == aws.ToString(removeEntry.Cidr)
+		}) {
+			descriptionOnlyRemovals = append(descriptionOnlyRemovals, removeEntry)
+		} else {
+			removals = append(removals, removeEntry)
+		}
+	}
+
+	for chunk := range slices.Chunk(descriptionOnlyRemovals, managedPrefixListEntriesMaxBatchSize) {
+		if err := modifyManagedPrefixListEntries(ctx, conn, id, func(input *ec2.ModifyManagedPrefixListInput) {
+			input.RemoveEntries = chunk
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(addEntries) == 0 && len(removals) == 0 {
+		return nil
+	}
+
+	if len(addEntries) <= managedPrefixListEntriesMaxBatchSize && len(removals) <= managedPrefixListEntriesMaxBatchSize {
+		return modifyManagedPrefixListEntries(ctx, conn, id, func(input *ec2.ModifyManagedPrefixListInput) {
+			input.AddEntries = addEntries
+			input.RemoveEntries = removals
+		})
+	}
+
+	// Removals are applied before additions so that the prefix list does not exceed its maximum number of entries.
+	for chunk := range slices.Chunk(removals, managedPrefixListEntriesMaxBatchSize) {
+		if err := modifyManagedPrefixListEntries(ctx, conn, id, func(input *ec2.ModifyManagedPrefixListInput) {
+			input.RemoveEntries = chunk
+		}); err != nil {
+			return err
+		}
+	}
+
+	for chunk := range slices.Chunk(addEntries, managedPrefixListEntriesMaxBatchSize) {
+		if err := modifyManagedPrefixListEntries(ctx, conn, id, func(input *ec2.ModifyManagedPrefixListInput) {
+			input.AddEntries = chunk
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func modifyManagedPrefixListEntries(ctx context.Context, conn *ec2.Client, id string, optFn func(*ec2.ModifyManagedPrefixListInput)) error {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, managedPrefixListTimeout, func() (interface{}, error) {
+		pl, err := findManagedPrefixListByID(ctx, conn, id)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 Managed Prefix List (%s): %w", id, err)
+		}
+
+		input := &ec2.ModifyManagedPrefixListInput{
+			CurrentVersion: pl.Version,
+			PrefixListId:   aws.String(id),
+		}
+		optFn(input)
+
+		return conn.ModifyManagedPrefixList(ctx, input)
+	}, errCodeIncorrectState, errCodePrefixListVersionMismatch)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitManagedPrefixListModified(ctx, conn, id); err != nil {
+		return fmt.Errorf("waiting for EC2 Managed Prefix List (%s) update: %w", id, err)
+	}
+
+	return nil
+}
+
 func updateMaxEntry(ctx context.Context, conn *ec2.Client, id string, maxEntries int32) error {
 	input := ec2.ModifyManagedPrefixListInput{
 		PrefixListId: aws.String(id),
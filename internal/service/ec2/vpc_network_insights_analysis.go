@@ -92,6 +92,11 @@ func resourceNetworkInsightsAnalysis() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"suggested_accounts": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
 				"wait_for_completion": {
@@ -1477,6 +1482,7 @@ func resourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resource
 	d.Set("start_date", output.StartDate.Format(time.RFC3339))
 	d.Set(names.AttrStatus, output.Status)
 	d.Set(names.AttrStatusMessage, output.StatusMessage)
+	d.Set("suggested_accounts", output.SuggestedAccounts)
 	d.Set("warning_message", output.WarningMessage)
 
 	setTagsOut(ctx, output.Tags)
@@ -81,6 +81,11 @@ func dataSourceNetworkInsightsAnalysis() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"suggested_accounts": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
 				names.AttrTags: tftags.TagsSchemaComputed(),
 				"warning_message": {
 					Type:     schema.TypeString,
@@ -138,6 +143,7 @@ func dataSourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resour
 	d.Set("start_date", output.StartDate.Format(time.RFC3339))
 	d.Set(names.AttrStatus, output.Status)
 	d.Set(names.AttrStatusMessage, output.StatusMessage)
+	d.Set("suggested_accounts", output.SuggestedAccounts)
 	d.Set("warning_message", output.WarningMessage)
 
 	setTagsOut(ctx, output.Tags)
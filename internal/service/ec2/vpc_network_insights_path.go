@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -63,6 +64,8 @@ func resourceNetworkInsightsPath() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"filter_at_destination": networkInsightsPathFilterSchema(),
+			"filter_at_source":      networkInsightsPathFilterSchema(),
 			names.AttrProtocol: {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -115,6 +118,14 @@ func resourceNetworkInsightsPathCreate(ctx context.Context, d *schema.ResourceDa
 		input.DestinationPort = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("filter_at_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FilterAtDestination = expandPathRequestFilter(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("filter_at_source"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FilterAtSource = expandPathRequestFilter(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("source_ip"); ok {
 		input.SourceIp = aws.String(v.(string))
 	}
@@ -151,6 +162,12 @@ func resourceNetworkInsightsPathRead(ctx context.Context, d *schema.ResourceData
 	d.Set(names.AttrDestinationARN, nip.DestinationArn)
 	d.Set("destination_ip", nip.DestinationIp)
 	d.Set("destination_port", nip.DestinationPort)
+	if err := d.Set("filter_at_destination", flattenPathFilter(nip.FilterAtDestination)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting filter_at_destination: %s", err)
+	}
+	if err := d.Set("filter_at_source", flattenPathFilter(nip.FilterAtSource)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting filter_at_source: %s", err)
+	}
 	d.Set(names.AttrProtocol, nip.Protocol)
 	d.Set(names.AttrSource, nip.Source)
 	d.Set("source_arn", nip.SourceArn)
@@ -188,6 +205,150 @@ func resourceNetworkInsightsPathDelete(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+func networkInsightsPathFilterSchema() *schema.Schema {
+	portRangeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"from_port": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+					"to_port": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_address": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+				"destination_port_range": portRangeSchema(),
+				"source_address": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+				"source_port_range": portRangeSchema(),
+			},
+		},
+	}
+}
+
+func expandPathRequestFilter(tfMap map[string]interface{}) *awstypes.PathRequestFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PathRequestFilter{}
+
+	if v, ok := tfMap["destination_address"].(string); ok && v != "" {
+		apiObject.DestinationAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DestinationPortRange = expandRequestFilterPortRange(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["source_address"].(string); ok && v != "" {
+		apiObject.SourceAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourcePortRange = expandRequestFilterPortRange(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandRequestFilterPortRange(tfMap map[string]interface{}) *awstypes.RequestFilterPortRange {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.RequestFilterPortRange{}
+
+	if v, ok := tfMap["from_port"].(int); ok && v != 0 {
+		apiObject.FromPort = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["to_port"].(int); ok && v != 0 {
+		apiObject.ToPort = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenPathFilter(apiObject *awstypes.PathFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	if apiObject.DestinationAddress == nil && apiObject.DestinationPortRange == nil && apiObject.SourceAddress == nil && apiObject.SourcePortRange == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DestinationAddress; v != nil {
+		tfMap["destination_address"] = aws.ToString(v)
+	}
+
+	if v := apiObject.DestinationPortRange; v != nil {
+		tfMap["destination_port_range"] = flattenFilterPortRange(v)
+	}
+
+	if v := apiObject.SourceAddress; v != nil {
+		tfMap["source_address"] = aws.ToString(v)
+	}
+
+	if v := apiObject.SourcePortRange; v != nil {
+		tfMap["source_port_range"] = flattenFilterPortRange(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFilterPortRange(apiObject *awstypes.FilterPortRange) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FromPort; v != nil {
+		tfMap["from_port"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.ToPort; v != nil {
+		tfMap["to_port"] = aws.ToInt32(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 // idFromIDOrARN return a resource ID from an ID or ARN.
 func idFromIDOrARN(idOrARN string) string {
 	// e.g. "eni-02ae120b80627a68f" or
@@ -45,7 +45,9 @@ func dataSourceNetworkInsightsPath() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			names.AttrFilter: customFiltersSchema(),
+			names.AttrFilter:        customFiltersSchema(),
+			"filter_at_destination": networkInsightsPathFilterSchemaComputed(),
+			"filter_at_source":      networkInsightsPathFilterSchemaComputed(),
 			"network_insights_path_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -104,6 +106,12 @@ func dataSourceNetworkInsightsPathRead(ctx context.Context, d *schema.ResourceDa
 	d.Set(names.AttrDestinationARN, nip.DestinationArn)
 	d.Set("destination_ip", nip.DestinationIp)
 	d.Set("destination_port", nip.DestinationPort)
+	if err := d.Set("filter_at_destination", flattenPathFilter(nip.FilterAtDestination)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting filter_at_destination: %s", err)
+	}
+	if err := d.Set("filter_at_source", flattenPathFilter(nip.FilterAtSource)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting filter_at_source: %s", err)
+	}
 	d.Set("network_insights_path_id", networkInsightsPathID)
 	d.Set(names.AttrProtocol, nip.Protocol)
 	d.Set(names.AttrSource, nip.Source)
@@ -114,3 +122,43 @@ func dataSourceNetworkInsightsPathRead(ctx context.Context, d *schema.ResourceDa
 
 	return diags
 }
+
+func networkInsightsPathFilterSchemaComputed() *schema.Schema {
+	portRangeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"from_port": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"to_port": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"destination_port_range": portRangeSchema(),
+				"source_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"source_port_range": portRangeSchema(),
+			},
+		},
+	}
+}
@@ -39,6 +39,8 @@ func TestAccVPCNetworkInsightsPath_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrDestinationARN, "aws_network_interface.test.1", names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, "destination_ip", ""),
 					resource.TestCheckResourceAttr(resourceName, "destination_port", "0"),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_destination.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_source.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrProtocol, "tcp"),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrSource, "aws_network_interface.test.0", names.AttrID),
 					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_network_interface.test.0", names.AttrARN),
@@ -255,6 +257,47 @@ func TestAccVPCNetworkInsightsPath_destinationPort(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInsightsPath_filterAtSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_path.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsPathDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsPathConfig_filterAtSource(rName, "1.1.1.1", 443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_destination.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_source.0.source_address", "1.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_source.0.destination_port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_source.0.destination_port_range.0.from_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_source.0.destination_port_range.0.to_port", "443"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCNetworkInsightsPathConfig_filterAtSource(rName, "8.8.8.8", 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsPathExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_source.0.source_address", "8.8.8.8"),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_source.0.destination_port_range.0.from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "filter_at_source.0.destination_port_range.0.to_port", "80"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkInsightsPathExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -471,3 +514,42 @@ resource "aws_ec2_network_insights_path" "test" {
 }
 `, rName, destinationPort))
 }
+
+func testAccVPCNetworkInsightsPathConfig_filterAtSource(rName, sourceAddress string, port int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_network_insights_path" "test" {
+  source      = aws_internet_gateway.test.id
+  destination = aws_network_interface.test.id
+  protocol    = "tcp"
+
+  filter_at_source {
+    source_address = %[2]q
+
+    destination_port_range {
+      from_port = %[3]d
+      to_port   = %[3]d
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, sourceAddress, port))
+}
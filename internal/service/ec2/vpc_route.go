@@ -261,6 +261,8 @@ func resourceRouteCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		errCodeInvalidTransitGatewayIDNotFound,
 	)
 
+	invalidateRouteTableCache(routeTableID)
+
 	// Local routes cannot be created manually.
 	if tfawserr.ErrMessageContains(err, errCodeInvalidGatewayIDNotFound, "The gateway ID 'local' does not exist") {
 		return sdkdiag.AppendErrorf(diags, "cannot create local Route, use `terraform import` to manage existing local Routes")
@@ -302,8 +304,13 @@ func resourceRouteRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	routeTableID := d.Get("route_table_id").(string)
+	// Refreshing many routes in the same route table shares a single route table description.
+	findCtx := ctx
+	if !d.IsNewResource() {
+		findCtx = withRouteTableReadCache(ctx)
+	}
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
-		return routeFinder(ctx, conn, routeTableID, destination)
+		return routeFinder(findCtx, conn, routeTableID, destination)
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -414,6 +421,8 @@ func resourceRouteUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Updating Route: %v", input)
 	_, err = conn.ReplaceRoute(ctx, input)
 
+	invalidateRouteTableCache(routeTableID)
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Route in Route Table (%s) with destination (%s): %s", routeTableID, destination, err)
 	}
@@ -464,6 +473,8 @@ func resourceRouteDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		errCodeInvalidParameterException,
 	)
 
+	invalidateRouteTableCache(routeTableID)
+
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
 		return diags
 	}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/cache"
+)
+
+const (
+	routeTableReadCacheTTL = 15 * time.Second
+)
+
+type routeTableReadCacheKey struct {
+	conn         *ec2.Client
+	routeTableID string
+}
+
+// routeTableCache shares DescribeRouteTables results so that refreshing many aws_route resources
+// in the same route table results in a single API call.
+var routeTableCache = cache.New[routeTableReadCacheKey, *awstypes.RouteTable](routeTableReadCacheTTL)
+
+type routeTableReadCacheContextKey struct{}
+
+// withRouteTableReadCache returns a context in which findRouteTableByID uses the route table read cache.
+// It must only be used for refreshing existing resources, never for waiting on a change.
+func withRouteTableReadCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, routeTableReadCacheContextKey{}, true)
+}
+
+func routeTableReadCacheEnabled(ctx context.Context) bool {
+	v, _ := ctx.Value(routeTableReadCacheContextKey{}).(bool)
+	return v
+}
+
+// invalidateRouteTableCache discards the cached description of the specified route table.
+// It must be called after any change to the route table's routes, whether or not the change succeeded.
+func invalidateRouteTableCache(routeTableID string) {
+	routeTableCache.InvalidateFunc(func(k routeTableReadCacheKey) bool {
+		return k.routeTableID == routeTableID
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestRouteTableReadCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("invalidate", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		fetch := func(context.Context) (*awstypes.RouteTable, error) {
+			calls.Add(1)
+			return &awstypes.RouteTable{}, nil
+		}
+
+		// Route table IDs unique to this test, as the cache is shared by the package.
+		routeTableCache.Get(ctx, routeTableReadCacheKey{nil, "rtb-test-1"}, fetch)
+		routeTableCache.Get(ctx, routeTableReadCacheKey{nil, "rtb-test-2"}, fetch)
+		invalidateRouteTableCache("rtb-test-1")
+		routeTableCache.Get(ctx, routeTableReadCacheKey{nil, "rtb-test-1"}, fetch)
+		routeTableCache.Get(ctx, routeTableReadCacheKey{nil, "rtb-test-2"}, fetch)
+
+		if got := calls.Load(); got != 3 {
+			t.Errorf("got %d calls, expected 3", got)
+		}
+	})
+
+	t.Run("context", func(t *testing.T) {
+		t.Parallel()
+
+		if routeTableReadCacheEnabled(ctx) {
+			t.Error("expected cache to be disabled")
+		}
+		if !routeTableReadCacheEnabled(withRouteTableReadCache(ctx)) {
+			t.Error("expected cache to be enabled")
+		}
+	})
+}
@@ -380,6 +380,8 @@ func resourceRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta
 	}
 	_, err = conn.DeleteRouteTable(ctx, &input)
 
+	invalidateRouteTableCache(d.Id())
+
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
 		return diags
 	}
@@ -522,6 +524,8 @@ func routeTableAddRoute(ctx context.Context, conn *ec2.Client, routeTableID stri
 		errCodeInvalidTransitGatewayIDNotFound,
 	)
 
+	invalidateRouteTableCache(routeTableID)
+
 	if err != nil {
 		return fmt.Errorf("creating Route in Route Table (%s) with destination (%s): %w", routeTableID, destination, err)
 	}
@@ -559,6 +563,8 @@ func routeTableDeleteRoute(ctx context.Context, conn *ec2.Client, routeTableID s
 
 	_, err := conn.DeleteRoute(ctx, input)
 
+	invalidateRouteTableCache(routeTableID)
+
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
 		return nil
 	}
@@ -608,6 +614,8 @@ func routeTableUpdateRoute(ctx context.Context, conn *ec2.Client, routeTableID s
 
 	_, err := conn.ReplaceRoute(ctx, input)
 
+	invalidateRouteTableCache(routeTableID)
+
 	if err != nil {
 		return fmt.Errorf("updating Route in Route Table (%s) with destination (%s): %w", routeTableID, destination, err)
 	}
@@ -629,6 +637,8 @@ func routeTableDisableVGWRoutePropagation(ctx context.Context, conn *ec2.Client,
 
 	_, err := conn.DisableVgwRoutePropagation(ctx, input)
 
+	invalidateRouteTableCache(routeTableID)
+
 	if err != nil {
 		return fmt.Errorf("disabling Route Table (%s) VPN Gateway (%s) route propagation: %w", routeTableID, gatewayID, err)
 	}
@@ -652,6 +662,8 @@ func routeTableEnableVGWRoutePropagation(ctx context.Context, conn *ec2.Client,
 		errCodeGatewayNotAttached,
 	)
 
+	invalidateRouteTableCache(routeTableID)
+
 	if err != nil {
 		return fmt.Errorf("enabling Route Table (%s) VPN Gateway (%s) route propagation: %w", routeTableID, gatewayID, err)
 	}
@@ -161,6 +161,11 @@ func resourceClientVPNEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"disconnect_on_session_timeout": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			names.AttrDNSName: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -265,6 +270,10 @@ func resourceClientVPNEndpointCreate(ctx context.Context, d *schema.ResourceData
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOkExists("disconnect_on_session_timeout"); ok {
+		input.DisconnectOnSessionTimeout = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("dns_servers"); ok && len(v.([]interface{})) > 0 {
 		input.DnsServers = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -346,6 +355,7 @@ func resourceClientVPNEndpointRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("connection_log_options", nil)
 	}
 	d.Set(names.AttrDescription, ep.Description)
+	d.Set("disconnect_on_session_timeout", ep.DisconnectOnSessionTimeout)
 	d.Set(names.AttrDNSName, ep.DnsName)
 	d.Set("dns_servers", aws.StringSlice(ep.DnsServers))
 	d.Set(names.AttrSecurityGroupIDs, aws.StringSlice(ep.SecurityGroupIds))
@@ -401,6 +411,10 @@ func resourceClientVPNEndpointUpdate(ctx context.Context, d *schema.ResourceData
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
 
+		if d.HasChange("disconnect_on_session_timeout") {
+			input.DisconnectOnSessionTimeout = aws.Bool(d.Get("disconnect_on_session_timeout").(bool))
+		}
+
 		if d.HasChange("dns_servers") {
 			dnsServers := d.Get("dns_servers").([]interface{})
 			enabled := len(dnsServers) > 0
@@ -555,21 +569,29 @@ func flattenClientVPNAuthentication(apiObject awstypes.ClientVpnAuthentication)
 	tfMap := map[string]interface{}{}
 	tfMap[names.AttrType] = apiObject.Type
 
-	if apiObject.MutualAuthentication != nil {
-		if v := apiObject.MutualAuthentication.ClientRootCertificateChain; v != nil {
-			tfMap["root_certificate_chain_arn"] = aws.ToString(v)
-		}
-	} else if apiObject.ActiveDirectory != nil {
-		if v := apiObject.ActiveDirectory.DirectoryId; v != nil {
-			tfMap["active_directory_id"] = aws.ToString(v)
+	// Select the details by authentication type as the API may also return empty structures for the other types.
+	switch apiObject.Type {
+	case awstypes.ClientVpnAuthenticationTypeCertificateAuthentication:
+		if apiObject.MutualAuthentication != nil {
+			if v := apiObject.MutualAuthentication.ClientRootCertificateChain; v != nil {
+				tfMap["root_certificate_chain_arn"] = aws.ToString(v)
+			}
 		}
-	} else if apiObject.FederatedAuthentication != nil {
-		if v := apiObject.FederatedAuthentication.SamlProviderArn; v != nil {
-			tfMap["saml_provider_arn"] = aws.ToString(v)
+	case awstypes.ClientVpnAuthenticationTypeDirectoryServiceAuthentication:
+		if apiObject.ActiveDirectory != nil {
+			if v := apiObject.ActiveDirectory.DirectoryId; v != nil {
+				tfMap["active_directory_id"] = aws.ToString(v)
+			}
 		}
+	case awstypes.ClientVpnAuthenticationTypeFederatedAuthentication:
+		if apiObject.FederatedAuthentication != nil {
+			if v := apiObject.FederatedAuthentication.SamlProviderArn; v != nil {
+				tfMap["saml_provider_arn"] = aws.ToString(v)
+			}
 
-		if v := apiObject.FederatedAuthentication.SelfServiceSamlProviderArn; v != nil {
-			tfMap["self_service_saml_provider_arn"] = aws.ToString(v)
+			if v := apiObject.FederatedAuthentication.SelfServiceSamlProviderArn; v != nil {
+				tfMap["self_service_saml_provider_arn"] = aws.ToString(v)
+			}
 		}
 	}
 
@@ -609,6 +609,21 @@ func waitImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client, targ
 	return err
 }
 
+func waitSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2.Client, target awstypes.SnapshotBlockPublicAccessState, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Target:                    enum.Slice(target),
+		Refresh:                   statusSnapshotBlockPublicAccess(ctx, conn),
+		Timeout:                   timeout,
+		Delay:                     5 * time.Second,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 func waitImageDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Image, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ImageStateAvailable, awstypes.ImageStateFailed, awstypes.ImageStatePending),
@@ -2909,6 +2924,34 @@ func waitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.Client, id str
 	return nil, err
 }
 
+func waitVPCEndpointServiceSupportedRegionsAvailable(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.ServiceConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{supportedRegionServiceStatePending},
+		Target:     []string{supportedRegionServiceStateAvailable},
+		Refresh:    statusVPCEndpointServiceSupportedRegions(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ServiceConfiguration); ok {
+		var errs []error
+		for _, v := range output.SupportedRegions {
+			switch state := aws.ToString(v.ServiceState); state {
+			case supportedRegionServiceStateClosed, supportedRegionServiceStateFailed:
+				errs = append(errs, fmt.Errorf("supported Region (%s): %s", aws.ToString(v.Region), state))
+			}
+		}
+		tfresource.SetLastError(err, errors.Join(errs...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitVPCEndpointServicePrivateDNSNameVerified(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.PrivateDnsNameConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.DnsNameStatePendingVerification),
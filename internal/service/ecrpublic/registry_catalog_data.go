@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecrpublic
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecrpublic/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ecrpublic_registry_catalog_data", name="Registry Catalog Data")
+func ResourceRegistryCatalogData() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRegistryCatalogDataPut,
+		ReadWithoutTimeout:   resourceRegistryCatalogDataRead,
+		UpdateWithoutTimeout: resourceRegistryCatalogDataPut,
+		DeleteWithoutTimeout: resourceRegistryCatalogDataDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"registry_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registry_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"verified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRegistryCatalogDataPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRPublicClient(ctx)
+
+	input := ecrpublic.PutRegistryCatalogDataInput{
+		DisplayName: aws.String(d.Get(names.AttrDisplayName).(string)),
+	}
+
+	_, err := conn.PutRegistryCatalogData(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting ECR Public Registry Catalog Data: %s", err)
+	}
+
+	if d.IsNewResource() {
+		registry, err := FindRegistry(ctx, conn)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ECR Public Registry: %s", err)
+		}
+
+		d.SetId(aws.ToString(registry.RegistryId))
+	}
+
+	return append(diags, resourceRegistryCatalogDataRead(ctx, d, meta)...)
+}
+
+func resourceRegistryCatalogDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRPublicClient(ctx)
+
+	registry, err := FindRegistry(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECR Public Registry (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Public Registry (%s): %s", d.Id(), err)
+	}
+
+	catalogData, err := FindRegistryCatalogData(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Public Registry (%s) Catalog Data: %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrDisplayName, catalogData.DisplayName)
+	d.Set("registry_arn", registry.RegistryArn)
+	d.Set("registry_id", registry.RegistryId)
+	d.Set("registry_uri", registry.RegistryUri)
+	d.Set("verified", registry.Verified)
+
+	return diags
+}
+
+func resourceRegistryCatalogDataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRPublicClient(ctx)
+
+	// The registry catalog data can't be deleted, only reset.
+	log.Printf("[DEBUG] Resetting ECR Public Registry (%s) Catalog Data", d.Id())
+	input := ecrpublic.PutRegistryCatalogDataInput{
+		DisplayName: aws.String(""),
+	}
+
+	_, err := conn.PutRegistryCatalogData(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resetting ECR Public Registry (%s) Catalog Data: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindRegistry returns the caller's public registry.
+func FindRegistry(ctx context.Context, conn *ecrpublic.Client) (*awstypes.Registry, error) {
+	input := &ecrpublic.DescribeRegistriesInput{}
+	var output []awstypes.Registry
+
+	pages := ecrpublic.NewDescribeRegistriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Registries...)
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func FindRegistryCatalogData(ctx context.Context, conn *ecrpublic.Client) (*awstypes.RegistryCatalogData, error) {
+	input := &ecrpublic.GetRegistryCatalogDataInput{}
+
+	output, err := conn.GetRegistryCatalogData(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RegistryCatalogData == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RegistryCatalogData, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecrpublic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecrpublic "github.com/hashicorp/terraform-provider-aws/internal/service/ecrpublic"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The registry catalog data is a per-account singleton, so these tests must not run in parallel.
+func TestAccECRPublicRegistryCatalogData_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecrpublic_registry_catalog_data.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRPublicServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRegistryCatalogDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryCatalogDataConfig_basic(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryCatalogDataExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName1),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, "registry_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "registry_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "registry_uri"),
+					resource.TestCheckResourceAttrSet(resourceName, "verified"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRegistryCatalogDataConfig_basic(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryCatalogDataExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRegistryCatalogDataExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicClient(ctx)
+
+		output, err := tfecrpublic.FindRegistryCatalogData(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := *output.DisplayName, rs.Primary.Attributes[names.AttrDisplayName]; got != want {
+			return fmt.Errorf("ECR Public Registry display name = %q, want %q", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRegistryCatalogDataDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ecrpublic_registry_catalog_data" {
+				continue
+			}
+
+			output, err := tfecrpublic.FindRegistryCatalogData(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if v := output.DisplayName; v != nil && *v != "" {
+				return fmt.Errorf("ECR Public Registry (%s) display name still set: %s", rs.Primary.ID, *v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccRegistryCatalogDataConfig_basic(displayName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_registry_catalog_data" "test" {
+  display_name = %[1]q
+}
+`, displayName)
+}
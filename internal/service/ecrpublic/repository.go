@@ -5,6 +5,8 @@ package ecrpublic
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"time"
@@ -83,6 +85,13 @@ func ResourceRepository() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
+							StateFunc: func(v interface{}) string {
+								return logoImageBlobHashSum(v.(string))
+							},
+							// Earlier versions stored the base64-encoded image itself in state.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return old != "" && logoImageBlobHashSum(old) == new
+							},
 						},
 						"operating_systems": {
 							Type:     schema.TypeSet,
@@ -308,8 +317,8 @@ func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func flattenRepositoryCatalogData(apiObject *ecrpublic.GetRepositoryCatalogDataOutput) map[string]interface{} {
-	if apiObject == nil {
-		return nil
+	if apiObject == nil || apiObject.CatalogData == nil {
+		return map[string]interface{}{}
 	}
 
 	catalogData := apiObject.CatalogData
@@ -384,10 +393,17 @@ func expandRepositoryCatalogData(tfMap map[string]interface{}) *awstypes.Reposit
 func resourceRepositoryUpdateCatalogData(ctx context.Context, conn *ecrpublic.Client, d *schema.ResourceData) error {
 	if d.HasChange("catalog_data") {
 		if v, ok := d.GetOk("catalog_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			// Only the hash of the logo image is kept in state, so the image is only sent when it changes.
+			if !d.HasChange("catalog_data.0.logo_image_blob") {
+				delete(tfMap, "logo_image_blob")
+			}
+
 			input := ecrpublic.PutRepositoryCatalogDataInput{
 				RepositoryName: aws.String(d.Id()),
 				RegistryId:     aws.String(d.Get("registry_id").(string)),
-				CatalogData:    expandRepositoryCatalogData(v.([]interface{})[0].(map[string]interface{})),
+				CatalogData:    expandRepositoryCatalogData(tfMap),
 			}
 
 			_, err := conn.PutRepositoryCatalogData(ctx, &input)
@@ -400,3 +416,19 @@ func resourceRepositoryUpdateCatalogData(ctx context.Context, conn *ecrpublic.Cl
 
 	return nil
 }
+
+// logoImageBlobHashSum returns the value stored in state for a catalog data logo image.
+// The base64-encoded image can be up to 2 MB, so only a hash of the decoded image is stored.
+func logoImageBlobHashSum(v string) string {
+	if v == "" {
+		return ""
+	}
+
+	b, err := itypes.Base64Decode(v)
+	if err != nil {
+		b = []byte(v)
+	}
+
+	hash := sha256.Sum256(b)
+	return hex.EncodeToString(hash[:])
+}
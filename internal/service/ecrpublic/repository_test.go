@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecrpublic/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -296,11 +298,11 @@ func TestAccECRPublicRepository_CatalogData_logoImageBlob(t *testing.T) {
 		CheckDestroy:             testAccCheckRepositoryDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRepositoryConfig_catalogDataLogoImageBlob(rName),
+				Config: testAccRepositoryConfig_catalogDataLogoImageBlob(rName, "terraform_logo.png"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRepositoryExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "catalog_data.#", "1"),
-					resource.TestCheckResourceAttrSet(resourceName, "catalog_data.0.logo_image_blob"),
+					resource.TestMatchResourceAttr(resourceName, "catalog_data.0.logo_image_blob", regexache.MustCompile(`^[0-9a-f]{64}$`)),
 				),
 			},
 			{
@@ -309,6 +311,19 @@ func TestAccECRPublicRepository_CatalogData_logoImageBlob(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"catalog_data.0.logo_image_blob"},
 			},
+			{
+				Config: testAccRepositoryConfig_catalogDataLogoImageBlob(rName, "terraform_logo_updated.png"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "catalog_data.0.logo_image_blob", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
 		},
 	})
 }
@@ -530,13 +545,13 @@ resource "aws_ecrpublic_repository" "test" {
 `, rName, usageText)
 }
 
-func testAccRepositoryConfig_catalogDataLogoImageBlob(rName string) string {
+func testAccRepositoryConfig_catalogDataLogoImageBlob(rName, fileName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecrpublic_repository" "test" {
-  repository_name = %q
+  repository_name = %[1]q
   catalog_data {
-    logo_image_blob = filebase64("test-fixtures/terraform_logo.png")
+    logo_image_blob = filebase64("test-fixtures/%[2]s")
   }
 }
-`, rName)
+`, rName, fileName)
 }
@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceRegistryCatalogData,
+			TypeName: "aws_ecrpublic_registry_catalog_data",
+			Name:     "Registry Catalog Data",
+		},
 		{
 			Factory:  ResourceRepository,
 			TypeName: "aws_ecrpublic_repository",
@@ -6,7 +6,10 @@ package ecs
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -21,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -114,6 +118,11 @@ func resourceCapacityProvider() *schema.Resource {
 					},
 				},
 			},
+			names.AttrForceDelete: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -200,7 +209,7 @@ func resourceCapacityProviderUpdate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrForceDelete, names.AttrTags, names.AttrTagsAll) {
 		input := &ecs.UpdateCapacityProviderInput{
 			AutoScalingGroupProvider: expandAutoScalingGroupProviderUpdate(d.Get("auto_scaling_group_provider")),
 			Name:                     aws.String(d.Get(names.AttrName).(string)),
@@ -229,8 +238,33 @@ func resourceCapacityProviderDelete(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
 
+	// A capacity provider that is still associated with a cluster can't be deleted.
+	// Rather than waiting for the delete to time out, fail fast or, if requested, remove the association.
+	name := d.Get(names.AttrName).(string)
+	clusters, err := findClustersByCapacityProvider(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Clusters associated with ECS Capacity Provider (%s): %s", d.Id(), err)
+	}
+
+	if len(clusters) > 0 {
+		if !d.Get(names.AttrForceDelete).(bool) {
+			clusterNames := tfslices.ApplyToAll(clusters, func(v awstypes.Cluster) string {
+				return aws.ToString(v.ClusterName)
+			})
+
+			return sdkdiag.AppendErrorf(diags, "deleting ECS Capacity Provider (%s): associated with ECS Cluster(s) (%s); remove it from the clusters' capacity providers and default capacity provider strategy or set force_delete", d.Id(), strings.Join(clusterNames, ", "))
+		}
+
+		for _, cluster := range clusters {
+			if err := disassociateCapacityProviderFromCluster(ctx, conn, &cluster, name); err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing ECS Capacity Provider (%s) from ECS Cluster (%s): %s", d.Id(), aws.ToString(cluster.ClusterName), err)
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting ECS Capacity Provider: %s", d.Id())
-	_, err := conn.DeleteCapacityProvider(ctx, &ecs.DeleteCapacityProviderInput{
+	_, err = conn.DeleteCapacityProvider(ctx, &ecs.DeleteCapacityProviderInput{
 		CapacityProvider: aws.String(d.Id()),
 	})
 
@@ -254,6 +288,7 @@ func resourceCapacityProviderDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCapacityProviderImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(names.AttrForceDelete, false)
 	d.Set(names.AttrName, d.Id())
 	d.SetId(arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition(ctx),
@@ -329,6 +364,67 @@ func findCapacityProviderByARN(ctx context.Context, conn *ecs.Client, arn string
 	return output, nil
 }
 
+// findClustersByCapacityProvider returns the clusters that have the named capacity provider associated.
+func findClustersByCapacityProvider(ctx context.Context, conn *ecs.Client, name string) ([]awstypes.Cluster, error) {
+	arns, err := listClusters(ctx, conn, &ecs.ListClustersInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []awstypes.Cluster
+
+	// DescribeClusters accepts at most 100 clusters per call.
+	for chunk := range slices.Chunk(arns, 100) {
+		clusters, err := findClusters(ctx, conn, &ecs.DescribeClustersInput{
+			Clusters: chunk,
+		})
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range clusters {
+			if aws.ToString(cluster.Status) == clusterStatusInactive {
+				continue
+			}
+
+			if slices.Contains(cluster.CapacityProviders, name) || slices.ContainsFunc(cluster.DefaultCapacityProviderStrategy, func(v awstypes.CapacityProviderStrategyItem) bool {
+				return aws.ToString(v.CapacityProvider) == name
+			}) {
+				output = append(output, cluster)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func disassociateCapacityProviderFromCluster(ctx context.Context, conn *ecs.Client, cluster *awstypes.Cluster, name string) error {
+	clusterARN := aws.ToString(cluster.ClusterArn)
+	input := &ecs.PutClusterCapacityProvidersInput{
+		CapacityProviders: tfslices.RemoveAll(cluster.CapacityProviders, name),
+		Cluster:           aws.String(clusterARN),
+		DefaultCapacityProviderStrategy: tfslices.Filter(cluster.DefaultCapacityProviderStrategy, func(v awstypes.CapacityProviderStrategyItem) bool {
+			return aws.ToString(v.CapacityProvider) != name
+		}),
+	}
+
+	if err := retryClusterCapacityProvidersPut(ctx, conn, input); err != nil {
+		return err
+	}
+
+	if _, err := waitClusterAvailable(ctx, conn, clusterARN); err != nil {
+		return fmt.Errorf("waiting for ECS Cluster (%s) update: %w", clusterARN, err)
+	}
+
+	return nil
+}
+
 func statusCapacityProvider(ctx context.Context, conn *ecs.Client, arn string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findCapacityProviderByARN(ctx, conn, arn)
@@ -75,6 +75,10 @@ func expandLoadBalancers(tfList []interface{}) []awstypes.LoadBalancer {
 			apiObject.TargetGroupArn = aws.String(v.(string))
 		}
 
+		if v, ok := tfMap["advanced_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.AdvancedConfiguration = expandAdvancedConfiguration(v[0].(map[string]interface{}))
+		}
+
 		apiObjects = append(apiObjects, apiObject)
 	}
 
@@ -98,12 +102,57 @@ func flattenLoadBalancers(apiObjects []awstypes.LoadBalancer) []interface{} {
 			tfMap["target_group_arn"] = aws.ToString(apiObject.TargetGroupArn)
 		}
 
+		if apiObject.AdvancedConfiguration != nil {
+			tfMap["advanced_configuration"] = []interface{}{flattenAdvancedConfiguration(apiObject.AdvancedConfiguration)}
+		}
+
 		tfList = append(tfList, tfMap)
 	}
 
 	return tfList
 }
 
+func expandAdvancedConfiguration(tfMap map[string]interface{}) *awstypes.AdvancedConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.AdvancedConfiguration{}
+
+	if v, ok := tfMap["alternate_target_group_arn"].(string); ok && v != "" {
+		apiObject.AlternateTargetGroupArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["production_listener_rule"].(string); ok && v != "" {
+		apiObject.ProductionListenerRule = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["test_listener_rule"].(string); ok && v != "" {
+		apiObject.TestListenerRule = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAdvancedConfiguration(apiObject *awstypes.AdvancedConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"alternate_target_group_arn": aws.ToString(apiObject.AlternateTargetGroupArn),
+		"production_listener_rule":   aws.ToString(apiObject.ProductionListenerRule),
+		names.AttrRoleARN:            aws.ToString(apiObject.RoleArn),
+		"test_listener_rule":         aws.ToString(apiObject.TestListenerRule),
+	}
+
+	return tfMap
+}
+
 func expandTaskSetLoadBalancers(tfList []interface{}) []awstypes.LoadBalancer {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -606,6 +606,55 @@ func resourceService() *schema.Resource {
 					},
 				},
 			},
+			"deployment_configuration": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bake_time_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 1440),
+						},
+						"lifecycle_hook": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hook_target_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"lifecycle_stages": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[awstypes.DeploymentLifecycleHookStage](),
+										},
+									},
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.DeploymentStrategy](),
+						},
+					},
+				},
+			},
 			"deployment_controller": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -693,6 +742,35 @@ func resourceService() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"advanced_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alternate_target_group_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"production_listener_rule": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"test_listener_rule": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
 						"container_name": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -880,6 +958,41 @@ func resourceService() *schema.Resource {
 													Required:     true,
 													ValidateFunc: validation.IntBetween(0, 65535),
 												},
+												"test_traffic_rules": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrHeader: {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		names.AttrName: {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																		names.AttrValue: {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"exact": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -1204,6 +1317,10 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.DeploymentConfiguration.DeploymentCircuitBreaker = expandDeploymentCircuitBreaker(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("deployment_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		expandDeploymentConfiguration(v.([]interface{})[0].(map[string]interface{}), input.DeploymentConfiguration)
+	}
+
 	if v, ok := d.GetOk("health_check_grace_period_seconds"); ok {
 		input.HealthCheckGracePeriodSeconds = aws.Int32(int32(v.(int)))
 	}
@@ -1356,6 +1473,10 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		} else {
 			d.Set("deployment_circuit_breaker", nil)
 		}
+
+		if err := d.Set("deployment_configuration", []interface{}{flattenDeploymentConfiguration(service.DeploymentConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting deployment_configuration: %s", err)
+		}
 	}
 	if err := d.Set("deployment_controller", flattenDeploymentController(service.DeploymentController)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting deployment_controller: %s", err)
@@ -1469,6 +1590,19 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
+		if d.HasChange("deployment_configuration") {
+			if input.DeploymentConfiguration == nil {
+				input.DeploymentConfiguration = &awstypes.DeploymentConfiguration{}
+			}
+
+			// Send an empty list so that removed lifecycle hooks are cleared.
+			input.DeploymentConfiguration.LifecycleHooks = []awstypes.DeploymentLifecycleHook{}
+
+			if v, ok := d.GetOk("deployment_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				expandDeploymentConfiguration(v.([]interface{})[0].(map[string]interface{}), input.DeploymentConfiguration)
+			}
+		}
+
 		switch schedulingStrategy := awstypes.SchedulingStrategy(d.Get("scheduling_strategy").(string)); schedulingStrategy {
 		case awstypes.SchedulingStrategyDaemon:
 			if d.HasChange("deployment_minimum_healthy_percent") {
@@ -2121,6 +2255,92 @@ func flattenDeploymentCircuitBreaker(apiObject *awstypes.DeploymentCircuitBreake
 	return tfMap
 }
 
+// expandDeploymentConfiguration sets the deployment strategy fields of the
+// deployment_configuration block on an existing DeploymentConfiguration, which
+// also carries fields from other top-level arguments.
+func expandDeploymentConfiguration(tfMap map[string]interface{}, apiObject *awstypes.DeploymentConfiguration) {
+	if tfMap == nil || apiObject == nil {
+		return
+	}
+
+	if v, ok := tfMap["bake_time_in_minutes"].(int); ok && v != 0 {
+		apiObject.BakeTimeInMinutes = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["lifecycle_hook"].([]interface{}); ok && len(v) > 0 {
+		apiObject.LifecycleHooks = expandDeploymentLifecycleHooks(v)
+	}
+
+	if v, ok := tfMap["strategy"].(string); ok && v != "" {
+		apiObject.Strategy = awstypes.DeploymentStrategy(v)
+	}
+}
+
+func flattenDeploymentConfiguration(apiObject *awstypes.DeploymentConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"strategy": apiObject.Strategy,
+	}
+
+	if v := apiObject.BakeTimeInMinutes; v != nil {
+		tfMap["bake_time_in_minutes"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.LifecycleHooks; v != nil {
+		tfMap["lifecycle_hook"] = flattenDeploymentLifecycleHooks(v)
+	}
+
+	return tfMap
+}
+
+func expandDeploymentLifecycleHooks(tfList []interface{}) []awstypes.DeploymentLifecycleHook {
+	apiObjects := make([]awstypes.DeploymentLifecycleHook, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.DeploymentLifecycleHook{}
+
+		if v, ok := tfMap["hook_target_arn"].(string); ok && v != "" {
+			apiObject.HookTargetArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["lifecycle_stages"].([]interface{}); ok && len(v) > 0 {
+			apiObject.LifecycleStages = flex.ExpandStringyValueList[awstypes.DeploymentLifecycleHookStage](v)
+		}
+
+		if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+			apiObject.RoleArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDeploymentLifecycleHooks(apiObjects []awstypes.DeploymentLifecycleHook) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"hook_target_arn":  aws.ToString(apiObject.HookTargetArn),
+			"lifecycle_stages": flex.FlattenStringyValueList(apiObject.LifecycleStages),
+			names.AttrRoleARN:  aws.ToString(apiObject.RoleArn),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenNetworkConfiguration(nc *awstypes.NetworkConfiguration) []interface{} {
 	if nc == nil {
 		return nil
@@ -2595,6 +2815,9 @@ func expandClientAliases(srv []interface{}) []awstypes.ServiceConnectClientAlias
 		if v, ok := raw[names.AttrDNSName].(string); ok && v != "" {
 			config.DnsName = aws.String(v)
 		}
+		if v, ok := raw["test_traffic_rules"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			config.TestTrafficRules = expandServiceConnectTestTrafficRules(v[0].(map[string]interface{}))
+		}
 
 		out = append(out, config)
 	}
@@ -2602,6 +2825,36 @@ func expandClientAliases(srv []interface{}) []awstypes.ServiceConnectClientAlias
 	return out
 }
 
+func expandServiceConnectTestTrafficRules(tfMap map[string]interface{}) *awstypes.ServiceConnectTestTrafficRules {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ServiceConnectTestTrafficRules{}
+
+	if v, ok := tfMap[names.AttrHeader].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		header := &awstypes.ServiceConnectTestTrafficHeaderRules{}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			header.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrValue].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			header.Value = &awstypes.ServiceConnectTestTrafficHeaderMatchRules{}
+
+			if v, ok := tfMap["exact"].(string); ok && v != "" {
+				header.Value.Exact = aws.String(v)
+			}
+		}
+
+		apiObject.Header = header
+	}
+
+	return apiObject
+}
+
 func flattenServiceRegistries(srs []awstypes.ServiceRegistry) []map[string]interface{} {
 	if len(srs) == 0 {
 		return nil
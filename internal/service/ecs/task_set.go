@@ -38,7 +38,7 @@ func resourceTaskSet() *schema.Resource {
 		DeleteWithoutTimeout: resourceTaskSetDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceTaskSetImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -252,7 +252,7 @@ func resourceTaskSet() *schema.Resource {
 			"wait_until_stable_timeout": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "10m",
+				Default:  taskSetDefaultWaitUntilStableTimeout,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					value := v.(string)
 					duration, err := time.ParseDuration(value)
@@ -416,7 +416,7 @@ func resourceTaskSetUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange("scale") {
 		taskSetID, service, cluster, err := taskSetParseResourceID(d.Id())
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -478,6 +478,23 @@ func resourceTaskSetDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+func resourceTaskSetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := taskSetParseResourceID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	// Arguments that only affect provider behavior aren't returned by the API.
+	d.Set(names.AttrForceDelete, false)
+	d.Set("wait_until_stable", false)
+	d.Set("wait_until_stable_timeout", taskSetDefaultWaitUntilStableTimeout)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const (
+	taskSetDefaultWaitUntilStableTimeout = "10m"
+)
+
 const taskSetResourceIDSeparator = ","
 
 func taskSetCreateResourceID(taskSetID, service, cluster string) string {
@@ -46,8 +46,6 @@ func TestAccECSTaskSet_basic(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"stability_status",
-					"wait_until_stable",
-					"wait_until_stable_timeout",
 				},
 			},
 		},
@@ -135,6 +133,39 @@ func TestAccECSTaskSet_withScale(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskSet_waitUntilStable(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskSetConfig_waitUntilStable(rName, 0.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrForceDelete, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stability_status", string(awstypes.StabilityStatusSteadyState)),
+					resource.TestCheckResourceAttr(resourceName, "wait_until_stable", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccTaskSetConfig_waitUntilStable(rName, 50.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "50"),
+					resource.TestCheckResourceAttr(resourceName, "stability_status", string(awstypes.StabilityStatusSteadyState)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSTaskSet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -496,6 +527,24 @@ resource "aws_ecs_task_set" "test" {
 `, scale))
 }
 
+func testAccTaskSetConfig_waitUntilStable(rName string, scale float64) string {
+	return acctest.ConfigCompose(testAccTaskSetConfig_base(rName), fmt.Sprintf(`
+resource "aws_ecs_task_set" "test" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  force_delete    = true
+
+  wait_until_stable         = true
+  wait_until_stable_timeout = "15m"
+
+  scale {
+    value = %[1]f
+  }
+}
+`, scale))
+}
+
 func testAccTaskSetConfig_capacityProviderStrategy(rName string, weight, base int) string {
 	return acctest.ConfigCompose(testAccCapacityProviderConfig_base(rName), testAccTaskSetConfig_base(rName), fmt.Sprintf(`
 resource "aws_ecs_capacity_provider" "test" {
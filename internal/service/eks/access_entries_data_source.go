@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_eks_access_entries", name="Access Entries")
+func dataSourceAccessEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccessEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_entry_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kubernetes_groups": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"principal_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrUserName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validClusterName,
+			},
+			"principal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAccessEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get(names.AttrClusterName).(string)
+	input := &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(clusterName),
+	}
+	var principalARNs []string
+	pages := eks.NewListAccessEntriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing EKS Access Entries (%s): %s", clusterName, err)
+		}
+
+		principalARNs = append(principalARNs, page.AccessEntries...)
+	}
+
+	// ListAccessEntries only returns principal ARNs.
+	var accessEntries []types.AccessEntry
+	for _, principalARN := range principalARNs {
+		accessEntry, err := findAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Access Entry (%s): %s", accessEntryCreateResourceID(clusterName, principalARN), err)
+		}
+
+		accessEntries = append(accessEntries, *accessEntry)
+	}
+
+	d.SetId(clusterName)
+	if err := d.Set("access_entries", flattenAccessEntries(accessEntries)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting access_entries: %s", err)
+	}
+	d.Set(names.AttrClusterName, clusterName)
+	d.Set("principal_arns", principalARNs)
+
+	return diags
+}
+
+func flattenAccessEntries(apiObjects []types.AccessEntry) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"access_entry_arn":  aws.ToString(apiObject.AccessEntryArn),
+			"kubernetes_groups": apiObject.KubernetesGroups,
+			"principal_arn":     aws.ToString(apiObject.PrincipalArn),
+			names.AttrType:      aws.ToString(apiObject.Type),
+			names.AttrUserName:  aws.ToString(apiObject.Username),
+		})
+	}
+
+	return tfList
+}
@@ -31,6 +31,7 @@ func resourceAccessPolicyAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccessPolicyAssociationCreate,
 		ReadWithoutTimeout:   resourceAccessPolicyAssociationRead,
+		UpdateWithoutTimeout: resourceAccessPolicyAssociationUpdate,
 		DeleteWithoutTimeout: resourceAccessPolicyAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -39,6 +40,7 @@ func resourceAccessPolicyAssociation() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -48,20 +50,17 @@ func resourceAccessPolicyAssociation() *schema.Resource {
 				MinItems: 1,
 				MaxItems: 1,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"namespaces": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							ForceNew: true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
 						},
 						names.AttrType: {
 							Type:     schema.TypeString,
-							ForceNew: true,
 							Required: true,
 						},
 					},
@@ -156,6 +155,33 @@ func resourceAccessPolicyAssociationRead(ctx context.Context, d *schema.Resource
 	return diags
 }
 
+func resourceAccessPolicyAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, principalARN, policyARN, err := accessPolicyAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Associating an already associated policy replaces its access scope in place,
+	// so the principal keeps its access throughout the change.
+	input := &eks.AssociateAccessPolicyInput{
+		AccessScope:  expandAccessScope(d.Get("access_scope").([]interface{})),
+		ClusterName:  aws.String(clusterName),
+		PolicyArn:    aws.String(policyARN),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	_, err = conn.AssociateAccessPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EKS Access Policy Association (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAccessPolicyAssociationRead(ctx, d, meta)...)
+}
+
 func resourceAccessPolicyAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EKSClient(ctx)
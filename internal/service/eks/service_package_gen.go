@@ -43,6 +43,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAccessEntries,
+			TypeName: "aws_eks_access_entries",
+			Name:     "Access Entries",
+		},
 		{
 			Factory:  dataSourceAccessEntry,
 			TypeName: "aws_eks_access_entry",
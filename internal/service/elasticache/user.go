@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -98,6 +99,20 @@ func resourceUser() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringLenBetween(16, 128),
+				ConflictsWith: []string{"authentication_mode.0.passwords", "passwords"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+			},
 			"passwords": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -147,6 +162,20 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Passwords = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" {
+		if input.AuthenticationMode != nil && input.AuthenticationMode.Type == awstypes.InputAuthenticationTypePassword {
+			input.AuthenticationMode.Passwords = []string{passwordWO}
+		} else {
+			input.Passwords = []string{passwordWO}
+		}
+	}
+
 	output, err := conn.CreateUser(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -250,11 +279,38 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.NoPasswordRequired = aws.Bool(d.Get("no_password_required").(bool))
 		}
 
+		// Passwords must not be sent when switching to an authentication mode that doesn't use them.
 		if d.HasChange("passwords") {
-			input.Passwords = flex.ExpandStringValueSet(d.Get("passwords").(*schema.Set))
+			if v := d.Get("passwords").(*schema.Set); v.Len() > 0 {
+				input.Passwords = flex.ExpandStringValueSet(v)
+			}
+		}
+
+		// The write-only password is also sent when the authentication mode changes,
+		// as switching to password authentication requires the passwords.
+		if d.HasChanges("authentication_mode", "password_wo_version") {
+			passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if passwordWO != "" {
+				if v := d.Get("authentication_mode.0.type").(string); v == string(awstypes.InputAuthenticationTypePassword) {
+					input.AuthenticationMode = &awstypes.AuthenticationMode{
+						Passwords: []string{passwordWO},
+						Type:      awstypes.InputAuthenticationTypePassword,
+					}
+				} else if d.HasChange("password_wo_version") {
+					input.Passwords = []string{passwordWO}
+				}
+			}
 		}
 
-		_, err := conn.ModifyUser(ctx, input)
+		// The user can't be modified while a previous change is still propagating to its user groups.
+		_, err := tfresource.RetryWhenIsA[*awstypes.InvalidUserStateFault](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.ModifyUser(ctx, input)
+		})
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating ElastiCache User (%s): %s", d.Id(), err)
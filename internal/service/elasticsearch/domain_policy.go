@@ -71,6 +71,11 @@ func resourceDomainPolicyUpsert(ctx context.Context, d *schema.ResourceData, met
 		DomainName:     aws.String(domainName),
 	}
 
+	// A domain that is still being created or modified may reject or silently drop the change.
+	if _, err := waitDomainConfigUpdated(ctx, conn, domainName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elasticsearch Domain (%s) Config update: %s", domainName, err)
+	}
+
 	_, err = tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ValidationException](ctx, propagationTimeout,
 		func() (interface{}, error) {
 			return conn.UpdateElasticsearchDomainConfig(ctx, input)
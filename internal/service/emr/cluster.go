@@ -21,6 +21,7 @@ import (
 	smithyjson "github.com/aws/smithy-go/encoding/json"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -53,7 +54,13 @@ func resourceCluster() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// Only instance groups can be reconfigured in place.
+			customdiff.ForceNewIf("configurations_json", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return len(d.Get("master_instance_fleet").([]interface{})) > 0
+			}),
+		),
 
 		SchemaFunc: func() map[string]*schema.Schema {
 			instanceFleetConfigSchema := func() *schema.Resource {
@@ -314,7 +321,6 @@ func resourceCluster() *schema.Resource {
 				"configurations_json": {
 					Type:                  schema.TypeString,
 					Optional:              true,
-					ForceNew:              true,
 					ValidateFunc:          validation.StringIsJSON,
 					DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
 					DiffSuppressOnRefresh: true,
@@ -1076,12 +1082,18 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("cluster_state", cluster.Status.State)
 	d.Set(names.AttrARN, cluster.ClusterArn)
 
+	configurations := cluster.Configurations
 	instanceGroups, err := findInstanceGroupsByClusterID(ctx, conn, d.Id())
 
 	if err == nil { // find instance group
 		coreGroup, _ := coreInstanceGroup(instanceGroups)
 		masterGroup, _ := masterInstanceGroup(instanceGroups)
 
+		// The cluster's configurations are not updated when its instance groups are reconfigured.
+		if masterGroup != nil && aws.ToInt64(masterGroup.LastSuccessfullyAppliedConfigurationsVersion) > 0 {
+			configurations = masterGroup.LastSuccessfullyAppliedConfigurations
+		}
+
 		flattenedCoreInstanceGroup, err := flattenCoreInstanceGroup(coreGroup)
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -1138,7 +1150,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if _, ok := d.GetOk("configurations_json"); ok {
-		configOut, err := flattenConfigurationJSON(cluster.Configurations)
+		configOut, err := flattenConfigurationJSON(configurations)
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -1354,6 +1366,61 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("configurations_json") {
+		configurations := []awstypes.Configuration{}
+		if v, ok := d.GetOk("configurations_json"); ok {
+			v, err := structure.NormalizeJsonString(v)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+			configurations, err = expandConfigurationJSON(v)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		// Task instance groups are managed by the aws_emr_instance_group resource, which has its own configurations.
+		var instanceGroupIDs []string
+		for _, k := range []string{"master_instance_group.0.id", "core_instance_group.0.id"} {
+			if v := d.Get(k).(string); v != "" {
+				instanceGroupIDs = append(instanceGroupIDs, v)
+			}
+		}
+
+		input := &emr.ModifyInstanceGroupsInput{
+			ClusterId: aws.String(d.Id()),
+		}
+		configurationsVersions := make(map[string]int64, len(instanceGroupIDs))
+		for _, v := range instanceGroupIDs {
+			output, err := findInstanceGroupByTwoPartKey(ctx, conn, d.Id(), v)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EMR Cluster (%s) Instance Group (%s): %s", d.Id(), v, err)
+			}
+
+			configurationsVersions[v] = aws.ToInt64(output.ConfigurationsVersion)
+			input.InstanceGroups = append(input.InstanceGroups, awstypes.InstanceGroupModifyConfig{
+				Configurations:  configurations,
+				InstanceGroupId: aws.String(v),
+			})
+		}
+
+		_, err := conn.ModifyInstanceGroups(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EMR Cluster (%s): reconfiguring instance groups: %s", d.Id(), err)
+		}
+
+		const (
+			timeout = 30 * time.Minute
+		)
+		for _, v := range instanceGroupIDs {
+			if _, err := waitInstanceGroupReconfigured(ctx, conn, d.Id(), v, configurationsVersions[v], timeout); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EMR Cluster (%s) Instance Group (%s) reconfiguration: %s", d.Id(), v, err)
+			}
+		}
+	}
+
 	if d.HasChange("instance_group") {
 		o, n := d.GetChange("instance_group")
 		oSet := o.(*schema.Set).List()
@@ -240,6 +240,39 @@ func TestAccEMRCluster_sJSON(t *testing.T) {
 	})
 }
 
+func TestAccEMRCluster_configurationsJSONReconfigure(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 awstypes.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_configurationsJSONReconfigure(rName, "2g"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json",
+						regexache.MustCompile(`"spark.executor.memory":"2g"`)),
+				),
+			},
+			{
+				Config: testAccClusterConfig_configurationsJSONReconfigure(rName, "4g"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json",
+						regexache.MustCompile(`"spark.executor.memory":"4g"`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRCluster_CoreInstanceGroup_autoScalingPolicy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster1, cluster2, cluster3 awstypes.Cluster
@@ -2545,6 +2578,56 @@ EOF
 `, rName))
 }
 
+func testAccClusterConfig_configurationsJSONReconfigure(rName, executorMemory string) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_baseVPC(rName, false),
+		testAccClusterConfig_baseIAMServiceRole(rName),
+		testAccClusterConfig_baseIAMInstanceProfile(rName),
+		fmt.Sprintf(`
+resource "aws_emr_cluster" "test" {
+  name          = %[1]q
+  release_label = "emr-5.33.1"
+  applications  = ["Hadoop", "Spark"]
+
+  ec2_attributes {
+    subnet_id                         = aws_subnet.test.id
+    emr_managed_master_security_group = aws_security_group.test.id
+    emr_managed_slave_security_group  = aws_security_group.test.id
+    instance_profile                  = aws_iam_instance_profile.emr_instance_profile.arn
+  }
+
+  master_instance_group {
+    instance_type = "m4.large"
+  }
+
+  core_instance_group {
+    instance_count = 1
+    instance_type  = "m4.large"
+  }
+
+  keep_job_flow_alive_when_no_steps = true
+  termination_protection            = false
+
+  configurations_json = jsonencode([
+    {
+      Classification = "spark-defaults"
+      Properties = {
+        "spark.executor.memory" = %[2]q
+      }
+    }
+  ])
+
+  depends_on = [
+    aws_route_table_association.test,
+    aws_iam_role_policy_attachment.emr_service,
+    aws_iam_role_policy_attachment.emr_instance_profile,
+  ]
+
+  service_role = aws_iam_role.emr_service.arn
+}
+`, rName, executorMemory))
+}
+
 func testAccClusterConfig_coreInstanceGroupAutoScalingPolicy(rName, autoscalingPolicy string) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseVPC(rName, false),
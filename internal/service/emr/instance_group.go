@@ -265,6 +265,17 @@ func resourceInstanceGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 	conn := meta.(*conns.AWSClient).EMRClient(ctx)
 
 	if d.HasChanges(names.AttrInstanceCount, "configurations_json") {
+		var configurationsVersion int64
+		if d.HasChange("configurations_json") {
+			output, err := findInstanceGroupByTwoPartKey(ctx, conn, d.Get("cluster_id").(string), d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EMR Instance Group (%s): %s", d.Id(), err)
+			}
+
+			configurationsVersion = aws.ToInt64(output.ConfigurationsVersion)
+		}
+
 		groupConfig := awstypes.InstanceGroupModifyConfig{
 			InstanceGroupId: aws.String(d.Id()),
 		}
@@ -301,8 +312,14 @@ func resourceInstanceGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 		const (
 			timeout = 30 * time.Minute
 		)
-		if _, err := waitInstanceGroupRunning(ctx, conn, d.Get("cluster_id").(string), d.Id(), timeout); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for EMR Instance Group (%s) update: %s", d.Id(), err)
+		if d.HasChange("configurations_json") {
+			if _, err := waitInstanceGroupReconfigured(ctx, conn, d.Get("cluster_id").(string), d.Id(), configurationsVersion, timeout); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EMR Instance Group (%s) update: %s", d.Id(), err)
+			}
+		} else {
+			if _, err := waitInstanceGroupRunning(ctx, conn, d.Get("cluster_id").(string), d.Id(), timeout); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EMR Instance Group (%s) update: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -450,6 +467,76 @@ func waitInstanceGroupRunning(ctx context.Context, conn *emr.Client, clusterID,
 	return nil, err
 }
 
+// statusInstanceGroupReconfiguration returns the status of the reconfiguration of an instance group whose
+// configurations version was fromVersion before the reconfiguration was requested.
+// A failed reconfiguration returns the instance group to RUNNING with its last successfully applied configurations,
+// so an instance group that is RUNNING with a newer configurations version than the one last successfully applied is treated as a failure.
+func statusInstanceGroupReconfiguration(ctx context.Context, conn *emr.Client, clusterID, groupID string, fromVersion int64) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInstanceGroupByTwoPartKey(ctx, conn, clusterID, groupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		version, appliedVersion := aws.ToInt64(output.ConfigurationsVersion), aws.ToInt64(output.LastSuccessfullyAppliedConfigurationsVersion)
+
+		switch state := output.Status.State; state {
+		case awstypes.InstanceGroupStateRunning:
+			if version <= fromVersion {
+				return output, instanceGroupReconfigurationStatusPending, nil
+			}
+
+			if appliedVersion == version {
+				return output, instanceGroupReconfigurationStatusApplied, nil
+			}
+
+			return output, "", instanceGroupReconfigurationError(output, fmt.Errorf("reconfiguration failed: configurations version %d, last successfully applied version %d", version, appliedVersion))
+		case awstypes.InstanceGroupStateArrested,
+			awstypes.InstanceGroupStateEnded,
+			awstypes.InstanceGroupStateShuttingDown,
+			awstypes.InstanceGroupStateSuspended,
+			awstypes.InstanceGroupStateTerminated,
+			awstypes.InstanceGroupStateTerminating:
+			return output, "", instanceGroupReconfigurationError(output, fmt.Errorf("reconfiguration failed: instance group state %s", state))
+		default:
+			return output, instanceGroupReconfigurationStatusPending, nil
+		}
+	}
+}
+
+func instanceGroupReconfigurationError(apiObject *awstypes.InstanceGroup, err error) error {
+	if v := apiObject.Status.StateChangeReason; v != nil && v.Message != nil {
+		return fmt.Errorf("%w: %s: %s", err, v.Code, aws.ToString(v.Message))
+	}
+
+	return err
+}
+
+// waitInstanceGroupReconfigured waits for the configurations requested after version fromVersion to be applied to an instance group.
+func waitInstanceGroupReconfigured(ctx context.Context, conn *emr.Client, clusterID, groupID string, fromVersion int64, timeout time.Duration) (*awstypes.InstanceGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{instanceGroupReconfigurationStatusPending},
+		Target:     []string{instanceGroupReconfigurationStatusApplied},
+		Refresh:    statusInstanceGroupReconfiguration(ctx, conn, clusterID, groupID, fromVersion),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InstanceGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 // readEBSConfig populates an emr.EbsConfiguration struct
 func readEBSConfig(d *schema.ResourceData) *awstypes.EbsConfiguration {
 	result := &awstypes.EbsConfiguration{}
@@ -133,8 +133,18 @@ func resourceManagedScalingPolicyRead(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "reading EMR Managed Scaling Policy (%s): %s", d.Id(), err)
 	}
 
+	computeLimits := managedScalingPolicy.ComputeLimits
+	// EMR omits MaximumCoreCapacityUnits from the response when it is equal to MaximumCapacityUnits.
+	if computeLimits != nil && computeLimits.MaximumCoreCapacityUnits == nil {
+		if v := d.Get("compute_limits").(*schema.Set).List(); len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["maximum_core_capacity_units"].(int); ok && v > 0 && int32(v) == aws.ToInt32(computeLimits.MaximumCapacityUnits) {
+				computeLimits.MaximumCoreCapacityUnits = aws.Int32(int32(v))
+			}
+		}
+	}
+
 	d.Set("cluster_id", d.Id())
-	if err := d.Set("compute_limits", flattenComputeLimits(managedScalingPolicy.ComputeLimits)); err != nil {
+	if err := d.Set("compute_limits", flattenComputeLimits(computeLimits)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting compute_limits: %s", err)
 	}
 
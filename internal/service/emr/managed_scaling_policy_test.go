@@ -100,6 +100,7 @@ func TestAccEMRManagedScalingPolicy_ComputeLimits_maximumCoreCapacityUnits(t *te
 				Config: testAccManagedScalingPolicyConfig_computeLimitsMaximumCoreCapacityUnits(rName, 2),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckManagedScalingPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compute_limits.0.maximum_core_capacity_units", "2"),
 				),
 			},
 			{
@@ -107,6 +108,8 @@ func TestAccEMRManagedScalingPolicy_ComputeLimits_maximumCoreCapacityUnits(t *te
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
+					// Not returned by the API when equal to maximum_capacity_units.
+					"compute_limits.0.maximum_core_capacity_units",
 					"compute_limits.0.maximum_ondemand_capacity_units",
 				},
 			},
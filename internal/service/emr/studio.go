@@ -70,6 +70,20 @@ func resourceStudio() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"idc_instance_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"idc_user_assignment": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.IdcUserAssignment](),
+			},
 			"idp_auth_url": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -100,6 +114,12 @@ func resourceStudio() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trusted_identity_propagation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"user_role": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -149,6 +169,14 @@ func resourceStudioCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.EncryptionKeyArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("idc_instance_arn"); ok {
+		input.IdcInstanceArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idc_user_assignment"); ok {
+		input.IdcUserAssignment = awstypes.IdcUserAssignment(v.(string))
+	}
+
 	if v, ok := d.GetOk("idp_auth_url"); ok {
 		input.IdpAuthUrl = aws.String(v.(string))
 	}
@@ -157,6 +185,10 @@ func resourceStudioCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.IdpRelayStateParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("trusted_identity_propagation_enabled"); ok {
+		input.TrustedIdentityPropagationEnabled = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("user_role"); ok {
 		input.UserRole = aws.String(v.(string))
 	}
@@ -207,11 +239,14 @@ func resourceStudioRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrDescription, studio.Description)
 	d.Set("encryption_key_arn", studio.EncryptionKeyArn)
 	d.Set("engine_security_group_id", studio.EngineSecurityGroupId)
+	d.Set("idc_instance_arn", studio.IdcInstanceArn)
+	d.Set("idc_user_assignment", studio.IdcUserAssignment)
 	d.Set("idp_auth_url", studio.IdpAuthUrl)
 	d.Set("idp_relay_state_parameter_name", studio.IdpRelayStateParameterName)
 	d.Set(names.AttrName, studio.Name)
 	d.Set(names.AttrServiceRole, studio.ServiceRole)
 	d.Set(names.AttrSubnetIDs, studio.SubnetIds)
+	d.Set("trusted_identity_propagation_enabled", studio.TrustedIdentityPropagationEnabled)
 	d.Set(names.AttrURL, studio.Url)
 	d.Set("user_role", studio.UserRole)
 	d.Set(names.AttrVPCID, studio.VpcId)
@@ -94,7 +94,20 @@ func resourceStudioSessionMappingCreate(ctx context.Context, d *schema.ResourceD
 		idOrName = v.(string)
 	}
 
-	_, err := conn.CreateStudioSessionMapping(ctx, input)
+	// Identity Center users and groups can take some time to become resolvable by name or ID after creation.
+	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateStudioSessionMapping(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "does not exist") &&
+				!errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "Studio does not exist") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating EMR Studio Session Mapping: %s", err)
@@ -109,7 +122,9 @@ func resourceStudioSessionMappingRead(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRClient(ctx)
 
-	mapping, err := findStudioSessionMappingByIDOrName(ctx, conn, d.Id())
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findStudioSessionMappingByIDOrName(ctx, conn, d.Id())
+	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EMR Studio Session Mapping (%s) not found, removing from state", d.Id())
@@ -121,6 +136,7 @@ func resourceStudioSessionMappingRead(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "reading EMR Studio Session Mapping (%s): %s", d.Id(), err)
 	}
 
+	mapping := outputRaw.(*awstypes.SessionMappingDetail)
 	d.Set("identity_id", mapping.IdentityId)
 	d.Set("identity_name", mapping.IdentityName)
 	d.Set("identity_type", mapping.IdentityType)
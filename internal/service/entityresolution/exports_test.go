@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+// Exports for use in tests only.
+var (
+	ResourceMatchingWorkflow = newMatchingWorkflowResource
+	ResourceSchemaMapping    = newSchemaMappingResource
+
+	FindMatchingWorkflowByName = findMatchingWorkflowByName
+	FindSchemaMappingByName    = findSchemaMappingByName
+)
@@ -724,6 +724,10 @@ func waitKxClusterCreated(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
+		if out.Status == types.KxClusterStatusCreateFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+		}
+
 		return out, err
 	}
 
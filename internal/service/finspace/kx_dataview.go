@@ -357,6 +357,10 @@ func waitKxDataviewCreated(ctx context.Context, conn *finspace.Client, id string
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
+		if out.Status == types.KxDataviewStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+		}
+
 		return out, err
 	}
 	return nil, err
@@ -374,6 +378,10 @@ func waitKxDataviewUpdated(ctx context.Context, conn *finspace.Client, id string
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if out, ok := outputRaw.(*finspace.GetKxDataviewOutput); ok {
+		if out.Status == types.KxDataviewStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+		}
+
 		return out, err
 	}
 	return nil, err
@@ -254,6 +254,10 @@ func waitKxScalingGroupCreated(ctx context.Context, conn *finspace.Client, id st
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxScalingGroupOutput); ok {
+		if out.Status == types.KxScalingGroupStatusCreateFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+		}
+
 		return out, err
 	}
 
@@ -93,6 +93,10 @@ func ResourceJob() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.ExecutionClass](),
+				// The execution class of streaming jobs is not returned by the API.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && new != "" && d.Get("command.0.name").(string) == "gluestreaming"
+				},
 			},
 			"execution_property": {
 				Type:     schema.TypeList,
@@ -172,6 +176,55 @@ func ResourceJob() *schema.Resource {
 				Required:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"source_control_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.SourceControlAuthStrategy](),
+						},
+						"auth_token": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"branch": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"folder": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"last_commit_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						names.AttrOwner: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"provider": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.SourceControlProvider](),
+						},
+						"repository": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrTimeout: {
@@ -265,6 +318,10 @@ func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.SecurityConfiguration = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("source_control_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceControlDetails = expandSourceControlDetails(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrTimeout); ok {
 		input.Timeout = aws.Int32(int32(v.(int)))
 	}
@@ -333,6 +390,9 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("number_of_workers", job.NumberOfWorkers)
 	d.Set(names.AttrRoleARN, job.Role)
 	d.Set("security_configuration", job.SecurityConfiguration)
+	if err := d.Set("source_control_details", flattenSourceControlDetails(d, job.SourceControlDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_control_details: %s", err)
+	}
 	d.Set(names.AttrTimeout, job.Timeout)
 	d.Set("worker_type", job.WorkerType)
 
@@ -375,7 +435,7 @@ func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 			jobUpdate.GlueVersion = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOk("job_run_queuing_enabled"); ok {
+		if v, ok := d.GetOk("job_run_queuing_enabled"); ok || d.HasChange("job_run_queuing_enabled") {
 			jobUpdate.JobRunQueuingEnabled = aws.Bool(v.(bool))
 		}
 
@@ -407,6 +467,10 @@ func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 			jobUpdate.SecurityConfiguration = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("source_control_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			jobUpdate.SourceControlDetails = expandSourceControlDetails(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk(names.AttrTimeout); ok {
 			jobUpdate.Timeout = aws.Int32(int32(v.(int)))
 		}
@@ -539,3 +603,68 @@ func flattenNotificationProperty(notificationProperty *awstypes.NotificationProp
 
 	return []map[string]interface{}{m}
 }
+
+func expandSourceControlDetails(tfMap map[string]interface{}) *awstypes.SourceControlDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.SourceControlDetails{}
+
+	if v, ok := tfMap["auth_strategy"].(string); ok && v != "" {
+		apiObject.AuthStrategy = awstypes.SourceControlAuthStrategy(v)
+	}
+
+	if v, ok := tfMap["auth_token"].(string); ok && v != "" {
+		apiObject.AuthToken = aws.String(v)
+	}
+
+	if v, ok := tfMap["branch"].(string); ok && v != "" {
+		apiObject.Branch = aws.String(v)
+	}
+
+	if v, ok := tfMap["folder"].(string); ok && v != "" {
+		apiObject.Folder = aws.String(v)
+	}
+
+	if v, ok := tfMap["last_commit_id"].(string); ok && v != "" {
+		apiObject.LastCommitId = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrOwner].(string); ok && v != "" {
+		apiObject.Owner = aws.String(v)
+	}
+
+	if v, ok := tfMap["provider"].(string); ok && v != "" {
+		apiObject.Provider = awstypes.SourceControlProvider(v)
+	}
+
+	if v, ok := tfMap["repository"].(string); ok && v != "" {
+		apiObject.Repository = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceControlDetails(d *schema.ResourceData, apiObject *awstypes.SourceControlDetails) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"auth_strategy":  string(apiObject.AuthStrategy),
+		"branch":         aws.ToString(apiObject.Branch),
+		"folder":         aws.ToString(apiObject.Folder),
+		"last_commit_id": aws.ToString(apiObject.LastCommitId),
+		names.AttrOwner:  aws.ToString(apiObject.Owner),
+		"provider":       string(apiObject.Provider),
+		"repository":     aws.ToString(apiObject.Repository),
+	}
+
+	// The auth token is not returned by the API.
+	if v, ok := d.GetOk("source_control_details.0.auth_token"); ok {
+		tfMap["auth_token"] = v.(string)
+	}
+
+	return []interface{}{tfMap}
+}
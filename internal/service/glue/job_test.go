@@ -347,6 +347,33 @@ func TestAccGlueJob_executionClass(t *testing.T) {
 	})
 }
 
+func TestAccGlueJob_executionClassStreaming(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job awstypes.Job
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_executionClassStreaming(rName, "FLEX"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "command.0.name", "gluestreaming"),
+				),
+			},
+			{
+				Config:   testAccJobConfig_executionClassStreaming(rName, "FLEX"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccGlueJob_executionProperty(t *testing.T) {
 	ctx := acctest.Context(t)
 	var job awstypes.Job
@@ -580,6 +607,46 @@ func TestAccGlueJob_tags(t *testing.T) {
 	})
 }
 
+func TestAccGlueJob_sourceControlDetails(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job awstypes.Job
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_sourceControlDetails(rName, "main"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "source_control_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_control_details.0.branch", "main"),
+					resource.TestCheckResourceAttr(resourceName, "source_control_details.0.folder", "jobs"),
+					resource.TestCheckResourceAttr(resourceName, "source_control_details.0.provider", "AWS_CODE_COMMIT"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_control_details.0.repository", "aws_codecommit_repository.test", "repository_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobConfig_sourceControlDetails(rName, "develop"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "source_control_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_control_details.0.branch", "develop"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueJob_streamingTimeout(t *testing.T) {
 	ctx := acctest.Context(t)
 	var job awstypes.Job
@@ -1088,6 +1155,26 @@ resource "aws_glue_job" "test" {
 `, rName, executionClass))
 }
 
+func testAccJobConfig_executionClassStreaming(rName, executionClass string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  execution_class   = %[2]q
+  name              = %[1]q
+  number_of_workers = 2
+  role_arn          = aws_iam_role.test.arn
+  worker_type       = "G.1X"
+  glue_version      = "4.0"
+
+  command {
+    name            = "gluestreaming"
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, executionClass))
+}
+
 func testAccJobConfig_executionProperty(rName string, maxConcurrentRuns int) string {
 	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
 resource "aws_glue_job" "test" {
@@ -1212,6 +1299,33 @@ resource "aws_glue_job" "test" {
 `, rName, jobRunQueuingEnabled))
 }
 
+func testAccJobConfig_sourceControlDetails(rName, branch string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_codecommit_repository" "test" {
+  repository_name = %[1]q
+}
+
+resource "aws_glue_job" "test" {
+  max_capacity = 10
+  name         = %[1]q
+  role_arn     = aws_iam_role.test.arn
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  source_control_details {
+    branch     = %[2]q
+    folder     = "jobs"
+    provider   = "AWS_CODE_COMMIT"
+    repository = aws_codecommit_repository.test.repository_name
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, branch))
+}
+
 func testAccJobConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
 resource "aws_glue_job" "test" {
@@ -110,7 +110,7 @@ func ResourceTrigger() *schema.Resource {
 			"event_batching_condition": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MinItems: 1,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"batch_size": {
@@ -217,11 +217,10 @@ func resourceTriggerCreate(ctx context.Context, d *schema.ResourceData, meta int
 	name := d.Get(names.AttrName).(string)
 	triggerType := d.Get(names.AttrType).(string)
 	input := &glue.CreateTriggerInput{
-		Actions:         expandActions(d.Get(names.AttrActions).([]interface{})),
-		Name:            aws.String(name),
-		Tags:            getTagsIn(ctx),
-		Type:            awstypes.TriggerType(triggerType),
-		StartOnCreation: d.Get("start_on_creation").(bool),
+		Actions: expandActions(d.Get(names.AttrActions).([]interface{})),
+		Name:    aws.String(name),
+		Tags:    getTagsIn(ctx),
+		Type:    awstypes.TriggerType(triggerType),
 	}
 
 	if v, ok := d.GetOk(names.AttrDescription); ok {
@@ -244,22 +243,12 @@ func resourceTriggerCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.WorkflowName = aws.String(v.(string))
 	}
 
-	if d.Get(names.AttrEnabled).(bool) && triggerType != string(awstypes.TriggerTypeOnDemand) {
-		start := true
-
-		if triggerType == string(awstypes.TriggerTypeEvent) {
-			start = false
-		}
-
-		input.StartOnCreation = start
-	}
-
-	if v, ok := d.GetOk("workflow_name"); ok {
-		input.WorkflowName = aws.String(v.(string))
-	}
-
-	if v, ok := d.GetOk("start_on_creation"); ok {
-		input.StartOnCreation = v.(bool)
+	// SCHEDULED and CONDITIONAL triggers are started on creation when enabled.
+	// An explicitly configured start_on_creation takes precedence, which allows EVENT triggers to be started too.
+	if v := d.GetRawConfig().GetAttr("start_on_creation"); v.IsKnown() && !v.IsNull() {
+		input.StartOnCreation = v.True()
+	} else if d.Get(names.AttrEnabled).(bool) {
+		input.StartOnCreation = triggerType == string(awstypes.TriggerTypeScheduled) || triggerType == string(awstypes.TriggerTypeConditional)
 	}
 
 	log.Printf("[DEBUG] Creating Glue Trigger: %+v", input)
@@ -628,6 +617,11 @@ func flattenConditions(conditions []awstypes.Condition) []interface{} {
 			"logical_operator": string(condition.LogicalOperator),
 		}
 
+		// The logical operator is not always returned for crawler conditions.
+		if condition.LogicalOperator == "" {
+			m["logical_operator"] = string(awstypes.LogicalOperatorEquals)
+		}
+
 		if v := aws.ToString(condition.CrawlerName); v != "" {
 			m["crawler_name"] = v
 		}
@@ -660,6 +654,11 @@ func flattenPredicate(predicate *awstypes.Predicate) []map[string]interface{} {
 		"logical":    string(predicate.Logical),
 	}
 
+	// The logical operator is not returned for single-condition predicates.
+	if predicate.Logical == "" {
+		m["logical"] = string(awstypes.LogicalAnd)
+	}
+
 	return []map[string]interface{}{m}
 }
 
@@ -579,6 +579,54 @@ func TestAccGlueTrigger_eventBatchingCondition(t *testing.T) {
 	})
 }
 
+func TestAccGlueTrigger_eventBatchingConditionWorkflow(t *testing.T) {
+	ctx := acctest.Context(t)
+	var trigger awstypes.Trigger
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_trigger.test"
+	conditionalResourceName := "aws_glue_trigger.conditional"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTriggerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTriggerConfig_eventWorkflow(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTriggerExists(ctx, resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "EVENT"),
+					resource.TestCheckResourceAttr(resourceName, "event_batching_condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_batching_condition.0.batch_size", "5"),
+					resource.TestCheckResourceAttr(resourceName, "event_batching_condition.0.batch_window", "900"),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow_name", "aws_glue_workflow.test", names.AttrName),
+					testAccCheckTriggerExists(ctx, conditionalResourceName, &trigger),
+					resource.TestCheckResourceAttr(conditionalResourceName, "predicate.#", "1"),
+					resource.TestCheckResourceAttr(conditionalResourceName, "predicate.0.logical", "AND"),
+					resource.TestCheckResourceAttr(conditionalResourceName, "predicate.0.conditions.#", "1"),
+					resource.TestCheckResourceAttrPair(conditionalResourceName, "predicate.0.conditions.0.crawler_name", "aws_glue_crawler.test", names.AttrName),
+					resource.TestCheckResourceAttr(conditionalResourceName, "predicate.0.conditions.0.crawl_state", "SUCCEEDED"),
+					resource.TestCheckResourceAttr(conditionalResourceName, "predicate.0.conditions.0.logical_operator", "EQUALS"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrEnabled, "start_on_creation"},
+			},
+			{
+				ResourceName:            conditionalResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrEnabled},
+			},
+		},
+	})
+}
+
 func TestAccGlueTrigger_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var trigger awstypes.Trigger
@@ -965,6 +1013,62 @@ resource "aws_glue_trigger" "test" {
 `, rName))
 }
 
+func testAccTriggerConfig_eventWorkflow(rName string) string {
+	return acctest.ConfigCompose(testAccCrawlerConfig_s3Target(rName, "bucket1"), fmt.Sprintf(`
+resource "aws_s3_bucket" "test2" {
+  bucket = %[1]q
+}
+
+resource "aws_glue_crawler" "test2" {
+  depends_on = [aws_iam_role_policy_attachment.test-AWSGlueServiceRole]
+
+  database_name = aws_glue_catalog_database.test.name
+  name          = "%[1]scrawl2"
+  role          = aws_iam_role.test.name
+
+  s3_target {
+    path = "s3://${aws_s3_bucket.test2.bucket}"
+  }
+}
+
+resource "aws_glue_workflow" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_trigger" "test" {
+  name          = %[1]q
+  type          = "EVENT"
+  workflow_name = aws_glue_workflow.test.name
+
+  actions {
+    crawler_name = aws_glue_crawler.test.name
+  }
+
+  event_batching_condition {
+    batch_size   = 5
+    batch_window = 900
+  }
+}
+
+resource "aws_glue_trigger" "conditional" {
+  name          = "%[1]s-conditional"
+  type          = "CONDITIONAL"
+  workflow_name = aws_glue_workflow.test.name
+
+  actions {
+    crawler_name = aws_glue_crawler.test2.name
+  }
+
+  predicate {
+    conditions {
+      crawler_name = aws_glue_crawler.test.name
+      crawl_state  = "SUCCEEDED"
+    }
+  }
+}
+`, rName))
+}
+
 func testAccTriggerConfig_actionsNull(rName string) string {
 	return acctest.ConfigCompose(testAccJobConfig_required(rName), fmt.Sprintf(`
 resource "aws_glue_trigger" "test" {
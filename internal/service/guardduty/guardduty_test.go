@@ -82,6 +82,7 @@ func TestAccGuardDuty_serial(t *testing.T) {
 		"PublishingDestination": {
 			acctest.CtBasic:      testAccPublishingDestination_basic,
 			acctest.CtDisappears: testAccPublishingDestination_disappears,
+			"kmsKeyUpdate":       testAccPublishingDestination_kmsKeyUpdate,
 		},
 	}
 
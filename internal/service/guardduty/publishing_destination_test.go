@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -54,6 +55,43 @@ func testAccPublishingDestination_basic(t *testing.T) {
 	})
 }
 
+func testAccPublishingDestination_kmsKeyUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_publishing_destination.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDetectorNotExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPublishingDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublishingDestinationConfig_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublishingDestinationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyARN, "aws_kms_key.gd_key", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccPublishingDestinationConfig_kmsKeyUpdated(bucketName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublishingDestinationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyARN, "aws_kms_key.gd_key2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccPublishingDestination_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_guardduty_publishing_destination.test"
@@ -80,7 +118,7 @@ func testAccPublishingDestination_disappears(t *testing.T) {
 	})
 }
 
-func testAccPublishingDestinationConfig_basic(bucketName string) string {
+func testAccPublishingDestinationConfig_base(bucketName string) string {
 	return fmt.Sprintf(`
 
 data "aws_caller_identity" "current" {}
@@ -178,7 +216,11 @@ resource "aws_kms_key" "gd_key" {
   deletion_window_in_days = 7
   policy                  = data.aws_iam_policy_document.kms_pol.json
 }
+`, bucketName)
+}
 
+func testAccPublishingDestinationConfig_basic(bucketName string) string {
+	return acctest.ConfigCompose(testAccPublishingDestinationConfig_base(bucketName), `
 resource "aws_guardduty_publishing_destination" "test" {
   detector_id     = aws_guardduty_detector.test_gd.id
   destination_arn = aws_s3_bucket.gd_bucket.arn
@@ -187,7 +229,28 @@ resource "aws_guardduty_publishing_destination" "test" {
   depends_on = [
     aws_s3_bucket_policy.gd_bucket_policy,
   ]
-}`, bucketName)
+}
+`)
+}
+
+func testAccPublishingDestinationConfig_kmsKeyUpdated(bucketName string) string {
+	return acctest.ConfigCompose(testAccPublishingDestinationConfig_base(bucketName), `
+resource "aws_kms_key" "gd_key2" {
+  description             = "Temporary key for AccTest of TF"
+  deletion_window_in_days = 7
+  policy                  = data.aws_iam_policy_document.kms_pol.json
+}
+
+resource "aws_guardduty_publishing_destination" "test" {
+  detector_id     = aws_guardduty_detector.test_gd.id
+  destination_arn = aws_s3_bucket.gd_bucket.arn
+  kms_key_arn     = aws_kms_key.gd_key2.arn
+
+  depends_on = [
+    aws_s3_bucket_policy.gd_bucket_policy,
+  ]
+}
+`)
 }
 
 func testAccCheckPublishingDestinationExists(ctx context.Context, name string) resource.TestCheckFunc {
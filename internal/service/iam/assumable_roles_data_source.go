@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	assumableRolesDefaultMaxConcurrency = 5
+	assumableRolesDurationSeconds       = 900
+	assumableRolesSessionName           = "terraform-assumable-roles"
+
+	assumableRoleReasonAccessDenied = "AccessDenied"
+	assumableRoleReasonExplicitDeny = "ExplicitDeny"
+	assumableRoleReasonRoleNotFound = "RoleNotFound"
+)
+
+// @SDKDataSource("aws_iam_assumable_roles", name="Assumable Roles")
+func dataSourceAssumableRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAssumableRolesRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidAccountID},
+				ExactlyOneOf: []string{"account_ids", "role_arns"},
+				RequiredWith: []string{"role_name"},
+			},
+			"assumable_role_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      assumableRolesDefaultMaxConcurrency,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assumable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidARN},
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"account_ids"},
+			},
+		},
+	}
+}
+
+type assumableRoleResult struct {
+	assumable bool
+	reason    string
+	roleARN   string
+}
+
+func dataSourceAssumableRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.STSClient(ctx)
+	iamConn := c.IAMClient(ctx)
+
+	var roleARNs []string
+	if v, ok := d.GetOk("role_arns"); ok && v.(*schema.Set).Len() > 0 {
+		roleARNs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("account_ids"); ok && v.(*schema.Set).Len() > 0 {
+		roleName := d.Get("role_name").(string)
+		for _, accountID := range flex.ExpandStringValueSet(v.(*schema.Set)) {
+			roleARNs = append(roleARNs, arn.ARN{
+				Partition: c.Partition(ctx),
+				Service:   "iam",
+				AccountID: accountID,
+				Resource:  "role/" + roleName,
+			}.String())
+		}
+	}
+	slices.Sort(roleARNs)
+
+	results := make([]assumableRoleResult, len(roleARNs))
+	errs := make([]error, len(roleARNs))
+	sem := make(chan struct{}, d.Get("max_concurrency").(int))
+	var wg sync.WaitGroup
+
+	for i, roleARN := range roleARNs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i], errs[i] = checkRoleAssumable(ctx, conn, iamConn, c.AccountID(ctx), roleARN)
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return sdkdiag.AppendErrorf(diags, "checking IAM Roles assumability: %s", err)
+	}
+
+	d.SetId(c.AccountID(ctx))
+
+	assumableRoleARNs := make([]string, 0)
+	for _, v := range results {
+		if v.assumable {
+			assumableRoleARNs = append(assumableRoleARNs, v.roleARN)
+		}
+	}
+	d.Set("assumable_role_arns", assumableRoleARNs)
+	if err := d.Set("results", flattenAssumableRoleResults(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	return diags
+}
+
+// checkRoleAssumable attempts to assume the specified role.
+// The temporary credentials returned on success are discarded.
+func checkRoleAssumable(ctx context.Context, conn *sts.Client, iamConn *iam.Client, callerAccountID, roleARN string) (assumableRoleResult, error) {
+	result := assumableRoleResult{
+		roleARN: roleARN,
+	}
+
+	input := &sts.AssumeRoleInput{
+		DurationSeconds: aws.Int32(assumableRolesDurationSeconds),
+		RoleArn:         aws.String(roleARN),
+		RoleSessionName: aws.String(assumableRolesSessionName),
+	}
+
+	_, err := conn.AssumeRole(ctx, input)
+
+	switch {
+	case err == nil:
+		result.assumable = true
+		return result, nil
+	case tfawserr.ErrMessageContains(err, errCodeAccessDenied, "explicit deny"):
+		result.reason = assumableRoleReasonExplicitDeny
+		return result, nil
+	case tfawserr.ErrCodeEquals(err, errCodeAccessDenied):
+		result.reason = assumableRoleReasonAccessDenied
+	default:
+		return result, fmt.Errorf("assuming IAM Role (%s): %w", roleARN, err)
+	}
+
+	// AssumeRole does not distinguish a missing role from one that cannot be assumed.
+	// Roles in the caller's account can be looked up directly.
+	if v, err := arn.Parse(roleARN); err == nil && v.AccountID == callerAccountID {
+		name := v.Resource[strings.LastIndex(v.Resource, "/")+1:]
+		if _, err := findRoleByName(ctx, iamConn, name); tfresource.NotFound(err) {
+			result.reason = assumableRoleReasonRoleNotFound
+		}
+	}
+
+	return result, nil
+}
+
+func flattenAssumableRoleResults(apiObjects []assumableRoleResult) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"assumable": apiObject.assumable,
+			"reason":    apiObject.reason,
+			"role_arn":  apiObject.roleARN,
+		})
+	}
+
+	return tfList
+}
@@ -70,6 +70,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iam_account_alias",
 			Name:     "Account Alias",
 		},
+		{
+			Factory:  dataSourceAssumableRoles,
+			TypeName: "aws_iam_assumable_roles",
+			Name:     "Assumable Roles",
+		},
 		{
 			Factory:  dataSourceGroup,
 			TypeName: "aws_iam_group",
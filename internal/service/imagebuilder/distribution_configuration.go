@@ -309,6 +309,29 @@ func resourceDistributionConfiguration() *schema.Resource {
 								},
 							},
 						},
+						"ssm_parameter_configuration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ami_account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"data_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.SsmParameterDataType](),
+									},
+									"parameter_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1011),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -577,6 +600,10 @@ func expandDistribution(tfMap map[string]interface{}) *awstypes.Distribution {
 		apiObject.S3ExportConfiguration = expandS3ExportConfiguration(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := tfMap["ssm_parameter_configuration"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SsmParameterConfigurations = expandSSMParameterConfigurations(v.List())
+	}
+
 	return apiObject
 }
 
@@ -898,6 +925,10 @@ func flattenDistribution(apiObject awstypes.Distribution) map[string]interface{}
 		tfMap["s3_export_configuration"] = []interface{}{flattenS3ExportConfiguration(v)}
 	}
 
+	if v := apiObject.SsmParameterConfigurations; v != nil {
+		tfMap["ssm_parameter_configuration"] = flattenSSMParameterConfigurations(v)
+	}
+
 	return tfMap
 }
 
@@ -1070,3 +1101,81 @@ func flattenS3ExportConfiguration(apiObject *awstypes.S3ExportConfiguration) map
 
 	return tfMap
 }
+
+func expandSSMParameterConfigurations(tfList []interface{}) []awstypes.SsmParameterConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.SsmParameterConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandSSMParameterConfiguration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSSMParameterConfiguration(tfMap map[string]interface{}) *awstypes.SsmParameterConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.SsmParameterConfiguration{}
+
+	if v, ok := tfMap["ami_account_id"].(string); ok && v != "" {
+		apiObject.AmiAccountId = aws.String(v)
+	}
+
+	if v, ok := tfMap["data_type"].(string); ok && v != "" {
+		apiObject.DataType = awstypes.SsmParameterDataType(v)
+	}
+
+	if v, ok := tfMap["parameter_name"].(string); ok && v != "" {
+		apiObject.ParameterName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSSMParameterConfigurations(apiObjects []awstypes.SsmParameterConfiguration) []interface{} {
+	if apiObjects == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenSSMParameterConfiguration(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenSSMParameterConfiguration(apiObject awstypes.SsmParameterConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"data_type": apiObject.DataType,
+	}
+
+	if v := apiObject.AmiAccountId; v != nil {
+		tfMap["ami_account_id"] = aws.ToString(v)
+	}
+
+	if v := apiObject.ParameterName; v != nil {
+		tfMap["parameter_name"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
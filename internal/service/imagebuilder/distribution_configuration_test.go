@@ -1161,6 +1161,52 @@ func TestAccImageBuilderDistributionConfiguration_DistributionS3Export_s3Prefix(
 	})
 }
 
+func TestAccImageBuilderDistributionConfiguration_Distribution_ssmParameterConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_distribution_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfigurationConfig_ssmParameterConfiguration(rName, string(awstypes.SsmParameterDataTypeAwsEc2Image)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "distribution.*", map[string]string{
+						"ssm_parameter_configuration.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "distribution.*.ssm_parameter_configuration.*", map[string]string{
+						"data_type":      string(awstypes.SsmParameterDataTypeAwsEc2Image),
+						"parameter_name": "/" + rName,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDistributionConfigurationConfig_ssmParameterConfiguration(rName, string(awstypes.SsmParameterDataTypeText)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceAttrRFC3339(resourceName, "date_updated"),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "distribution.*.ssm_parameter_configuration.*", map[string]string{
+						"data_type":      string(awstypes.SsmParameterDataTypeText),
+						"parameter_name": "/" + rName,
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccImageBuilderDistributionConfiguration_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1924,6 +1970,29 @@ resource "aws_imagebuilder_distribution_configuration" "test" {
 `, rName, s3Prefix)
 }
 
+func testAccDistributionConfigurationConfig_ssmParameterConfiguration(rName, dataType string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name = %[1]q
+
+  distribution {
+    ami_distribution_configuration {
+      name = "{{ imagebuilder:buildDate }}"
+    }
+
+    ssm_parameter_configuration {
+      data_type      = %[2]q
+      parameter_name = "/%[1]s"
+    }
+
+    region = data.aws_region.current.name
+  }
+}
+`, rName, dataType)
+}
+
 func testAccDistributionConfigurationConfig_tags1(rName string, tagKey1 string, tagValue1 string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
@@ -6,10 +6,12 @@ package inspector2
 // Exports for use in tests only.
 var (
 	ResourceDelegatedAdminAccount     = resourceDelegatedAdminAccount
+	ResourceFilter                    = newFilterResource
 	ResourceMemberAssociation         = resourceMemberAssociation
 	ResourceOrganizationConfiguration = resourceOrganizationConfiguration
 
 	FindDelegatedAdminAccountByID = findDelegatedAdminAccountByID
+	FindFilterByARN               = findFilterByARN
 	FindMemberByAccountID         = findMemberByAccountID
 	FindOrganizationConfiguration = findOrganizationConfiguration
 
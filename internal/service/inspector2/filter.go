@@ -0,0 +1,559 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_inspector2_filter", name="Filter")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newFilterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &filterResource{}
+
+	return r, nil
+}
+
+type filterResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *filterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAction: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FilterAction](),
+				Required:   true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"filter_criteria": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[filterCriteriaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_account_id":                     stringFilterSchemaBlock(ctx),
+						"code_vulnerability_detector_name":   stringFilterSchemaBlock(ctx),
+						"code_vulnerability_detector_tags":   stringFilterSchemaBlock(ctx),
+						"code_vulnerability_file_path":       stringFilterSchemaBlock(ctx),
+						"component_id":                       stringFilterSchemaBlock(ctx),
+						"component_type":                     stringFilterSchemaBlock(ctx),
+						"ec2_instance_image_id":              stringFilterSchemaBlock(ctx),
+						"ec2_instance_subnet_id":             stringFilterSchemaBlock(ctx),
+						"ec2_instance_vpc_id":                stringFilterSchemaBlock(ctx),
+						"ecr_image_architecture":             stringFilterSchemaBlock(ctx),
+						"ecr_image_hash":                     stringFilterSchemaBlock(ctx),
+						"ecr_image_pushed_at":                dateFilterSchemaBlock(ctx),
+						"ecr_image_registry":                 stringFilterSchemaBlock(ctx),
+						"ecr_image_repository_name":          stringFilterSchemaBlock(ctx),
+						"ecr_image_tags":                     stringFilterSchemaBlock(ctx),
+						"epss_score":                         numberFilterSchemaBlock(ctx),
+						"exploit_available":                  stringFilterSchemaBlock(ctx),
+						"finding_arn":                        stringFilterSchemaBlock(ctx),
+						"finding_status":                     stringFilterSchemaBlock(ctx),
+						"finding_type":                       stringFilterSchemaBlock(ctx),
+						"first_observed_at":                  dateFilterSchemaBlock(ctx),
+						"fix_available":                      stringFilterSchemaBlock(ctx),
+						"inspector_score":                    numberFilterSchemaBlock(ctx),
+						"lambda_function_execution_role_arn": stringFilterSchemaBlock(ctx),
+						"lambda_function_last_modified_at":   dateFilterSchemaBlock(ctx),
+						"lambda_function_layers":             stringFilterSchemaBlock(ctx),
+						"lambda_function_name":               stringFilterSchemaBlock(ctx),
+						"lambda_function_runtime":            stringFilterSchemaBlock(ctx),
+						"last_observed_at":                   dateFilterSchemaBlock(ctx),
+						"network_protocol":                   stringFilterSchemaBlock(ctx),
+						"port_range":                         portRangeFilterSchemaBlock(ctx),
+						"related_vulnerabilities":            stringFilterSchemaBlock(ctx),
+						names.AttrResourceID:                 stringFilterSchemaBlock(ctx),
+						"resource_tags":                      mapFilterSchemaBlock(ctx),
+						names.AttrResourceType:               stringFilterSchemaBlock(ctx),
+						"severity":                           stringFilterSchemaBlock(ctx),
+						"title":                              stringFilterSchemaBlock(ctx),
+						"updated_at":                         dateFilterSchemaBlock(ctx),
+						"vendor_severity":                    stringFilterSchemaBlock(ctx),
+						"vulnerability_id":                   stringFilterSchemaBlock(ctx),
+						"vulnerability_source":               stringFilterSchemaBlock(ctx),
+						"vulnerable_packages":                packageFilterSchemaBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func stringFilterSchemaBlock(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[stringFilterModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: stringFilterSchemaAttributes(),
+		},
+	}
+}
+
+func stringFilterSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"comparison": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.StringComparison](),
+			Required:   true,
+		},
+		names.AttrValue: schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func dateFilterSchemaBlock(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[dateFilterModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"end_inclusive": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+				"start_inclusive": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+			},
+		},
+	}
+}
+
+func numberFilterSchemaBlock(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[numberFilterModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: numberFilterSchemaAttributes(),
+		},
+	}
+}
+
+func numberFilterSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"lower_inclusive": schema.Float64Attribute{
+			Optional: true,
+		},
+		"upper_inclusive": schema.Float64Attribute{
+			Optional: true,
+		},
+	}
+}
+
+func mapFilterSchemaBlock(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[mapFilterModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.MapComparison](),
+					Required:   true,
+				},
+				names.AttrKey: schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 128),
+					},
+				},
+				names.AttrValue: schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(0, 256),
+					},
+				},
+			},
+		},
+	}
+}
+
+func portRangeFilterSchemaBlock(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[portRangeFilterModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"begin_inclusive": schema.Int64Attribute{
+					Optional: true,
+				},
+				"end_inclusive": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func packageFilterSchemaBlock(ctx context.Context) schema.SetNestedBlock {
+	nestedStringFilterBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[stringFilterModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: stringFilterSchemaAttributes(),
+			},
+		}
+	}
+
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[packageFilterModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"architecture": nestedStringFilterBlock(),
+				"epoch": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[numberFilterModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: numberFilterSchemaAttributes(),
+					},
+				},
+				"file_path":               nestedStringFilterBlock(),
+				names.AttrName:            nestedStringFilterBlock(),
+				"release":                 nestedStringFilterBlock(),
+				"source_lambda_layer_arn": nestedStringFilterBlock(),
+				"source_layer_hash":       nestedStringFilterBlock(),
+				names.AttrVersion:         nestedStringFilterBlock(),
+			},
+		},
+	}
+}
+
+func (r *filterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	name := data.Name.ValueString()
+	var input inspector2.CreateFilterInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFilter(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Inspector2 Filter (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *filterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	arn := data.ARN.ValueString()
+	output, err := findFilterByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 Filter (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Filter"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *filterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new filterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	if !new.Action.Equal(old.Action) ||
+		!new.Description.Equal(old.Description) ||
+		!new.FilterCriteria.Equal(old.FilterCriteria) ||
+		!new.Name.Equal(old.Name) ||
+		!new.Reason.Equal(old.Reason) {
+		arn := new.ARN.ValueString()
+		var input inspector2.UpdateFilterInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FilterArn = fwflex.StringFromFramework(ctx, new.ARN)
+
+		_, err := conn.UpdateFilter(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Inspector2 Filter (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *filterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	arn := data.ARN.ValueString()
+	input := inspector2.DeleteFilterInput{
+		Arn: fwflex.StringFromFramework(ctx, data.ARN),
+	}
+	_, err := conn.DeleteFilter(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Inspector2 Filter (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *filterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func (r *filterResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.Filter, error) {
+	input := inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	return findFilter(ctx, conn, &input)
+}
+
+func findFilter(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) (*awstypes.Filter, error) {
+	output, err := findFilters(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findFilters(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) ([]awstypes.Filter, error) {
+	var output []awstypes.Filter
+
+	pages := inspector2.NewListFiltersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Filters...)
+	}
+
+	return output, nil
+}
+
+type filterResourceModel struct {
+	Action         fwtypes.StringEnum[awstypes.FilterAction]            `tfsdk:"action"`
+	ARN            types.String                                         `tfsdk:"arn"`
+	Description    types.String                                         `tfsdk:"description"`
+	FilterCriteria fwtypes.ListNestedObjectValueOf[filterCriteriaModel] `tfsdk:"filter_criteria"`
+	Name           types.String                                         `tfsdk:"name"`
+	Reason         types.String                                         `tfsdk:"reason"`
+	Tags           tftags.Map                                           `tfsdk:"tags"`
+	TagsAll        tftags.Map                                           `tfsdk:"tags_all"`
+}
+
+type filterCriteriaModel struct {
+	AWSAccountID                   fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"aws_account_id"`
+	CodeVulnerabilityDetectorName  fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_detector_name"`
+	CodeVulnerabilityDetectorTags  fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_detector_tags"`
+	CodeVulnerabilityFilePath      fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_file_path"`
+	ComponentID                    fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"component_id"`
+	ComponentType                  fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"component_type"`
+	EC2InstanceImageID             fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_image_id"`
+	EC2InstanceSubnetID            fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_subnet_id"`
+	EC2InstanceVPCID               fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_vpc_id"`
+	ECRImageArchitecture           fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_architecture"`
+	ECRImageHash                   fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_hash"`
+	ECRImagePushedAt               fwtypes.SetNestedObjectValueOf[dateFilterModel]      `tfsdk:"ecr_image_pushed_at"`
+	ECRImageRegistry               fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_registry"`
+	ECRImageRepositoryName         fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_repository_name"`
+	ECRImageTags                   fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_tags"`
+	EPSSScore                      fwtypes.SetNestedObjectValueOf[numberFilterModel]    `tfsdk:"epss_score"`
+	ExploitAvailable               fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"exploit_available"`
+	FindingARN                     fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_arn"`
+	FindingStatus                  fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_status"`
+	FindingType                    fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_type"`
+	FirstObservedAt                fwtypes.SetNestedObjectValueOf[dateFilterModel]      `tfsdk:"first_observed_at"`
+	FixAvailable                   fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"fix_available"`
+	InspectorScore                 fwtypes.SetNestedObjectValueOf[numberFilterModel]    `tfsdk:"inspector_score"`
+	LambdaFunctionExecutionRoleARN fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_execution_role_arn"`
+	LambdaFunctionLastModifiedAt   fwtypes.SetNestedObjectValueOf[dateFilterModel]      `tfsdk:"lambda_function_last_modified_at"`
+	LambdaFunctionLayers           fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_layers"`
+	LambdaFunctionName             fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_name"`
+	LambdaFunctionRuntime          fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_runtime"`
+	LastObservedAt                 fwtypes.SetNestedObjectValueOf[dateFilterModel]      `tfsdk:"last_observed_at"`
+	NetworkProtocol                fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"network_protocol"`
+	PortRange                      fwtypes.SetNestedObjectValueOf[portRangeFilterModel] `tfsdk:"port_range"`
+	RelatedVulnerabilities         fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"related_vulnerabilities"`
+	ResourceID                     fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"resource_id"`
+	ResourceTags                   fwtypes.SetNestedObjectValueOf[mapFilterModel]       `tfsdk:"resource_tags"`
+	ResourceType                   fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"resource_type"`
+	Severity                       fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"severity"`
+	Title                          fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"title"`
+	UpdatedAt                      fwtypes.SetNestedObjectValueOf[dateFilterModel]      `tfsdk:"updated_at"`
+	VendorSeverity                 fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"vendor_severity"`
+	VulnerabilityID                fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"vulnerability_id"`
+	VulnerabilitySource            fwtypes.SetNestedObjectValueOf[stringFilterModel]    `tfsdk:"vulnerability_source"`
+	VulnerablePackages             fwtypes.SetNestedObjectValueOf[packageFilterModel]   `tfsdk:"vulnerable_packages"`
+}
+
+type stringFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.StringComparison] `tfsdk:"comparison"`
+	Value      types.String                                  `tfsdk:"value"`
+}
+
+type dateFilterModel struct {
+	EndInclusive   timetypes.RFC3339 `tfsdk:"end_inclusive"`
+	StartInclusive timetypes.RFC3339 `tfsdk:"start_inclusive"`
+}
+
+type numberFilterModel struct {
+	LowerInclusive types.Float64 `tfsdk:"lower_inclusive"`
+	UpperInclusive types.Float64 `tfsdk:"upper_inclusive"`
+}
+
+type mapFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.MapComparison] `tfsdk:"comparison"`
+	Key        types.String                               `tfsdk:"key"`
+	Value      types.String                               `tfsdk:"value"`
+}
+
+type portRangeFilterModel struct {
+	BeginInclusive types.Int64 `tfsdk:"begin_inclusive"`
+	EndInclusive   types.Int64 `tfsdk:"end_inclusive"`
+}
+
+type packageFilterModel struct {
+	Architecture         fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"architecture"`
+	Epoch                fwtypes.ListNestedObjectValueOf[numberFilterModel] `tfsdk:"epoch"`
+	FilePath             fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"file_path"`
+	Name                 fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"name"`
+	Release              fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"release"`
+	SourceLambdaLayerARN fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"source_lambda_layer_arn"`
+	SourceLayerHash      fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"source_layer_hash"`
+	Version              fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"version"`
+}
@@ -31,6 +31,12 @@ func TestAccInspector2_serial(t *testing.T) {
 			acctest.CtBasic:      testAccDelegatedAdminAccount_basic,
 			acctest.CtDisappears: testAccDelegatedAdminAccount_disappears,
 		},
+		"Filter": {
+			acctest.CtBasic:      testAccFilter_basic,
+			acctest.CtDisappears: testAccFilter_disappears,
+			"criteria":           testAccFilter_criteria,
+			"tags":               testAccFilter_tags,
+		},
 		"MemberAssociation": {
 			acctest.CtBasic:      testAccMemberAssociation_basic,
 			acctest.CtDisappears: testAccMemberAssociation_disappears,
@@ -2319,6 +2319,52 @@ func TestAccIoTTopicRule_errorAction(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_timestreamErrorAction(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_timestreamErrorAction(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "error_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kinesis.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.timestream.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.timestream.0.database_name", "TestDB"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.timestream.0.dimension.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "error_action.0.timestream.0.dimension.*", map[string]string{
+						names.AttrName:  "dim1",
+						names.AttrValue: "${dim1}",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.timestream.0.table_name", "test_table"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.timestream.0.timestamp.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "kafka.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "kafka.*", map[string]string{
+						"header.#":       "1",
+						"header.0.key":   "header-1",
+						"header.0.value": "value-1",
+						"topic":          "fake_topic",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "timestream.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16115
 func TestAccIoTTopicRule_updateKinesisErrorAction(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -3183,6 +3229,56 @@ resource "aws_iot_topic_rule" "test" {
 `, rName, dimName))
 }
 
+func testAccTopicRuleConfig_timestreamErrorAction(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTopicRuleConfig_destinationRole(rName),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  kafka {
+    destination_arn = "arn:${data.aws_partition.current.partition}:iot:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:ruledestination/vpc/pretend-this-is-a-uuid"
+    topic           = "fake_topic"
+
+    client_properties = {
+      "acks"                  = "1"
+      "bootstrap.servers"     = "b-1.localhost:9094"
+      "compression.type"      = "none"
+      "key.serializer"        = "org.apache.kafka.common.serialization.StringSerializer"
+      "security.protocol"     = "SSL"
+      "ssl.keystore"          = "$${get_secret('secret_name', 'SecretBinary', '', '${aws_iam_role.test.arn}')}"
+      "ssl.keystore.password" = "password"
+      "value.serializer"      = "org.apache.kafka.common.serialization.ByteBufferSerializer"
+    }
+
+    header {
+      key   = "header-1"
+      value = "value-1"
+    }
+  }
+
+  error_action {
+    timestream {
+      database_name = "TestDB"
+      role_arn      = aws_iam_role.test.arn
+      table_name    = "test_table"
+
+      dimension {
+        name  = "dim1"
+        value = "$${dim1}"
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccTopicRuleConfig_kinesisErrorAction(rName string) string {
 	return acctest.ConfigCompose(
 		testAccTopicRuleConfig_destinationRole(rName),
@@ -179,7 +179,12 @@ func resourceRoomRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("maximum_message_length", out.MaximumMessageLength)
 	d.Set("maximum_message_rate_per_second", out.MaximumMessageRatePerSecond)
 
-	if err := d.Set("message_review_handler", flattenMessageReviewHandler(out.MessageReviewHandler)); err != nil {
+	messageReviewHandler := flattenMessageReviewHandler(out.MessageReviewHandler)
+	// A disassociated handler is returned with an empty URI; don't report it unless it is configured.
+	if out.MessageReviewHandler != nil && aws.ToString(out.MessageReviewHandler.Uri) == "" && len(d.Get("message_review_handler").([]interface{})) == 0 {
+		messageReviewHandler = nil
+	}
+	if err := d.Set("message_review_handler", messageReviewHandler); err != nil {
 		return create.AppendDiagError(diags, names.IVSChat, create.ErrActionSetting, ResNameRoom, d.Id(), err)
 	}
 
@@ -216,6 +221,14 @@ func resourceRoomUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	if d.HasChanges("message_review_handler") {
 		in.MessageReviewHandler = expandMessageReviewHandler(d.Get("message_review_handler").([]interface{}))
+
+		// An empty URI disassociates the message review handler from the room.
+		if in.MessageReviewHandler == nil {
+			in.MessageReviewHandler = &types.MessageReviewHandler{
+				Uri: aws.String(""),
+			}
+		}
+
 		update = true
 	}
 
@@ -273,14 +286,9 @@ func flattenMessageReviewHandler(apiObject *types.MessageReviewHandler) []interf
 		return nil
 	}
 
-	m := map[string]interface{}{}
-
-	if v := apiObject.FallbackResult; v != "" {
-		m["fallback_result"] = v
-	}
-
-	if v := apiObject.Uri; v != nil {
-		m[names.AttrURI] = aws.ToString(v)
+	m := map[string]interface{}{
+		"fallback_result": apiObject.FallbackResult,
+		names.AttrURI:     aws.ToString(apiObject.Uri),
 	}
 
 	return []interface{}{m}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ivschat/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -175,6 +176,28 @@ func TestAccIVSChatRoom_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 				),
 			},
+			{
+				Config: testAccRoomConfig_update(rName, maximumMessageLength, maximumMessageRatePerSecond, "ALLOW"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(ctx, resourceName, &room2),
+					testAccCheckRoomNotRecreated(&room1, &room2),
+					resource.TestCheckResourceAttr(resourceName, "message_review_handler.0.fallback_result", "ALLOW"),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, "message_review_handler.0.uri", "lambda", fmt.Sprintf("function:%s", rName)),
+				),
+			},
+			{
+				Config: testAccRoomConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(ctx, resourceName, &room2),
+					testAccCheckRoomNotRecreated(&room1, &room2),
+					resource.TestCheckResourceAttr(resourceName, "message_review_handler.#", "0"),
+				),
+			},
 		},
 	})
 }
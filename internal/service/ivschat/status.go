@@ -5,10 +5,11 @@ package ivschat
 
 import (
 	"context"
-	"reflect"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ivschat"
+	"github.com/aws/aws-sdk-go-v2/service/ivschat/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -47,17 +48,53 @@ func statusRoom(ctx context.Context, conn *ivschat.Client, id string, updateDeta
 
 		if updateDetails == nil {
 			return out, statusNormal, nil
-		} else {
-			if (aws.ToInt32(updateDetails.MaximumMessageLength) != 0 && updateDetails.MaximumMessageLength == out.MaximumMessageLength) ||
-				(aws.ToInt32(updateDetails.MaximumMessageRatePerSecond) != 0 && updateDetails.MaximumMessageRatePerSecond == out.MaximumMessageRatePerSecond) ||
-				(updateDetails.MessageReviewHandler != nil && out.MessageReviewHandler != nil &&
-					(updateDetails.MessageReviewHandler.FallbackResult == out.MessageReviewHandler.FallbackResult || aws.ToString(updateDetails.MessageReviewHandler.Uri) == aws.ToString(out.MessageReviewHandler.Uri))) ||
-				(updateDetails.Name != nil && aws.ToString(updateDetails.Name) == aws.ToString(out.Name)) ||
-				(updateDetails.LoggingConfigurationIdentifiers != nil &&
-					(reflect.DeepEqual(updateDetails.LoggingConfigurationIdentifiers, out.LoggingConfigurationIdentifiers) || (len(updateDetails.LoggingConfigurationIdentifiers) == 0 && out.LoggingConfigurationIdentifiers == nil))) {
-				return out, statusUpdated, nil
+		}
+
+		if roomUpdateApplied(updateDetails, out) {
+			return out, statusUpdated, nil
+		}
+
+		return out, statusChangePending, nil
+	}
+}
+
+// roomUpdateApplied returns whether every field set in the update request is reflected in the room.
+func roomUpdateApplied(in *ivschat.UpdateRoomInput, out *ivschat.GetRoomOutput) bool {
+	if in.MaximumMessageLength != nil && aws.ToInt32(in.MaximumMessageLength) != aws.ToInt32(out.MaximumMessageLength) {
+		return false
+	}
+
+	if in.MaximumMessageRatePerSecond != nil && aws.ToInt32(in.MaximumMessageRatePerSecond) != aws.ToInt32(out.MaximumMessageRatePerSecond) {
+		return false
+	}
+
+	if v := in.MessageReviewHandler; v != nil {
+		var uri string
+		var fallbackResult types.FallbackResult
+		if out.MessageReviewHandler != nil {
+			uri, fallbackResult = aws.ToString(out.MessageReviewHandler.Uri), out.MessageReviewHandler.FallbackResult
+		}
+
+		if aws.ToString(v.Uri) != uri || (v.FallbackResult != "" && v.FallbackResult != fallbackResult) {
+			return false
+		}
+	}
+
+	if in.Name != nil && aws.ToString(in.Name) != aws.ToString(out.Name) {
+		return false
+	}
+
+	if in.LoggingConfigurationIdentifiers != nil {
+		if len(in.LoggingConfigurationIdentifiers) != len(out.LoggingConfigurationIdentifiers) {
+			return false
+		}
+
+		for _, v := range in.LoggingConfigurationIdentifiers {
+			if !slices.Contains(out.LoggingConfigurationIdentifiers, v) {
+				return false
 			}
-			return out, statusChangePending, nil
 		}
 	}
+
+	return true
 }
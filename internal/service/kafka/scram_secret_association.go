@@ -5,8 +5,6 @@ package kafka
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"log"
 	"slices"
 
@@ -224,23 +222,19 @@ func disassociateSRAMSecrets(ctx context.Context, conn *kafka.Client, clusterARN
 }
 
 func unprocessedScramSecretsError(apiObjects []types.UnprocessedScramSecret, ignoreInvalidSecretARN bool) error {
-	var errs []error
+	var items []errs.ItemError
 
 	for _, apiObject := range apiObjects {
 		if ignoreInvalidSecretARN && aws.ToString(apiObject.ErrorCode) == "InvalidSecretArn" {
 			continue
 		}
 
-		err := unprocessedScramSecretError(&apiObject)
-
-		if err != nil {
-			errs = append(errs, fmt.Errorf("%s: %w", aws.ToString(apiObject.SecretArn), err))
-		}
+		items = append(items, errs.ItemError{
+			ID:      aws.ToString(apiObject.SecretArn),
+			Code:    aws.ToString(apiObject.ErrorCode),
+			Message: aws.ToString(apiObject.ErrorMessage),
+		})
 	}
 
-	return errors.Join(errs...)
-}
-
-func unprocessedScramSecretError(apiObject *types.UnprocessedScramSecret) error {
-	return fmt.Errorf("%s: %s", aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage))
+	return errs.NewBatchError(items)
 }
@@ -788,6 +788,25 @@ func resourceApplication() *schema.Resource {
 						},
 					},
 				},
+				"application_maintenance_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"application_maintenance_window_end_time": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"application_maintenance_window_start_time": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be in the format HH:MM"),
+							},
+						},
+					},
+				},
 				"application_mode": {
 					Type:             schema.TypeString,
 					Optional:         true,
@@ -844,6 +863,11 @@ func resourceApplication() *schema.Resource {
 						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
 					),
 				},
+				"rollback_on_update_failure": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				"runtime_environment": {
 					Type:             schema.TypeString,
 					Required:         true,
@@ -905,6 +929,12 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 	// CreateTimestamp is required for deletion, so persist to state now in case of subsequent errors and destroy being called without refresh.
 	d.Set("create_timestamp", aws.ToTime(output.ApplicationDetail.CreateTimestamp).Format(time.RFC3339))
 
+	if v, ok := d.GetOk("application_maintenance_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := updateApplicationMaintenanceConfiguration(ctx, conn, applicationName, v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if _, ok := d.GetOk("start_application"); ok {
 		if err := startApplication(ctx, conn, expandStartApplicationInput(d), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -933,6 +963,9 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 	if err := d.Set("application_configuration", flattenApplicationConfigurationDescription(application.ApplicationConfigurationDescription)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting application_configuration: %s", err)
 	}
+	if err := d.Set("application_maintenance_configuration", flattenApplicationMaintenanceConfigurationDescription(application.ApplicationMaintenanceConfigurationDescription)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting application_maintenance_configuration: %s", err)
+	}
 	d.Set("application_mode", application.ApplicationMode)
 	d.Set(names.AttrARN, application.ApplicationARN)
 	if err := d.Set("cloudwatch_logging_options", flattenCloudWatchLoggingOptionDescriptions(application.CloudWatchLoggingOptionDescriptions)); err != nil {
@@ -954,6 +987,15 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KinesisAnalyticsV2Client(ctx)
 	applicationName := d.Get(names.AttrName).(string)
+	restartFromSnapshot := false
+
+	if d.HasChange("application_maintenance_configuration") {
+		if v, ok := d.GetOk("application_maintenance_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := updateApplicationMaintenanceConfiguration(ctx, conn, applicationName, v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
 
 	if d.HasChanges("application_configuration", "cloudwatch_logging_options", "service_execution_role") {
 		currentApplicationVersionID := int64(d.Get("version_id").(int))
@@ -1293,9 +1335,15 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 				}
 
 				if actual, expected := application.ApplicationStatus, awstypes.ApplicationStatusRunning; actual == expected {
-					input.RunConfigurationUpdate = expandRunConfigurationUpdate(d.Get("application_configuration.0.run_configuration").([]interface{}))
+					// A new snapshot to restore from is applied by restarting the application once all other updates are done.
+					if d.HasChange("application_configuration.0.run_configuration.0.application_restore_configuration.0.snapshot_name") &&
+						d.Get("application_configuration.0.run_configuration.0.application_restore_configuration.0.snapshot_name").(string) != "" {
+						restartFromSnapshot = true
+					} else {
+						input.RunConfigurationUpdate = expandRunConfigurationUpdate(d.Get("application_configuration.0.run_configuration").([]interface{}))
 
-					updateApplication = true
+						updateApplication = true
+					}
 				}
 			}
 
@@ -1400,16 +1448,39 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 			if operationID := aws.ToString(output.OperationId); operationID != "" {
 				if _, err := waitApplicationOperationSucceeded(ctx, conn, applicationName, operationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-					return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) operation (%s) success: %s", applicationName, operationID, err)
+					diags = sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) operation (%s) success: %s", applicationName, operationID, err)
 				}
 			}
 
-			if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
+			if !diags.HasError() {
+				if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
+				}
+			}
+
+			if diags.HasError() {
+				if d.Get("rollback_on_update_failure").(bool) {
+					if err := rollbackApplication(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						diags = sdkdiag.AppendFromErr(diags, err)
+					}
+				}
+
+				// Record the application's actual configuration.
+				return append(diags, resourceApplicationRead(ctx, d, meta)...)
 			}
 		}
 	}
 
+	if restartFromSnapshot && d.Get("start_application").(bool) {
+		if err := stopApplication(ctx, conn, expandStopApplicationInput(d), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := startApplication(ctx, conn, expandStartApplicationInput(d), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChange("start_application") {
 		if _, ok := d.GetOk("start_application"); ok {
 			if err := startApplication(ctx, conn, expandStartApplicationInput(d), d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -1470,6 +1541,7 @@ func resourceApplicationImport(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set(names.AttrName, parts[1])
+	d.Set("rollback_on_update_failure", false)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -1554,6 +1626,56 @@ func stopApplication(ctx context.Context, conn *kinesisanalyticsv2.Client, input
 	return nil
 }
 
+func rollbackApplication(ctx context.Context, conn *kinesisanalyticsv2.Client, applicationName string, timeout time.Duration) error {
+	application, err := findApplicationDetailByName(ctx, conn, applicationName)
+
+	if err != nil {
+		return fmt.Errorf("reading Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	input := &kinesisanalyticsv2.RollbackApplicationInput{
+		ApplicationName:             aws.String(applicationName),
+		CurrentApplicationVersionId: application.ApplicationVersionId,
+	}
+
+	output, err := conn.RollbackApplication(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("rolling back Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	if operationID := aws.ToString(output.OperationId); operationID != "" {
+		if _, err := waitApplicationOperationSucceeded(ctx, conn, applicationName, operationID, timeout); err != nil {
+			return fmt.Errorf("waiting for Kinesis Analytics v2 Application (%s) operation (%s) success: %w", applicationName, operationID, err)
+		}
+	}
+
+	if _, err := waitApplicationRolledBack(ctx, conn, applicationName, timeout); err != nil {
+		return fmt.Errorf("waiting for Kinesis Analytics v2 Application (%s) rollback: %w", applicationName, err)
+	}
+
+	return nil
+}
+
+func updateApplicationMaintenanceConfiguration(ctx context.Context, conn *kinesisanalyticsv2.Client, applicationName string, tfMap map[string]interface{}) error {
+	input := &kinesisanalyticsv2.UpdateApplicationMaintenanceConfigurationInput{
+		ApplicationMaintenanceConfigurationUpdate: &awstypes.ApplicationMaintenanceConfigurationUpdate{
+			ApplicationMaintenanceWindowStartTimeUpdate: aws.String(tfMap["application_maintenance_window_start_time"].(string)),
+		},
+		ApplicationName: aws.String(applicationName),
+	}
+
+	_, err := waitIAMPropagation(ctx, func() (*kinesisanalyticsv2.UpdateApplicationMaintenanceConfigurationOutput, error) {
+		return conn.UpdateApplicationMaintenanceConfiguration(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating Kinesis Analytics v2 Application (%s) maintenance configuration: %w", applicationName, err)
+	}
+
+	return nil
+}
+
 func findApplicationDetailByName(ctx context.Context, conn *kinesisanalyticsv2.Client, name string) (*awstypes.ApplicationDetail, error) {
 	input := &kinesisanalyticsv2.DescribeApplicationInput{
 		ApplicationName: aws.String(name),
@@ -1650,6 +1772,23 @@ func waitApplicationUpdated(ctx context.Context, conn *kinesisanalyticsv2.Client
 	return nil, err
 }
 
+func waitApplicationRolledBack(ctx context.Context, conn *kinesisanalyticsv2.Client, name string, timeout time.Duration) (*awstypes.ApplicationDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationStatusRollingBack, awstypes.ApplicationStatusUpdating),
+		Target:  enum.Slice(awstypes.ApplicationStatusReady, awstypes.ApplicationStatusRolledBack, awstypes.ApplicationStatusRunning),
+		Refresh: statusApplication(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ApplicationDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitApplicationDeleted(ctx context.Context, conn *kinesisanalyticsv2.Client, name string, timeout time.Duration) (*awstypes.ApplicationDetail, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationStatusDeleting),
@@ -2952,6 +3091,19 @@ func flattenApplicationConfigurationDescription(applicationConfigurationDescript
 	return []interface{}{mApplicationConfiguration}
 }
 
+func flattenApplicationMaintenanceConfigurationDescription(apiObject *awstypes.ApplicationMaintenanceConfigurationDescription) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"application_maintenance_window_end_time":   aws.ToString(apiObject.ApplicationMaintenanceWindowEndTime),
+		"application_maintenance_window_start_time": aws.ToString(apiObject.ApplicationMaintenanceWindowStartTime),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenCloudWatchLoggingOptionDescriptions(cloudWatchLoggingOptionDescriptions []awstypes.CloudWatchLoggingOptionDescription) []interface{} {
 	if len(cloudWatchLoggingOptionDescriptions) == 0 {
 		return []interface{}{}
@@ -1188,6 +1188,46 @@ func TestAccKinesisAnalyticsV2Application_FlinkApplicationEnvironmentProperties_
 	})
 }
 
+func TestAccKinesisAnalyticsV2Application_FlinkApplication_maintenanceConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ApplicationDetail
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisAnalyticsV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_maintenanceConfiguration(rName, "03:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_maintenance_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_maintenance_configuration.0.application_maintenance_window_start_time", "03:00"),
+					resource.TestCheckResourceAttrSet(resourceName, "application_maintenance_configuration.0.application_maintenance_window_end_time"),
+					resource.TestCheckResourceAttr(resourceName, "rollback_on_update_failure", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rollback_on_update_failure"},
+			},
+			{
+				Config: testAccApplicationConfig_maintenanceConfiguration(rName, "21:30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_maintenance_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_maintenance_configuration.0.application_maintenance_window_start_time", "21:30"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKinesisAnalyticsV2Application_FlinkApplication_restoreFromSnapshot(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.ApplicationDetail
@@ -4501,6 +4541,23 @@ resource "aws_kinesisanalyticsv2_application" "test" {
 `, rName, runtimeEnvironment))
 }
 
+func testAccApplicationConfig_maintenanceConfiguration(rName, startTime string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_baseServiceExecutionIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                       = %[1]q
+  runtime_environment        = "FLINK-1_18"
+  service_execution_role     = aws_iam_role.test[0].arn
+  rollback_on_update_failure = true
+
+  application_maintenance_configuration {
+    application_maintenance_window_start_time = %[2]q
+  }
+}
+`, rName, startTime))
+}
+
 func testAccApplicationConfig_basicSQL(rName string) string {
 	return acctest.ConfigCompose(
 		testAccApplicationConfig_baseServiceExecutionIAMRole(rName),
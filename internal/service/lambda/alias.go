@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -35,6 +36,12 @@ func resourceAlias() *schema.Resource {
 			StateContext: resourceAliasImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		CustomizeDiff: validateAliasRoutingConfiguration,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -139,20 +146,35 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
+	functionName, name := d.Get("function_name").(string), d.Get(names.AttrName).(string)
 	input := &lambda.UpdateAliasInput{
 		Description:     aws.String(d.Get(names.AttrDescription).(string)),
-		FunctionName:    aws.String(d.Get("function_name").(string)),
+		FunctionName:    aws.String(functionName),
 		FunctionVersion: aws.String(d.Get("function_version").(string)),
-		Name:            aws.String(d.Get(names.AttrName).(string)),
+		Name:            aws.String(name),
 		RoutingConfig:   expandAliasRoutingConfiguration(d.Get("routing_config").([]interface{})),
 	}
 
-	_, err := conn.UpdateAlias(ctx, input)
+	// Changing the versions behind an alias reallocates any provisioned concurrency configured on it.
+	// Don't shift traffic while a previous allocation is still in progress.
+	if err := waitAliasProvisionedConcurrencySettled(ctx, conn, functionName, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lambda Alias (%s) provisioned concurrency: %s", d.Id(), err)
+	}
+
+	_, err := tfresource.RetryWhenIsOneOf2[*awstypes.ProvisionedConcurrencyConfigNotFoundException, *awstypes.ResourceConflictException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		return conn.UpdateAlias(ctx, input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Lambda Alias (%s): %s", d.Id(), err)
 	}
 
+	if d.HasChanges("function_version", "routing_config") {
+		if err := waitAliasProvisionedConcurrencySettled(ctx, conn, functionName, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Alias (%s) provisioned concurrency: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceAliasRead(ctx, d, meta)...)
 }
 
@@ -191,6 +213,43 @@ func resourceAliasImport(ctx context.Context, d *schema.ResourceData, meta inter
 	return []*schema.ResourceData{d}, nil
 }
 
+// validateAliasRoutingConfiguration ensures that no additional version weight is assigned to the alias's primary version.
+func validateAliasRoutingConfiguration(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("function_version") || !d.NewValueKnown("routing_config") {
+		return nil
+	}
+
+	functionVersion := d.Get("function_version").(string)
+	if v, ok := d.GetOk("routing_config.0.additional_version_weights"); ok {
+		if _, ok := v.(map[string]interface{})[functionVersion]; ok {
+			return fmt.Errorf("routing_config.0.additional_version_weights must not contain the alias's function_version (%s)", functionVersion)
+		}
+	}
+
+	return nil
+}
+
+// waitAliasProvisionedConcurrencySettled waits for any provisioned concurrency allocation on the alias to complete.
+func waitAliasProvisionedConcurrencySettled(ctx context.Context, conn *lambda.Client, functionName, aliasName string, timeout time.Duration) error {
+	output, err := findProvisionedConcurrencyConfigByTwoPartKey(ctx, conn, functionName, aliasName)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if output.Status != awstypes.ProvisionedConcurrencyStatusEnumInProgress {
+		return nil
+	}
+
+	_, err = waitProvisionedConcurrencyConfigReady(ctx, conn, functionName, aliasName, timeout)
+
+	return err
+}
+
 func findAliasByTwoPartKey(ctx context.Context, conn *lambda.Client, functionName, aliasName string) (*lambda.GetAliasOutput, error) {
 	input := &lambda.GetAliasInput{
 		FunctionName: aws.String(functionName),
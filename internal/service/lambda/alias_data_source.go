@@ -44,6 +44,19 @@ func dataSourceAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"routing_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_version_weights": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -64,6 +77,9 @@ func dataSourceAliasRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set(names.AttrDescription, output.Description)
 	d.Set("function_version", output.FunctionVersion)
 	d.Set("invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), aliasARN))
+	if err := d.Set("routing_config", flattenAliasRoutingConfiguration(output.RoutingConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting routing_config: %s", err)
+	}
 
 	return diags
 }
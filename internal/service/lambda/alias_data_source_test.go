@@ -31,6 +31,7 @@ func TestAccLambdaAliasDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDescription, resourceName, names.AttrDescription),
 					resource.TestCheckResourceAttrPair(dataSourceName, "function_version", resourceName, "function_version"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "invoke_arn", resourceName, "invoke_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routing_config.#", resourceName, "routing_config.#"),
 				),
 			},
 		},
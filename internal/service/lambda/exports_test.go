@@ -33,6 +33,7 @@ var (
 	GetFunctionNameFromARN                       = getFunctionNameFromARN
 	GetQualifierFromAliasOrVersionARN            = getQualifierFromAliasOrVersionARN
 	LayerVersionParseResourceID                  = layerVersionParseResourceID
+	ParseECRImageURI                             = parseECRImageURI
 	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
 	SignerServiceIsAvailable                     = signerServiceIsAvailable
 
@@ -7,11 +7,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -210,6 +213,10 @@ func resourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"image_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -314,6 +321,11 @@ func resourceFunction() *schema.Resource {
 				Default:      -1,
 				ValidateFunc: validation.IntAtLeast(-1),
 			},
+			"resolve_image_digest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrRole: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -459,6 +471,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			resolveImageDigest,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -675,6 +688,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting image_config: %s", err)
 	}
 	if output.Code != nil {
+		d.Set("image_digest", imageDigestFromURI(aws.ToString(output.Code.ResolvedImageUri)))
 		d.Set("image_uri", output.Code.ImageUri)
 	}
 	d.Set("invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), functionARN))
@@ -1334,6 +1348,90 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// resolveImageDigest resolves the tag of an existing function's image_uri to a digest via ECR when resolve_image_digest is set.
+// A digest that differs from the one currently deployed plans a code update even though image_uri is unchanged.
+func resolveImageDigest(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("resolve_image_digest").(bool) {
+		return nil
+	}
+
+	imageURI := d.Get("image_uri").(string)
+	if imageURI == "" || !d.NewValueKnown("image_uri") {
+		return nil
+	}
+
+	registryID, region, repositoryName, imageTag, err := parseECRImageURI(imageURI)
+	if err != nil {
+		return err
+	}
+
+	if imageTag == "" {
+		// Digest-pinned URI, so any change shows up in image_uri itself.
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+	input := &ecr.DescribeImagesInput{
+		ImageIds: []ecrtypes.ImageIdentifier{{
+			ImageTag: aws.String(imageTag),
+		}},
+		RegistryId:     aws.String(registryID),
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	output, err := conn.DescribeImages(ctx, input, func(o *ecr.Options) {
+		o.Region = region
+	})
+
+	if err != nil {
+		return fmt.Errorf("resolving Lambda Function image (%s) digest: %w", imageURI, err)
+	}
+
+	if len(output.ImageDetails) == 0 {
+		return fmt.Errorf("resolving Lambda Function image (%s) digest: image not found", imageURI)
+	}
+
+	if digest := aws.ToString(output.ImageDetails[0].ImageDigest); digest != d.Get("image_digest").(string) {
+		if err := d.SetNew("image_digest", digest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var ecrImageURIRegexp = regexache.MustCompile(`^(\d{12})\.dkr\.ecr(?:-fips)?\.([0-9a-z-]+)\.amazonaws\.com(?:\.cn)?/([^:@]+)(?::([^:@]+))?(?:@(.+))?$`)
+
+// parseECRImageURI returns the registry ID, Region, repository name and tag of an ECR image URI.
+// The returned tag is empty if the URI references a digest; a URI with neither references the "latest" tag.
+func parseECRImageURI(uri string) (string, string, string, string, error) {
+	m := ecrImageURIRegexp.FindStringSubmatch(uri)
+	if m == nil {
+		return "", "", "", "", fmt.Errorf("unexpected format for ECR image URI (%s)", uri)
+	}
+
+	registryID, region, repositoryName, imageTag, imageDigest := m[1], m[2], m[3], m[4], m[5]
+
+	if imageDigest != "" {
+		return registryID, region, repositoryName, "", nil
+	}
+
+	if imageTag == "" {
+		imageTag = "latest"
+	}
+
+	return registryID, region, repositoryName, imageTag, nil
+}
+
+// imageDigestFromURI returns the digest of an image URI of the form repository@digest.
+func imageDigestFromURI(uri string) string {
+	if _, digest, ok := strings.Cut(uri, "@"); ok {
+		return digest
+	}
+
+	return ""
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1358,6 +1456,7 @@ func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
 		d.HasChange("image_uri") ||
+		d.HasChange("image_digest") ||
 		d.HasChange("architectures")
 }
 
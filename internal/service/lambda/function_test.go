@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -963,6 +964,7 @@ func TestAccLambdaFunction_image(t *testing.T) {
 					testAccCheckFunctionQualifiedInvokeARN(resourceName, &conf),
 					resource.TestCheckResourceAttr(resourceName, "package_type", string(awstypes.PackageTypeImage)),
 					resource.TestCheckResourceAttr(resourceName, "image_uri", imageLatestID),
+					resource.TestCheckResourceAttrSet(resourceName, "image_digest"),
 					resource.TestCheckResourceAttr(resourceName, "image_config.0.entry_point.0", "/bootstrap-with-handler"),
 					resource.TestCheckResourceAttr(resourceName, "image_config.0.command.0", "app.lambda_handler"),
 					resource.TestCheckResourceAttr(resourceName, "image_config.0.working_directory", "/var/task"),
@@ -996,6 +998,109 @@ func TestAccLambdaFunction_image(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_imageResolveDigest(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "AWS_LAMBDA_IMAGE_LATEST_ID"
+	imageLatestID := os.Getenv(key)
+	if imageLatestID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_imageResolveDigest(rName, imageLatestID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "image_uri", imageLatestID),
+					resource.TestMatchResourceAttr(resourceName, "image_digest", regexache.MustCompile(`^sha256:[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, "resolve_image_digest", acctest.CtTrue),
+				),
+			},
+			// The tag still resolves to the deployed digest, so there is nothing to update.
+			{
+				Config: testAccFunctionConfig_imageResolveDigest(rName, imageLatestID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestParseECRImageURI(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		uri                string
+		wantRegistryID     string
+		wantRegion         string
+		wantRepositoryName string
+		wantImageTag       string
+		wantErr            bool
+	}{
+		"tag": {
+			uri:                "123456789012.dkr.ecr.us-west-2.amazonaws.com/app:v1",
+			wantRegistryID:     "123456789012",
+			wantRegion:         "us-west-2",
+			wantRepositoryName: "app",
+			wantImageTag:       "v1",
+		},
+		"no tag": {
+			uri:                "123456789012.dkr.ecr.us-west-2.amazonaws.com/team/app",
+			wantRegistryID:     "123456789012",
+			wantRegion:         "us-west-2",
+			wantRepositoryName: "team/app",
+			wantImageTag:       "latest",
+		},
+		"digest": {
+			uri:                "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/app@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			wantRegistryID:     "123456789012",
+			wantRegion:         "cn-north-1",
+			wantRepositoryName: "app",
+		},
+		"not ECR": {
+			uri:     "public.ecr.aws/lambda/provided:al2",
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			registryID, region, repositoryName, imageTag, err := tflambda.ParseECRImageURI(testCase.uri)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("got error %t, want %t: %v", got, want, err)
+			}
+
+			if got, want := registryID, testCase.wantRegistryID; got != want {
+				t.Errorf("registry ID: got %q, want %q", got, want)
+			}
+			if got, want := region, testCase.wantRegion; got != want {
+				t.Errorf("Region: got %q, want %q", got, want)
+			}
+			if got, want := repositoryName, testCase.wantRepositoryName; got != want {
+				t.Errorf("repository name: got %q, want %q", got, want)
+			}
+			if got, want := imageTag, testCase.wantImageTag; got != want {
+				t.Errorf("image tag: got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestAccLambdaFunction_architectures(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3186,6 +3291,20 @@ resource "aws_lambda_function" "test" {
 `, imageID, rName))
 }
 
+func testAccFunctionConfig_imageResolveDigest(rName, imageID string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  image_uri            = %[1]q
+  function_name        = %[2]q
+  role                 = aws_iam_role.iam_for_lambda.arn
+  package_type         = "Image"
+  resolve_image_digest = true
+}
+`, imageID, rName))
+}
+
 func testAccFunctionConfig_imageUpdateCode(rName, imageID string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -119,6 +120,12 @@ func resourceLayerVersion() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{"filename"},
 			},
+			"retain_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -214,6 +221,12 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(aws.ToString(output.LayerVersionArn))
 
+	if v, ok := d.GetOk("retain_versions"); ok {
+		if err := pruneLayerVersions(ctx, conn, layerName, output.Version, v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "pruning Lambda Layer (%s) Versions: %s", layerName, err)
+		}
+	}
+
 	return append(diags, resourceLayerVersionRead(ctx, d, meta)...)
 }
 
@@ -283,6 +296,54 @@ func resourceLayerVersionDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// pruneLayerVersions deletes all but the newest retain versions of the specified layer.
+// Only versions older than the one just published are candidates for deletion.
+func pruneLayerVersions(ctx context.Context, conn *lambda.Client, layerName string, publishedVersion int64, retain int) error {
+	input := &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+	}
+	var versions []int64
+	pages := lambda.NewListLayerVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return fmt.Errorf("listing versions: %w", err)
+		}
+
+		for _, v := range page.LayerVersions {
+			versions = append(versions, v.Version)
+		}
+	}
+
+	slices.Sort(versions)
+	slices.Reverse(versions)
+
+	var pruned []string
+	for i, version := range versions {
+		if i < retain || version >= publishedVersion {
+			continue
+		}
+
+		_, err := conn.DeleteLayerVersion(ctx, &lambda.DeleteLayerVersionInput{
+			LayerName:     aws.String(layerName),
+			VersionNumber: aws.Int64(version),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting version %d: %w", version, err)
+		}
+
+		pruned = append(pruned, strconv.FormatInt(version, 10))
+	}
+
+	if len(pruned) > 0 {
+		log.Printf("[INFO] Pruned Lambda Layer (%s) Versions: %s", layerName, strings.Join(pruned, ", "))
+	}
+
+	return nil
+}
+
 func layerVersionParseResourceID(id string) (layerName string, version int64, err error) {
 	v, err := arn.Parse(id)
 	if err != nil {
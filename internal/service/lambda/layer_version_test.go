@@ -349,6 +349,46 @@ func TestAccLambdaLayerVersion_skipDestroy(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_retainVersions(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop, // this purposely leaves dangling resources, since skip_destroy = true
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionConfig_retainVersions(rName, "nodejs16.x"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retain_versions", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+			{
+				Config: testAccLayerVersionConfig_retainVersions(rName, "nodejs18.x"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+					testAccCheckLayerVersionNumberExists(ctx, rName, 1),
+				),
+			},
+			{
+				Config: testAccLayerVersionConfig_retainVersions(rName, "nodejs20.x"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "3"),
+					testAccCheckLayerVersionNumberNotExists(ctx, rName, 1),
+					testAccCheckLayerVersionNumberExists(ctx, rName, 2),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLayerVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -400,6 +440,34 @@ func testAccCheckLayerVersionExists(ctx context.Context, n string) resource.Test
 	}
 }
 
+func testAccCheckLayerVersionNumberExists(ctx context.Context, layerName string, versionNumber int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		_, err := tflambda.FindLayerVersionByTwoPartKey(ctx, conn, layerName, versionNumber)
+
+		return err
+	}
+}
+
+func testAccCheckLayerVersionNumberNotExists(ctx context.Context, layerName string, versionNumber int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		_, err := tflambda.FindLayerVersionByTwoPartKey(ctx, conn, layerName, versionNumber)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lambda Layer (%s) Version %d still exists", layerName, versionNumber)
+	}
+}
+
 func testAccLayerVersionConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lambda_layer_version" "test" {
@@ -535,3 +603,15 @@ resource "aws_lambda_layer_version" "test" {
 }
 `, rName, compatRuntime)
 }
+
+func testAccLayerVersionConfig_retainVersions(rName, compatRuntime string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = [%[2]q]
+  retain_versions     = 2
+  skip_destroy        = true
+}
+`, rName, compatRuntime)
+}
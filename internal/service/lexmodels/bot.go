@@ -258,7 +258,7 @@ func resourceBotCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	var output *lexmodelbuildingservice.PutBotOutput
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+	_, err := tfresource.RetryGWhenIsA[*lexmodelbuildingservice.PutBotOutput, *awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutCreate), func() (*lexmodelbuildingservice.PutBotOutput, error) {
 		var err error
 
 		if output != nil {
@@ -381,7 +381,7 @@ func resourceBotUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.VoiceId = aws.String(v.(string))
 	}
 
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+	_, err := tfresource.RetryGWhenIsA[*lexmodelbuildingservice.PutBotOutput, *awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutUpdate), func() (*lexmodelbuildingservice.PutBotOutput, error) {
 		return conn.PutBot(ctx, input)
 	})
 
@@ -405,7 +405,7 @@ func resourceBotDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	log.Printf("[DEBUG] Deleting Lex Bot: (%s)", d.Id())
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryGWhenIsA[*lexmodelbuildingservice.DeleteBotOutput, *awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutDelete), func() (*lexmodelbuildingservice.DeleteBotOutput, error) {
 		return conn.DeleteBot(ctx, input)
 	})
 
@@ -5,6 +5,7 @@ package lexmodels
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -16,7 +17,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lexmodelbuildingservice"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelbuildingservice/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -65,7 +65,8 @@ func resourceBotAlias() *schema.Resource {
 			},
 			"bot_version": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validBotVersion,
 			},
 			"checksum": {
@@ -117,8 +118,90 @@ func resourceBotAlias() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validBotAliasName,
 			},
+			"use_latest_bot_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
+
+		CustomizeDiff: resolveBotAliasBotVersion,
+	}
+}
+
+// resolveBotAliasBotVersion requires exactly one of bot_version and use_latest_bot_version.
+// With use_latest_bot_version, the latest published bot version is resolved at plan time so
+// that publishing a newer version shows up as a change to bot_version.
+func resolveBotAliasBotVersion(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	configured := !rawConfig.GetAttr("bot_version").IsNull()
+
+	if !d.Get("use_latest_bot_version").(bool) {
+		if !configured {
+			return errors.New(`one of "bot_version" or "use_latest_bot_version" must be set`)
+		}
+
+		return nil
+	}
+
+	if configured {
+		return errors.New(`"bot_version" cannot be set when "use_latest_bot_version" is true`)
+	}
+
+	if !d.NewValueKnown("bot_name") {
+		return d.SetNewComputed("bot_version")
+	}
+
+	conn := meta.(*conns.AWSClient).LexModelsClient(ctx)
+
+	version, err := findLatestBotVersionByName(ctx, conn, d.Get("bot_name").(string))
+
+	// The bot may be created in the same apply.
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return d.SetNewComputed("bot_version")
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading latest Lex Model Bot (%s) version: %w", d.Get("bot_name").(string), err)
+	}
+
+	if d.Get("bot_version").(string) != version {
+		return d.SetNew("bot_version", version)
+	}
+
+	return nil
+}
+
+// botAliasBotVersion returns the bot version to point the alias at, resolving the latest
+// published version if it was not known at plan time.
+func botAliasBotVersion(ctx context.Context, conn *lexmodelbuildingservice.Client, d *schema.ResourceData) (string, error) {
+	if v := d.Get("bot_version").(string); v != "" || !d.Get("use_latest_bot_version").(bool) {
+		return v, nil
+	}
+
+	return findLatestBotVersionByName(ctx, conn, d.Get("bot_name").(string))
+}
+
+// isBotAliasIAMRoleNotReadyError returns whether err indicates that the conversation logs
+// IAM role has not yet propagated and cannot be assumed by Lex.
+func isBotAliasIAMRoleNotReadyError(err error) bool {
+	return errs.IsAErrorMessageContains[*awstypes.BadRequestException](err, "Lex can't access your IAM role") ||
+		errs.IsAErrorMessageContains[*awstypes.BadRequestException](err, "is not assumable") ||
+		errs.IsAErrorMessageContains[*awstypes.BadRequestException](err, "Unable to assume role")
+}
+
+// retryableBotAliasPutError retries PutBotAlias while the conversation logs IAM role propagates
+// or while another operation on the alias is pending.
+func retryableBotAliasPutError(err error) (bool, error) {
+	if isBotAliasIAMRoleNotReadyError(err) || errs.IsA[*awstypes.ConflictException](err) {
+		return true, err
 	}
+
+	return false, err
 }
 
 var validBotAliasName = validation.All(
@@ -134,9 +217,14 @@ func resourceBotAliasCreate(ctx context.Context, d *schema.ResourceData, meta in
 	botAliasName := d.Get(names.AttrName).(string)
 	id := fmt.Sprintf("%s:%s", botName, botAliasName)
 
+	botVersion, err := botAliasBotVersion(ctx, conn, d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lex Model Bot Alias (%s): reading latest bot version: %s", id, err)
+	}
+
 	input := &lexmodelbuildingservice.PutBotAliasInput{
 		BotName:     aws.String(botName),
-		BotVersion:  aws.String(d.Get("bot_version").(string)),
+		BotVersion:  aws.String(botVersion),
 		Description: aws.String(d.Get(names.AttrDescription).(string)),
 		Name:        aws.String(botAliasName),
 	}
@@ -149,28 +237,15 @@ func resourceBotAliasCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ConversationLogs = conversationLogs
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	_, err = tfresource.RetryGWhenWithBackoff(ctx, d.Timeout(schema.TimeoutCreate), func() (*lexmodelbuildingservice.PutBotAliasOutput, error) {
 		output, err := conn.PutBotAlias(ctx, input)
 
 		if output != nil {
 			input.Checksum = output.Checksum
 		}
-		// IAM eventual consistency
-		if errs.IsAErrorMessageContains[*awstypes.BadRequestException](err, "Lex can't access your IAM role") {
-			return retry.RetryableError(err)
-		}
-		if errs.IsA[*awstypes.ConflictException](err) {
-			return retry.RetryableError(fmt.Errorf("%q bot alias still creating, another operation is pending: %w", id, err))
-		}
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
 
-		return nil
-	})
-	if tfresource.TimedOut(err) { // nosemgrep:ci.helper-schema-TimeoutError-check-doesnt-return-output
-		_, err = conn.PutBotAlias(ctx, input)
-	}
+		return output, err
+	}, retryableBotAliasPutError)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Lex Model Bot Alias (%s): %s", id, err)
@@ -226,9 +301,14 @@ func resourceBotAliasUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LexModelsClient(ctx)
 
+	botVersion, err := botAliasBotVersion(ctx, conn, d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lex Model Bot Alias (%s): reading latest bot version: %s", d.Id(), err)
+	}
+
 	input := &lexmodelbuildingservice.PutBotAliasInput{
 		BotName:    aws.String(d.Get("bot_name").(string)),
-		BotVersion: aws.String(d.Get("bot_version").(string)),
+		BotVersion: aws.String(botVersion),
 		Checksum:   aws.String(d.Get("checksum").(string)),
 		Name:       aws.String(d.Get(names.AttrName).(string)),
 	}
@@ -245,26 +325,9 @@ func resourceBotAliasUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ConversationLogs = conversationLogs
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		_, err := conn.PutBotAlias(ctx, input)
-
-		// IAM eventual consistency
-		if errs.IsAErrorMessageContains[*awstypes.BadRequestException](err, "Lex can't access your IAM role") {
-			return retry.RetryableError(err)
-		}
-		if errs.IsA[*awstypes.ConflictException](err) {
-			return retry.RetryableError(fmt.Errorf("%q bot alias still updating", d.Id()))
-		}
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBotAlias(ctx, input)
-	}
+	_, err = tfresource.RetryGWhenWithBackoff(ctx, d.Timeout(schema.TimeoutUpdate), func() (*lexmodelbuildingservice.PutBotAliasOutput, error) {
+		return conn.PutBotAlias(ctx, input)
+	}, retryableBotAliasPutError)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Lex Model Bot Alias (%s): %s", d.Id(), err)
@@ -280,7 +343,7 @@ func resourceBotAliasDelete(ctx context.Context, d *schema.ResourceData, meta in
 	botAliasName, botName := d.Get(names.AttrName).(string), d.Get("bot_name").(string)
 
 	log.Printf("[DEBUG] Deleting Lex Model Bot Alias: %s", d.Id())
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryGWhenIsA[*lexmodelbuildingservice.DeleteBotAliasOutput, *awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutDelete), func() (*lexmodelbuildingservice.DeleteBotAliasOutput, error) {
 		return conn.DeleteBotAlias(ctx, &lexmodelbuildingservice.DeleteBotAliasInput{
 			BotName: aws.String(botName),
 			Name:    aws.String(botAliasName),
@@ -310,6 +373,7 @@ func resourceBotAliasImport(ctx context.Context, d *schema.ResourceData, _ inter
 
 	d.Set("bot_name", parts[0])
 	d.Set(names.AttrName, parts[1])
+	d.Set("use_latest_bot_version", false)
 
 	return []*schema.ResourceData{d}, nil
 }
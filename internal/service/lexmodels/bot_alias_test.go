@@ -123,6 +123,64 @@ func testAccBotAlias_botVersion(t *testing.T) {
 	})
 }
 
+func testAccBotAlias_useLatestBotVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lexmodelbuildingservice.GetBotAliasOutput
+	resourceName := "aws_lex_bot_alias.test"
+	testBotName := "test_bot_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testBotAliasName := "test_bot_alias" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexModelBuildingServiceEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotAliasDestroy(ctx, testBotName, testBotAliasName),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccBotConfig_intent(testBotName),
+					testAccBotConfig_basic(testBotName),
+					testAccBotAliasConfig_useLatestBotVersion(testBotAliasName),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBotAliasExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", tflexmodels.BotVersionLatest),
+					resource.TestCheckResourceAttr(resourceName, "use_latest_bot_version", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrCreatedDate, "use_latest_bot_version"},
+			},
+			// Publishing a version happens during apply, after the alias was planned.
+			{
+				Config: acctest.ConfigCompose(
+					testAccBotConfig_intent(testBotName),
+					testAccBotConfig_createVersion(testBotName),
+					testAccBotAliasConfig_useLatestBotVersion(testBotAliasName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					testAccBotConfig_intent(testBotName),
+					testAccBotConfig_createVersion(testBotName),
+					testAccBotAliasConfig_useLatestBotVersion(testBotAliasName),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBotAliasExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLexModelsBotAlias_conversationLogsText(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v lexmodelbuildingservice.GetBotAliasOutput
@@ -439,6 +497,17 @@ resource "aws_lex_bot_alias" "test" {
 `, rName)
 }
 
+func testAccBotAliasConfig_useLatestBotVersion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_bot_alias" "test" {
+  bot_name               = aws_lex_bot.test.name
+  description            = "Testing lex bot alias create."
+  name                   = "%s"
+  use_latest_bot_version = true
+}
+`, rName)
+}
+
 func testAccBotAliasConfig_conversationLogsText(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lex_bot_alias" "test" {
@@ -92,10 +92,11 @@ func TestAccLexModelsBot_Version_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		"LexBot_createVersion":         testAccBot_createVersion,
-		"LexBotAlias_botVersion":       testAccBotAlias_botVersion,
-		"DataSourceLexBot_withVersion": testAccBotDataSource_withVersion,
-		"DataSourceLexBotAlias_basic":  testAccBotAliasDataSource_basic,
+		"LexBot_createVersion":            testAccBot_createVersion,
+		"LexBotAlias_botVersion":          testAccBotAlias_botVersion,
+		"LexBotAlias_useLatestBotVersion": testAccBotAlias_useLatestBotVersion,
+		"DataSourceLexBot_withVersion":    testAccBotDataSource_withVersion,
+		"DataSourceLexBotAlias_basic":     testAccBotAliasDataSource_basic,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
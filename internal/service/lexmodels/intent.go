@@ -15,7 +15,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lexmodelbuildingservice"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelbuildingservice/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -338,7 +337,7 @@ func resourceIntentCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.Slots = expandSlots(v.(*schema.Set).List())
 	}
 
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+	_, err := tfresource.RetryGWhenIsA[*lexmodelbuildingservice.PutIntentOutput, *awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutCreate), func() (*lexmodelbuildingservice.PutIntentOutput, error) {
 		return conn.PutIntent(ctx, input)
 	})
 
@@ -473,23 +472,10 @@ func resourceIntentUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.Slots = expandSlots(v.(*schema.Set).List())
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		_, err := conn.PutIntent(ctx, input)
-
-		if errs.IsA[*awstypes.ConflictException](err) {
-			return retry.RetryableError(fmt.Errorf("%q: intent still updating", d.Id()))
-		}
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := tfresource.RetryGWhenIsA[*lexmodelbuildingservice.PutIntentOutput, *awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutUpdate), func() (*lexmodelbuildingservice.PutIntentOutput, error) {
+		return conn.PutIntent(ctx, input)
 	})
 
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutIntent(ctx, input)
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating intent %s: %s", d.Id(), err)
 	}
@@ -502,7 +488,7 @@ func resourceIntentDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).LexModelsClient(ctx)
 
 	log.Printf("[DEBUG] Deleting Lex Model Intent: %s", d.Id())
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryGWhenIsA[*lexmodelbuildingservice.DeleteIntentOutput, *awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutDelete), func() (*lexmodelbuildingservice.DeleteIntentOutput, error) {
 		return conn.DeleteIntent(ctx, &lexmodelbuildingservice.DeleteIntentInput{
 			Name: aws.String(d.Id()),
 		})
@@ -158,7 +158,7 @@ func resourceSlotTypeCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	var output *lexmodelbuildingservice.PutSlotTypeOutput
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+	_, err := tfresource.RetryGWhenIsA[*lexmodelbuildingservice.PutSlotTypeOutput, *awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutCreate), func() (*lexmodelbuildingservice.PutSlotTypeOutput, error) {
 		var err error
 
 		if output != nil {
@@ -232,7 +232,7 @@ func resourceSlotTypeUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.EnumerationValues = expandEnumerationValues(v.(*schema.Set).List())
 	}
 
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+	_, err := tfresource.RetryGWhenIsA[*lexmodelbuildingservice.PutSlotTypeOutput, *awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutUpdate), func() (*lexmodelbuildingservice.PutSlotTypeOutput, error) {
 		return conn.PutSlotType(ctx, input)
 	})
 
@@ -252,7 +252,7 @@ func resourceSlotTypeDelete(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	log.Printf("[DEBUG] Deleting Lex Slot Type: (%s)", d.Id())
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryGWhenIsA[*lexmodelbuildingservice.DeleteSlotTypeOutput, *awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutDelete), func() (*lexmodelbuildingservice.DeleteSlotTypeOutput, error) {
 		return conn.DeleteSlotType(ctx, input)
 	})
 
@@ -29,6 +29,7 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -311,7 +312,10 @@ func (r *resourceBotLocale) Update(ctx context.Context, req resource.UpdateReque
 			)
 			return
 		}
-		state.refreshFromOutput(ctx, out)
+		resp.Diagnostics.Append(plan.refreshFromOutput(ctx, out)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -373,6 +377,10 @@ func waitBotLocaleCreated(ctx context.Context, conn *lexmodelsv2.Client, id stri
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		if out.BotLocaleStatus == awstypes.BotLocaleStatusFailed {
+			tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(out.FailureReasons, errors.New)...))
+		}
+
 		return out, err
 	}
 
@@ -391,6 +399,10 @@ func waitBotLocaleUpdated(ctx context.Context, conn *lexmodelsv2.Client, id stri
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		if out.BotLocaleStatus == awstypes.BotLocaleStatusFailed {
+			tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(out.FailureReasons, errors.New)...))
+		}
+
 		return out, err
 	}
 
@@ -413,6 +425,28 @@ func waitBotLocaleDeleted(ctx context.Context, conn *lexmodelsv2.Client, id stri
 	return nil, err
 }
 
+func waitBotLocaleBuilt(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.BotLocaleStatusBuilding, awstypes.BotLocaleStatusReadyExpressTesting),
+		Target:                    enum.Slice(awstypes.BotLocaleStatusBuilt),
+		Refresh:                   statusBotLocale(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		if out.BotLocaleStatus == awstypes.BotLocaleStatusFailed {
+			tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(out.FailureReasons, errors.New)...))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
 func statusBotLocale(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		out, err := FindBotLocaleByID(ctx, conn, id)
@@ -533,3 +567,51 @@ func (rd *resourceBotLocaleData) refreshFromOutput(ctx context.Context, out *lex
 
 	return diags
 }
+
+// botLocaleID returns the Bot Locale resource ID for the locale that owns an intent or slot.
+func botLocaleID(localeID, botID, botVersion string) string {
+	id, _ := fwflex.FlattenResourceId([]string{localeID, botID, botVersion}, botLocaleIDPartCount, false)
+
+	return id
+}
+
+// buildBotLocale starts a build of the bot locale and waits for it to finish.
+// A failed build is reported with the failure reasons returned by Lex.
+func buildBotLocale(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	parts, err := fwflex.ExpandResourceId(id, botLocaleIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &lexmodelsv2.BuildBotLocaleInput{
+		LocaleId:   aws.String(parts[0]),
+		BotId:      aws.String(parts[1]),
+		BotVersion: aws.String(parts[2]),
+	}
+
+	if _, err := conn.BuildBotLocale(ctx, in); err != nil {
+		return nil, err
+	}
+
+	return waitBotLocaleBuilt(ctx, conn, id, timeout)
+}
+
+// syncBotLocale builds the bot locale when build is set and returns the resulting locale status.
+// Intent and slot changes leave the locale NotBuilt until it is rebuilt.
+func syncBotLocale(ctx context.Context, conn *lexmodelsv2.Client, id string, build bool, timeout time.Duration) (awstypes.BotLocaleStatus, error) {
+	if build {
+		out, err := buildBotLocale(ctx, conn, id, timeout)
+		if out != nil {
+			return out.BotLocaleStatus, err
+		}
+
+		return "", err
+	}
+
+	out, err := FindBotLocaleByID(ctx, conn, id)
+	if err != nil {
+		return "", err
+	}
+
+	return out.BotLocaleStatus, nil
+}
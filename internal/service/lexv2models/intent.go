@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -831,6 +832,9 @@ func (r *resourceIntent) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"bot_locale_status": schema.StringAttribute{
+				Computed: true,
+			},
 			"bot_version": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -874,6 +878,11 @@ func (r *resourceIntent) Schema(ctx context.Context, req resource.SchemaRequest,
 			"parent_intent_signature": schema.StringAttribute{
 				Optional: true,
 			},
+			"rebuild_bot_locale": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"dialog_code_hook":         dialogCodeHookLNB,
@@ -951,7 +960,19 @@ func (r *resourceIntent) Create(ctx context.Context, req resource.CreateRequest,
 	data.CreationDateTime = dataAfter.CreationDateTime
 	data.LastUpdatedDateTime = dataAfter.LastUpdatedDateTime
 
+	localeID := botLocaleID(data.LocaleID.ValueString(), data.BotID.ValueString(), data.BotVersion.ValueString())
+	status, err := syncBotLocale(ctx, conn, localeID, data.RebuildBotLocale.ValueBool(), r.CreateTimeout(ctx, data.Timeouts))
+	data.BotLocaleStatus = flex.StringValueToFramework(ctx, status)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBotLocale, localeID, err),
+			err.Error(),
+		)
+		return
+	}
 }
 
 func (r *resourceIntent) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -988,6 +1009,21 @@ func (r *resourceIntent) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	localeID := botLocaleID(data.LocaleID.ValueString(), data.BotID.ValueString(), data.BotVersion.ValueString())
+	status, err := syncBotLocale(ctx, conn, localeID, false, 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionReading, ResNameBotLocale, localeID, err),
+			err.Error(),
+		)
+		return
+	}
+	data.BotLocaleStatus = flex.StringValueToFramework(ctx, status)
+
+	if data.RebuildBotLocale.IsNull() {
+		data.RebuildBotLocale = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -1046,35 +1082,47 @@ func (r *resourceIntent) Update(ctx context.Context, req resource.UpdateRequest,
 		change = true
 	}
 
-	if !change {
-		return
-	}
+	if change {
+		input := &lexmodelsv2.UpdateIntentInput{}
+		resp.Diagnostics.Append(flex.Expand(ctx, &new, input, intentFlexOpt)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	input := &lexmodelsv2.UpdateIntentInput{}
-	resp.Diagnostics.Append(flex.Expand(ctx, &new, input, intentFlexOpt)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+		_, err := conn.UpdateIntent(ctx, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameIntent, new.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
 
-	_, err := conn.UpdateIntent(ctx, input)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameIntent, new.ID.String(), err),
-			err.Error(),
-		)
-		return
+		_, err = waitIntentNormal(ctx, conn, new.IntentID.ValueString(), new.BotID.ValueString(), new.BotVersion.ValueString(), new.LocaleID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameIntent, new.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
 	}
 
-	_, err = waitIntentNormal(ctx, conn, new.IntentID.ValueString(), new.BotID.ValueString(), new.BotVersion.ValueString(), new.LocaleID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+	// Rebuild after intent changes, or when rebuilding is first enabled.
+	build := new.RebuildBotLocale.ValueBool() && (change || !old.RebuildBotLocale.ValueBool())
+	localeID := botLocaleID(new.LocaleID.ValueString(), new.BotID.ValueString(), new.BotVersion.ValueString())
+	status, err := syncBotLocale(ctx, conn, localeID, build, r.UpdateTimeout(ctx, new.Timeouts))
+	new.BotLocaleStatus = flex.StringValueToFramework(ctx, status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
+
 	if err != nil {
 		resp.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameIntent, new.ID.String(), err),
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBotLocale, localeID, err),
 			err.Error(),
 		)
 		return
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
 }
 
 func (r *resourceIntent) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1495,6 +1543,7 @@ type DialogCodeHookSettings struct {
 
 type ResourceIntentData struct {
 	BotID                  types.String                                                 `tfsdk:"bot_id"`
+	BotLocaleStatus        types.String                                                 `tfsdk:"bot_locale_status"`
 	BotVersion             types.String                                                 `tfsdk:"bot_version"`
 	ClosingSetting         fwtypes.ListNestedObjectValueOf[IntentClosingSetting]        `tfsdk:"closing_setting"`
 	ConfirmationSetting    fwtypes.ListNestedObjectValueOf[IntentConfirmationSetting]   `tfsdk:"confirmation_setting"`
@@ -1512,6 +1561,7 @@ type ResourceIntentData struct {
 	Name                   types.String                                                 `tfsdk:"name"`
 	OutputContext          fwtypes.ListNestedObjectValueOf[OutputContext]               `tfsdk:"output_context"`
 	ParentIntentSignature  types.String                                                 `tfsdk:"parent_intent_signature"`
+	RebuildBotLocale       types.Bool                                                   `tfsdk:"rebuild_bot_locale"`
 	SampleUtterance        fwtypes.ListNestedObjectValueOf[SampleUtterance]             `tfsdk:"sample_utterance"`
 	SlotPriority           fwtypes.ListNestedObjectValueOf[SlotPriority]                `tfsdk:"slot_priority"`
 	Timeouts               timeouts.Value                                               `tfsdk:"timeouts"`
@@ -1819,6 +1819,57 @@ func TestAccLexV2ModelsIntent_updateSampleUtterance(t *testing.T) {
 	})
 }
 
+func TestAccLexV2ModelsIntent_rebuildBotLocale(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var intent lexmodelsv2.DescribeIntentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntentConfig_rebuildBotLocale(rName, "{\"step\":1}", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(ctx, resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "bot_locale_status", string(lextypes.BotLocaleStatusNotBuilt)),
+					resource.TestCheckResourceAttr(resourceName, "rebuild_bot_locale", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_code_hook.0.post_fulfillment_status_specification.0.success_response.0.message_group.0.message.0.custom_payload.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_code_hook.0.post_fulfillment_status_specification.0.success_response.0.message_group.0.message.0.custom_payload.0.value", "{\"step\":1}"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIntentConfig_rebuildBotLocale(rName, "{\"step\":2}", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(ctx, resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "bot_locale_status", string(lextypes.BotLocaleStatusBuilt)),
+					resource.TestCheckResourceAttr(resourceName, "rebuild_bot_locale", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_code_hook.0.post_fulfillment_status_specification.0.success_response.0.message_group.0.message.0.custom_payload.0.value", "{\"step\":2}"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rebuild_bot_locale"},
+			},
+		},
+	})
+}
+
 func testAccCheckIntentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
@@ -2445,3 +2496,39 @@ resource "aws_lexv2models_intent" "test" {
 }
 `, rName, utter1, utter2, utter3, utter4))
 }
+
+func testAccIntentConfig_rebuildBotLocale(rName, payload string, rebuild bool) string {
+	return acctest.ConfigCompose(
+		testAccIntentConfig_base(rName, 60, true),
+		fmt.Sprintf(`
+resource "aws_lexv2models_intent" "test" {
+  bot_id             = aws_lexv2models_bot.test.id
+  bot_version        = aws_lexv2models_bot_locale.test.bot_version
+  name               = %[1]q
+  locale_id          = aws_lexv2models_bot_locale.test.locale_id
+  rebuild_bot_locale = %[3]t
+
+  sample_utterance {
+    utterance = "book a table"
+  }
+
+  fulfillment_code_hook {
+    enabled = true
+
+    post_fulfillment_status_specification {
+      success_response {
+        allow_interrupt = true
+
+        message_group {
+          message {
+            custom_payload {
+              value = %[2]q
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, payload, rebuild))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -525,6 +526,9 @@ func (r *resourceSlot) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"bot_locale_status": schema.StringAttribute{
+				Computed: true,
+			},
 			"bot_version": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -556,6 +560,11 @@ func (r *resourceSlot) Schema(ctx context.Context, req resource.SchemaRequest, r
 			names.AttrName: schema.StringAttribute{
 				Required: true,
 			},
+			"rebuild_bot_locale": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"slot_type_id": schema.StringAttribute{
 				Computed: true,
 				Optional: true,
@@ -634,7 +643,19 @@ func (r *resourceSlot) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	localeID := botLocaleID(plan.LocaleID.ValueString(), plan.BotID.ValueString(), plan.BotVersion.ValueString())
+	status, err := syncBotLocale(ctx, conn, localeID, plan.RebuildBotLocale.ValueBool(), r.CreateTimeout(ctx, plan.Timeouts))
+	plan.BotLocaleStatus = flex.StringValueToFramework(ctx, status)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBotLocale, localeID, err),
+			err.Error(),
+		)
+		return
+	}
 }
 
 func (r *resourceSlot) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -664,6 +685,21 @@ func (r *resourceSlot) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	localeID := botLocaleID(state.LocaleID.ValueString(), state.BotID.ValueString(), state.BotVersion.ValueString())
+	status, err := syncBotLocale(ctx, conn, localeID, false, 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionReading, ResNameBotLocale, localeID, err),
+			err.Error(),
+		)
+		return
+	}
+	state.BotLocaleStatus = flex.StringValueToFramework(ctx, status)
+
+	if state.RebuildBotLocale.IsNull() {
+		state.RebuildBotLocale = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -677,7 +713,8 @@ func (r *resourceSlot) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	if slotHasChanges(ctx, plan, state) {
+	change := slotHasChanges(ctx, plan, state)
+	if change {
 		input := &lexmodelsv2.UpdateSlotInput{}
 
 		resp.Diagnostics.Append(flex.Expand(ctx, plan, input, slotFlexOpt)...)
@@ -707,7 +744,21 @@ func (r *resourceSlot) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
+	// Rebuild after slot changes, or when rebuilding is first enabled.
+	build := plan.RebuildBotLocale.ValueBool() && (change || !state.RebuildBotLocale.ValueBool())
+	localeID := botLocaleID(plan.LocaleID.ValueString(), plan.BotID.ValueString(), plan.BotVersion.ValueString())
+	status, err := syncBotLocale(ctx, conn, localeID, build, r.UpdateTimeout(ctx, plan.Timeouts))
+	plan.BotLocaleStatus = flex.StringValueToFramework(ctx, status)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBotLocale, localeID, err),
+			err.Error(),
+		)
+		return
+	}
 }
 
 func (r *resourceSlot) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -782,6 +833,7 @@ func findSlotByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*le
 
 type resourceSlotData struct {
 	BotID                   types.String                                                 `tfsdk:"bot_id"`
+	BotLocaleStatus         types.String                                                 `tfsdk:"bot_locale_status"`
 	BotVersion              types.String                                                 `tfsdk:"bot_version"`
 	Description             types.String                                                 `tfsdk:"description"`
 	ID                      types.String                                                 `tfsdk:"id"`
@@ -791,6 +843,7 @@ type resourceSlotData struct {
 	MultipleValuesSetting   fwtypes.ListNestedObjectValueOf[MultipleValuesSettingData]   `tfsdk:"multiple_values_setting"`
 	Name                    types.String                                                 `tfsdk:"name"`
 	ObfuscationSetting      fwtypes.ListNestedObjectValueOf[ObfuscationSettingData]      `tfsdk:"obfuscation_setting"`
+	RebuildBotLocale        types.Bool                                                   `tfsdk:"rebuild_bot_locale"`
 	Timeouts                timeouts.Value                                               `tfsdk:"timeouts"`
 	SlotTypeID              types.String                                                 `tfsdk:"slot_type_id"`
 	ValueElicitationSetting fwtypes.ListNestedObjectValueOf[ValueElicitationSettingData] `tfsdk:"value_elicitation_setting"`
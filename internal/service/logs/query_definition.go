@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -38,6 +39,8 @@ func resourceQueryDefinition() *schema.Resource {
 			StateContext: resourceQueryDefinitionImport,
 		},
 
+		CustomizeDiff: resourceQueryDefinitionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrName: {
 				Type:     schema.TypeString,
@@ -52,13 +55,19 @@ func resourceQueryDefinition() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validLogGroupName,
+					ValidateFunc: validation.Any(validLogGroupName, verify.ValidARN),
 				},
 			},
 			"query_definition_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"query_language": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.QueryLanguage](),
+			},
 			"query_string": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -81,6 +90,10 @@ func resourceQueryDefinitionPut(ctx context.Context, d *schema.ResourceData, met
 		input.LogGroupNames = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("query_language"); ok {
+		input.QueryLanguage = awstypes.QueryLanguage(v.(string))
+	}
+
 	if !d.IsNewResource() {
 		input.QueryDefinitionId = aws.String(d.Id())
 	}
@@ -114,9 +127,10 @@ func resourceQueryDefinitionRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Query Definition (%s): %s", d.Id(), err)
 	}
 
-	d.Set("log_group_names", result.LogGroupNames)
+	d.Set("log_group_names", flattenQueryDefinitionLogGroupNames(d.Get("log_group_names").([]interface{}), result.LogGroupNames))
 	d.Set(names.AttrName, result.Name)
 	d.Set("query_definition_id", result.QueryDefinitionId)
+	d.Set("query_language", result.QueryLanguage)
 	d.Set("query_string", result.QueryString)
 
 	return diags
@@ -164,14 +178,30 @@ func resourceQueryDefinitionImport(ctx context.Context, d *schema.ResourceData,
 }
 
 func findQueryDefinitionByTwoPartKey(ctx context.Context, conn *cloudwatchlogs.Client, name, queryDefinitionID string) (*awstypes.QueryDefinition, error) {
-	input := cloudwatchlogs.DescribeQueryDefinitionsInput{}
-	if name != "" {
-		input.QueryDefinitionNamePrefix = aws.String(name)
+	var err error
+
+	// Query definitions are listed per query language, defaulting to Logs Insights QL.
+	for _, queryLanguage := range []awstypes.QueryLanguage{"", awstypes.QueryLanguagePpl, awstypes.QueryLanguageSql} {
+		input := cloudwatchlogs.DescribeQueryDefinitionsInput{
+			QueryLanguage: queryLanguage,
+		}
+		if name != "" {
+			input.QueryDefinitionNamePrefix = aws.String(name)
+		}
+
+		var output *awstypes.QueryDefinition
+		output, err = findQueryDefinition(ctx, conn, &input, func(v *awstypes.QueryDefinition) bool {
+			return aws.ToString(v.QueryDefinitionId) == queryDefinitionID
+		})
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		return output, err
 	}
 
-	return findQueryDefinition(ctx, conn, &input, func(v *awstypes.QueryDefinition) bool {
-		return aws.ToString(v.QueryDefinitionId) == queryDefinitionID
-	})
+	return nil, err
 }
 
 func findQueryDefinition(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeQueryDefinitionsInput, filter tfslices.Predicate[*awstypes.QueryDefinition]) (*awstypes.QueryDefinition, error) {
@@ -207,3 +237,30 @@ func findQueryDefinitions(ctx context.Context, conn *cloudwatchlogs.Client, inpu
 
 	return output, nil
 }
+
+func resourceQueryDefinitionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// OpenSearch SQL queries specify their log groups in the query string.
+	if awstypes.QueryLanguage(diff.Get("query_language").(string)) == awstypes.QueryLanguageSql && len(diff.Get("log_group_names").([]interface{})) > 0 {
+		return fmt.Errorf("log_group_names cannot be specified when query_language is %s", awstypes.QueryLanguageSql)
+	}
+
+	return nil
+}
+
+// flattenQueryDefinitionLogGroupNames returns the API's log group identifiers, preserving
+// any configured log group ARN that the API returns with a trailing ":*".
+func flattenQueryDefinitionLogGroupNames(configured []interface{}, apiObjects []string) []string {
+	tfList := make([]string, 0, len(apiObjects))
+
+	for i, apiObject := range apiObjects {
+		if i < len(configured) {
+			if v, ok := configured[i].(string); ok && arn.IsARN(v) && trimLogGroupARNWildcardSuffix(apiObject) == trimLogGroupARNWildcardSuffix(v) {
+				apiObject = v
+			}
+		}
+
+		tfList = append(tfList, apiObject)
+	}
+
+	return tfList
+}
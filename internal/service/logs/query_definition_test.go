@@ -48,6 +48,7 @@ func TestAccLogsQueryDefinition_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "query_string", expectedQueryString),
 					resource.TestCheckResourceAttr(resourceName, "log_group_names.#", "0"),
 					resource.TestMatchResourceAttr(resourceName, "query_definition_id", regexache.MustCompile(verify.UUIDRegexPattern)),
+					resource.TestCheckResourceAttr(resourceName, "query_language", "CWLI"),
 				),
 			},
 			{
@@ -223,6 +224,86 @@ func testAccCheckQueryDefinitionDestroy(ctx context.Context) resource.TestCheckF
 	}
 }
 
+func TestAccLogsQueryDefinition_queryLanguage(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.QueryDefinition
+	resourceName := "aws_cloudwatch_query_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueryDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDefinitionConfig_queryLanguagePPL(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueryDefinitionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_group_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "query_language", "PPL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccQueryDefinitionImportStateID(ctx, &v),
+			},
+			{
+				Config: testAccQueryDefinitionConfig_queryLanguageSQL(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueryDefinitionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_group_names.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "query_language", "SQL"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsQueryDefinition_QueryLanguage_sqlLogGroupNames(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueryDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueryDefinitionConfig_queryLanguageSQLLogGroupNames(rName),
+				ExpectError: regexache.MustCompile(`log_group_names cannot be specified when query_language is SQL`),
+			},
+		},
+	})
+}
+
+func TestAccLogsQueryDefinition_logGroupARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.QueryDefinition
+	resourceName := "aws_cloudwatch_query_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueryDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDefinitionConfig_logGroupARNs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueryDefinitionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_group_names.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_names.0", "aws_cloudwatch_log_group.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccQueryDefinitionConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_query_definition" "test" {
@@ -258,3 +339,68 @@ resource "aws_cloudwatch_log_group" "test" {
 }
 `, rName, count)
 }
+
+func testAccQueryDefinitionConfig_queryLanguagePPL(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_query_definition" "test" {
+  name           = %[1]q
+  query_language = "PPL"
+
+  log_group_names = [aws_cloudwatch_log_group.test.name]
+
+  query_string = "fields `+"`@timestamp`"+`, `+"`@message`"+` | head 20"
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccQueryDefinitionConfig_queryLanguageSQL(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_query_definition" "test" {
+  name           = %[1]q
+  query_language = "SQL"
+
+  query_string = "SELECT `+"`@timestamp`"+`, `+"`@message`"+` FROM `+"`${aws_cloudwatch_log_group.test.name}`"+` LIMIT 20"
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccQueryDefinitionConfig_queryLanguageSQLLogGroupNames(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_query_definition" "test" {
+  name           = %[1]q
+  query_language = "SQL"
+
+  log_group_names = [%[1]q]
+
+  query_string = "SELECT * FROM `+"`%[1]s`"+` LIMIT 20"
+}
+`, rName)
+}
+
+func testAccQueryDefinitionConfig_logGroupARNs(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_query_definition" "test" {
+  name = %[1]q
+
+  log_group_names = [aws_cloudwatch_log_group.test.arn]
+
+  query_string = <<EOF
+fields @timestamp, @message
+| sort @timestamp desc
+| limit 20
+EOF
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
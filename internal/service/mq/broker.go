@@ -542,6 +542,7 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).MQClient(ctx)
 
 	requiresReboot := false
+	maintenanceWindowChanged := false
 
 	if d.HasChange(names.AttrSecurityGroups) {
 		input := &mq.UpdateBrokerInput{
@@ -633,6 +634,8 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) maintenance window start time: %s", d.Id(), err)
 		}
+
+		maintenanceWindowChanged = true
 	}
 
 	if d.HasChange("data_replication_mode") {
@@ -662,6 +665,18 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		if _, err := waitBrokerRebooted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) reboot: %s", d.Id(), err)
 		}
+	} else if requiresReboot || maintenanceWindowChanged {
+		// Scheduled changes are applied during the maintenance window that is currently in effect,
+		// and a new maintenance window start time only takes effect after that window has passed.
+		o, _ := d.GetChange("maintenance_window_start_time")
+		window := describeNextMaintenanceWindow(time.Now(), expandWeeklyStartTime(o.([]interface{})))
+
+		if requiresReboot {
+			diags = sdkdiag.AppendWarningf(diags, "MQ Broker (%s) changes have been scheduled and will be applied during the next maintenance window%s. Set apply_immediately to true to reboot the broker and apply them now.", d.Id(), window)
+		}
+		if maintenanceWindowChanged {
+			diags = sdkdiag.AppendWarningf(diags, "MQ Broker (%s) maintenance window start time change will take effect after the next maintenance window%s.", d.Id(), window)
+		}
 	}
 
 	return diags
@@ -1090,6 +1105,63 @@ func flattenUsers(users []*types.User, cfgUsers []interface{}) *schema.Set {
 	return schema.NewSet(resourceUserHash, out)
 }
 
+// nextMaintenanceWindowStart returns the first start of the specified weekly maintenance window after now.
+func nextMaintenanceWindowStart(now time.Time, apiObject *types.WeeklyStartTime) (time.Time, bool) {
+	if apiObject == nil {
+		return time.Time{}, false
+	}
+
+	location := time.UTC
+	if v := aws.ToString(apiObject.TimeZone); v != "" {
+		var err error
+		location, err = time.LoadLocation(v)
+
+		if err != nil {
+			return time.Time{}, false
+		}
+	}
+
+	timeOfDay, err := time.Parse("15:04", aws.ToString(apiObject.TimeOfDay))
+
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	weekday := -1
+	for v := time.Sunday; v <= time.Saturday; v++ {
+		if strings.EqualFold(v.String(), string(apiObject.DayOfWeek)) {
+			weekday = int(v)
+			break
+		}
+	}
+
+	if weekday < 0 {
+		return time.Time{}, false
+	}
+
+	now = now.In(location)
+	next := time.Date(now.Year(), now.Month(), now.Day(), timeOfDay.Hour(), timeOfDay.Minute(), 0, 0, location)
+	next = next.AddDate(0, 0, (weekday-int(now.Weekday())+7)%7)
+
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 7)
+	}
+
+	return next, true
+}
+
+func describeNextMaintenanceWindow(now time.Time, apiObject *types.WeeklyStartTime) string {
+	if v, ok := nextMaintenanceWindowStart(now, apiObject); ok {
+		return fmt.Sprintf(" (starting %s)", v.Format(time.RFC3339))
+	}
+
+	if apiObject != nil {
+		return fmt.Sprintf(" (%s %s %s)", apiObject.DayOfWeek, aws.ToString(apiObject.TimeOfDay), aws.ToString(apiObject.TimeZone))
+	}
+
+	return ""
+}
+
 func expandWeeklyStartTime(cfg []interface{}) *types.WeeklyStartTime {
 	if len(cfg) < 1 {
 		return nil
@@ -317,6 +317,87 @@ func TestNormalizeEngineVersion(t *testing.T) {
 	}
 }
 
+func TestNextMaintenanceWindowStart(t *testing.T) {
+	t.Parallel()
+
+	// Thursday.
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		window  *types.WeeklyStartTime
+		want    time.Time
+		wantNil bool
+	}{
+		{
+			name:    "nil",
+			wantNil: true,
+		},
+		{
+			name: "later this week",
+			window: &types.WeeklyStartTime{
+				DayOfWeek: types.DayOfWeekSaturday,
+				TimeOfDay: aws.String("02:00"),
+				TimeZone:  aws.String("UTC"),
+			},
+			want: time.Date(2026, time.October, 17, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "later today",
+			window: &types.WeeklyStartTime{
+				DayOfWeek: types.DayOfWeekThursday,
+				TimeOfDay: aws.String("13:30"),
+			},
+			want: time.Date(2026, time.October, 15, 13, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "earlier today",
+			window: &types.WeeklyStartTime{
+				DayOfWeek: types.DayOfWeekThursday,
+				TimeOfDay: aws.String("11:00"),
+				TimeZone:  aws.String("UTC"),
+			},
+			want: time.Date(2026, time.October, 22, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "other time zone",
+			window: &types.WeeklyStartTime{
+				DayOfWeek: types.DayOfWeekFriday,
+				TimeOfDay: aws.String("00:30"),
+				TimeZone:  aws.String("Asia/Tokyo"),
+			},
+			want: time.Date(2026, time.October, 15, 15, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "unknown time zone",
+			window: &types.WeeklyStartTime{
+				DayOfWeek: types.DayOfWeekFriday,
+				TimeOfDay: aws.String("00:30"),
+				TimeZone:  aws.String("UTC+3"),
+			},
+			wantNil: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := tfmq.NextMaintenanceWindowStart(now, tt.window)
+
+			if tt.wantNil {
+				if ok {
+					t.Errorf("NextMaintenanceWindowStart() = %v, want none", got)
+				}
+				return
+			}
+
+			if !ok || !got.Equal(tt.want) {
+				t.Errorf("NextMaintenanceWindowStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 const (
 	testAccBrokerVersionNewer = "5.17.6"  // before changing, check b/c must be valid on GovCloud
 	testAccBrokerVersionOlder = "5.16.7"  // before changing, check b/c must be valid on GovCloud
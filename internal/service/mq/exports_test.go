@@ -11,7 +11,8 @@ var (
 	FindBrokerByID        = findBrokerByID
 	FindConfigurationByID = findConfigurationByID
 
-	NormalizeEngineVersion = normalizeEngineVersion
+	NextMaintenanceWindowStart = nextMaintenanceWindowStart
+	NormalizeEngineVersion     = normalizeEngineVersion
 
 	WaitBrokerRebooted = waitBrokerRebooted
 	WaitBrokerDeleted  = waitBrokerDeleted
@@ -41,8 +41,38 @@ func resourceRuleGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
+				"analysis_results": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"analysis_detail": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"identified_rule_ids": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"identified_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"analyze_rule_group": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				names.AttrARN: {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -469,10 +499,11 @@ func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	name := d.Get(names.AttrName).(string)
 	input := &networkfirewall.CreateRuleGroupInput{
-		Capacity:      aws.Int32(int32(d.Get("capacity").(int))),
-		RuleGroupName: aws.String(name),
-		Tags:          getTagsIn(ctx),
-		Type:          awstypes.RuleGroupType(d.Get(names.AttrType).(string)),
+		AnalyzeRuleGroup: d.Get("analyze_rule_group").(bool),
+		Capacity:         aws.Int32(int32(d.Get("capacity").(int))),
+		RuleGroupName:    aws.String(name),
+		Tags:             getTagsIn(ctx),
+		Type:             awstypes.RuleGroupType(d.Get(names.AttrType).(string)),
 	}
 
 	if v, ok := d.GetOk(names.AttrDescription); ok {
@@ -506,7 +537,11 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
 
-	output, err := findRuleGroupByARN(ctx, conn, d.Id())
+	input := &networkfirewall.DescribeRuleGroupInput{
+		AnalyzeRuleGroup: d.Get("analyze_rule_group").(bool),
+		RuleGroupArn:     aws.String(d.Id()),
+	}
+	output, err := findRuleGroup(ctx, conn, input)
 
 	if err == nil && output.RuleGroup == nil {
 		err = tfresource.NewEmptyResultError(d.Id())
@@ -523,6 +558,9 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	response := output.RuleGroupResponse
+	if err := d.Set("analysis_results", flattenAnalysisResults(response.AnalysisResults)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting analysis_results: %s", err)
+	}
 	d.Set(names.AttrARN, response.RuleGroupArn)
 	d.Set("capacity", response.Capacity)
 	d.Set(names.AttrDescription, response.Description)
@@ -545,8 +583,9 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
 
-	if d.HasChanges(names.AttrDescription, names.AttrEncryptionConfiguration, "rule_group", "rules", names.AttrType) {
+	if d.HasChanges("analyze_rule_group", names.AttrDescription, names.AttrEncryptionConfiguration, "rule_group", "rules", names.AttrType) {
 		input := &networkfirewall.UpdateRuleGroupInput{
+			AnalyzeRuleGroup:        d.Get("analyze_rule_group").(bool),
 			EncryptionConfiguration: expandEncryptionConfiguration(d.Get(names.AttrEncryptionConfiguration).([]interface{})),
 			RuleGroupArn:            aws.String(d.Id()),
 			Type:                    awstypes.RuleGroupType(d.Get(names.AttrType).(string)),
@@ -580,7 +619,23 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			}
 		}
 
-		_, err := conn.UpdateRuleGroup(ctx, input)
+		_, err := tfresource.RetryWhenIsA[*awstypes.InvalidTokenException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			output, err := conn.UpdateRuleGroup(ctx, input)
+
+			// The update token is invalidated by any concurrent change to the rule group
+			// (including a previous attempt that timed out client side), so refresh it before retrying.
+			if errs.IsA[*awstypes.InvalidTokenException](err) {
+				ruleGroup, findErr := findRuleGroupByARN(ctx, conn, d.Id())
+
+				if findErr != nil {
+					return nil, findErr
+				}
+
+				input.UpdateToken = ruleGroup.UpdateToken
+			}
+
+			return output, err
+		})
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Rule Group (%s): %s", d.Id(), err)
@@ -624,6 +679,10 @@ func findRuleGroupByARN(ctx context.Context, conn *networkfirewall.Client, arn s
 		RuleGroupArn: aws.String(arn),
 	}
 
+	return findRuleGroup(ctx, conn, input)
+}
+
+func findRuleGroup(ctx context.Context, conn *networkfirewall.Client, input *networkfirewall.DescribeRuleGroupInput) (*networkfirewall.DescribeRuleGroupOutput, error) {
 	output, err := conn.DescribeRuleGroup(ctx, input)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
@@ -1123,6 +1182,26 @@ func flattenRuleGroup(apiObject *awstypes.RuleGroup) []interface{} {
 	return []interface{}{tfMap}
 }
 
+func flattenAnalysisResults(apiObjects []awstypes.AnalysisResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"analysis_detail":     aws.ToString(apiObject.AnalysisDetail),
+			"identified_rule_ids": apiObject.IdentifiedRuleIds,
+			"identified_type":     string(apiObject.IdentifiedType),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenReferenceSets(apiObject *awstypes.ReferenceSets) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
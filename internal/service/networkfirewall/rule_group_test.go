@@ -248,6 +248,48 @@ func TestAccNetworkFirewallRuleGroup_Basic_statelessRule(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_analyzeRuleGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_analyzeRuleGroup(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "analysis_results.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "analyze_rule_group", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccRuleGroupConfig_analyzeRuleGroup(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "analysis_results.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "analysis_results.0.analysis_detail"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_results.0.identified_rule_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_results.0.identified_rule_ids.0", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_results.0.identified_type", string(awstypes.IdentifiedTypeStatelessRuleForwardingAsymmetrically)),
+					resource.TestCheckResourceAttr(resourceName, "analyze_rule_group", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"analysis_results", "analyze_rule_group"},
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_Basic_rules(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -1537,6 +1579,41 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName)
 }
 
+func testAccRuleGroupConfig_analyzeRuleGroup(rName string, analyze bool) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  analyze_rule_group = %[2]t
+  capacity           = 100
+  name               = %[1]q
+  type               = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:pass"]
+
+            match_attributes {
+              destination {
+                address_definition = "20.1.0.0/24"
+              }
+
+              source {
+                address_definition = "10.1.0.0/24"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, analyze)
+}
+
 func testAccRuleGroupConfig_updateStateless(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
@@ -170,7 +170,11 @@ func resourceAttachmentAccepterCreate(ctx context.Context, d *schema.ResourceDat
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "accepting Network Manager Attachment (%s): %s", attachmentID, err)
 		}
+	}
 
+	// Once accepted the attachment passes through PENDING_NETWORK_UPDATE while it is propagated to the core network edges.
+	// Segment and edge location are only reported once the attachment is AVAILABLE.
+	if state != awstypes.AttachmentStateAvailable {
 		switch attachmentType {
 		case awstypes.AttachmentTypeVpc:
 			if _, err := waitVPCAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
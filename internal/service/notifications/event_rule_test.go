@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notifications_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/notifications"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnotifications "github.com/hashicorp/terraform-provider-aws/internal/service/notifications"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNotificationsEventRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v notifications.GetEventRuleOutput
+	resourceName := "aws_notifications_event_rule.test"
+	configurationResourceName := "aws_notifications_notification_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(ctx, resourceName, names.AttrARN, "notifications", regexache.MustCompile(`configuration/.+/rule/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "event_type", "CloudWatch Alarm State Change"),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_configuration_arn", configurationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSource, "aws.cloudwatch"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccNotificationsEventRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v notifications.GetEventRuleOutput
+	resourceName := "aws_notifications_event_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfnotifications.ResourceEventRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNotificationsEventRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v notifications.GetEventRuleOutput
+	resourceName := "aws_notifications_event_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "1"),
+				),
+			},
+			{
+				Config: testAccEventRuleConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "event_pattern"),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.Region()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckEventRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NotificationsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_notifications_event_rule" {
+				continue
+			}
+
+			_, err := tfnotifications.FindEventRuleByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("User Notifications Event Rule %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEventRuleExists(ctx context.Context, n string, v *notifications.GetEventRuleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NotificationsClient(ctx)
+
+		output, err := tfnotifications.FindEventRuleByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEventRuleConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_notifications_notification_configuration" "test" {
+  name        = %[1]q
+  description = %[1]q
+}
+`, rName)
+}
+
+func testAccEventRuleConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEventRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_notifications_event_rule" "test" {
+  notification_configuration_arn = aws_notifications_notification_configuration.test.arn
+  source                         = "aws.cloudwatch"
+  event_type                     = "CloudWatch Alarm State Change"
+  regions                        = [%[1]q]
+}
+`, acctest.Region()))
+}
+
+func testAccEventRuleConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccEventRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_notifications_event_rule" "test" {
+  notification_configuration_arn = aws_notifications_notification_configuration.test.arn
+  source                         = "aws.cloudwatch"
+  event_type                     = "CloudWatch Alarm State Change"
+  regions                        = [%[1]q, %[2]q]
+
+  event_pattern = jsonencode({
+    detail = {
+      state = {
+        value = ["ALARM"]
+      }
+    }
+  })
+}
+`, acctest.Region(), acctest.AlternateRegion()))
+}
@@ -41,14 +41,12 @@ func resourceDomainPolicy() *schema.Resource {
 				Required: true,
 			},
 			"access_policies": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc:             normalizeAccessPolicies,
 			},
 		},
 	}
@@ -92,6 +90,11 @@ func resourceDomainPolicyUpsert(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", policy, err)
 	}
 
+	// A domain that is still being created or modified may reject or silently drop the change.
+	if err := waitForDomainProcessingComplete(ctx, conn, domainName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain Policy (%s): waiting for domain: %s", domainName, err)
+	}
+
 	_, err = tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ValidationException](ctx, propagationTimeout,
 		func() (interface{}, error) {
 			return conn.UpdateDomainConfig(ctx, &opensearch.UpdateDomainConfigInput{
@@ -117,6 +120,14 @@ func resourceDomainPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
 
+	if err := waitForDomainProcessingComplete(ctx, conn, d.Get(names.AttrDomainName).(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Domain Policy (%s): waiting for domain: %s", d.Id(), err)
+	}
+
 	_, err := conn.UpdateDomainConfig(ctx, &opensearch.UpdateDomainConfigInput{
 		DomainName:     aws.String(d.Get(names.AttrDomainName).(string)),
 		AccessPolicies: aws.String(""),
@@ -133,3 +144,10 @@ func resourceDomainPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 
 	return diags
 }
+
+// normalizeAccessPolicies is the StateFunc for access_policies, shared by aws_opensearch_domain and
+// aws_opensearch_domain_policy so that moving a policy between them produces no diff.
+func normalizeAccessPolicies(v interface{}) string {
+	json, _ := structure.NormalizeJsonString(v)
+	return json
+}
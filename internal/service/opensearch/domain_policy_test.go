@@ -11,6 +11,7 @@ import (
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -77,6 +78,43 @@ func TestAccOpenSearchDomainPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchDomainPolicy_fromInline(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain awstypes.DomainStatus
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_domain_policy.test"
+	domainResourceName := "aws_opensearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainPolicyConfig_inline(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, domainResourceName, &domain),
+					resource.TestCheckResourceAttrSet(domainResourceName, "access_policies"),
+				),
+			},
+			{
+				Config: testAccDomainPolicyConfig_standalone(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(domainResourceName, plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, domainResourceName, &domain),
+					resource.TestCheckResourceAttrPair(resourceName, "access_policies", domainResourceName, "access_policies"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyMatch(resource, attr, expectedPolicy string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resource]
@@ -141,3 +179,66 @@ POLICIES
 }
 `, randInt, policy)
 }
+
+func testAccDomainPolicyConfig_baseInline(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+locals {
+  # The account ID principal form is returned by the API as the account's root ARN.
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "es:*"
+      Effect    = "Allow"
+      Principal = { AWS = data.aws_caller_identity.current.account_id }
+      Resource  = "arn:${data.aws_partition.current.partition}:es:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:domain/%[1]s/*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccDomainPolicyConfig_inline(rName string) string {
+	return acctest.ConfigCompose(testAccDomainPolicyConfig_baseInline(rName), fmt.Sprintf(`
+resource "aws_opensearch_domain" "test" {
+  domain_name     = %[1]q
+  access_policies = local.policy
+
+  cluster_config {
+    instance_type = "t2.small.search" # supported in both aws and aws-us-gov
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+`, rName))
+}
+
+func testAccDomainPolicyConfig_standalone(rName string) string {
+	return acctest.ConfigCompose(testAccDomainPolicyConfig_baseInline(rName), fmt.Sprintf(`
+resource "aws_opensearch_domain" "test" {
+  domain_name = %[1]q
+
+  cluster_config {
+    instance_type = "t2.small.search" # supported in both aws and aws-us-gov
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+
+resource "aws_opensearch_domain_policy" "test" {
+  domain_name     = aws_opensearch_domain.test.domain_name
+  access_policies = local.policy
+}
+`, rName))
+}
@@ -107,6 +107,22 @@ func waitForDomainUpdate(ctx context.Context, conn *opensearch.Client, domainNam
 	return nil
 }
 
+// waitForDomainProcessingComplete waits for any in-progress creation or change of the domain to complete.
+// Unlike waitForDomainUpdate it returns immediately if the domain isn't processing.
+func waitForDomainProcessingComplete(ctx context.Context, conn *opensearch.Client, domainName string, timeout time.Duration) error {
+	out, err := findDomainByName(ctx, conn, domainName)
+
+	if err != nil {
+		return err
+	}
+
+	if !aws.ToBool(out.Processing) {
+		return nil
+	}
+
+	return waitForDomainUpdate(ctx, conn, domainName, timeout)
+}
+
 func waitForDomainDelete(ctx context.Context, conn *opensearch.Client, domainName string, timeout time.Duration) error {
 	var out *awstypes.DomainStatus
 	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
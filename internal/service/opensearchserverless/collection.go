@@ -314,11 +314,15 @@ func (r *resourceCollection) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
+// collectionStatusActiveEndpointsPending is a pseudo-status used while an ACTIVE
+// collection's endpoints have not yet been populated.
+const collectionStatusActiveEndpointsPending = "ACTIVE_ENDPOINTS_PENDING"
+
 func waitCollectionCreated(ctx context.Context, conn *opensearchserverless.Client, id string, timeout time.Duration) (*awstypes.CollectionDetail, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.CollectionStatusCreating),
+		Pending:    append(enum.Slice(awstypes.CollectionStatusCreating), collectionStatusActiveEndpointsPending),
 		Target:     enum.Slice(awstypes.CollectionStatusActive),
-		Refresh:    statusCollection(ctx, conn, id),
+		Refresh:    statusCollectionEndpoints(ctx, conn, id),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
@@ -375,3 +379,21 @@ func statusCollection(ctx context.Context, conn *opensearchserverless.Client, id
 		return output, string(output.Status), nil
 	}
 }
+
+// statusCollectionEndpoints reports an ACTIVE collection as pending until both
+// its collection and dashboard endpoints are available.
+func statusCollectionEndpoints(ctx context.Context, conn *opensearchserverless.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, status, err := statusCollection(ctx, conn, id)()
+
+		if err != nil || output == nil {
+			return output, status, err
+		}
+
+		if v := output.(*awstypes.CollectionDetail); v.Status == awstypes.CollectionStatusActive && (aws.ToString(v.CollectionEndpoint) == "" || aws.ToString(v.DashboardEndpoint) == "") {
+			return output, collectionStatusActiveEndpointsPending, nil
+		}
+
+		return output, status, nil
+	}
+}
@@ -94,6 +94,40 @@ func TestAccOpenSearchServerlessCollection_standbyReplicas(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchServerlessCollection_standbyReplicasVectorSearch(t *testing.T) {
+	ctx := acctest.Context(t)
+	var collection types.CollectionDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckCollection(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCollectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionConfig_standbyReplicasType(rName, "DISABLED", "VECTORSEARCH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(ctx, resourceName, &collection),
+					resource.TestCheckResourceAttr(resourceName, "standby_replicas", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "VECTORSEARCH"),
+					resource.TestCheckResourceAttrSet(resourceName, "collection_endpoint"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccOpenSearchServerlessCollection_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var collection types.CollectionDetail
@@ -316,6 +350,21 @@ resource "aws_opensearchserverless_collection" "test" {
 	)
 }
 
+func testAccCollectionConfig_standbyReplicasType(rName, standbyReplicas, collectionType string) string {
+	return acctest.ConfigCompose(
+		testAccCollectionBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_opensearchserverless_collection" "test" {
+  name             = %[1]q
+  standby_replicas = %[2]q
+  type             = %[3]q
+
+  depends_on = [aws_opensearchserverless_security_policy.test]
+}
+`, rName, standbyReplicas, collectionType),
+	)
+}
+
 func testAccCollectionConfig_update(rName, description string) string {
 	return acctest.ConfigCompose(
 		testAccCollectionBaseConfig(rName),
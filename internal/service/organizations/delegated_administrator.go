@@ -7,13 +7,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -68,10 +71,13 @@ func resourceDelegatedAdministrator() *schema.Resource {
 				Computed: true,
 			},
 			"service_principal": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(1, 128),
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.AllDiag(
+					validation.ToDiagFunc(validation.StringLenBetween(1, 128)),
+					validateDelegatedAdministratorServicePrincipal,
+				),
 			},
 			names.AttrStatus: {
 				Type:     schema.TypeString,
@@ -95,6 +101,8 @@ func resourceDelegatedAdministratorCreate(ctx context.Context, d *schema.Resourc
 
 	_, err := conn.RegisterDelegatedAdministrator(ctx, input)
 
+	invalidateDelegatedAdministratorCaches()
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Organizations Delegated Administrator (%s): %s", id, err)
 	}
@@ -113,7 +121,7 @@ func resourceDelegatedAdministratorRead(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	delegatedAccount, err := findDelegatedAdministratorByTwoPartKey(ctx, conn, accountID, servicePrincipal)
+	delegatedAccount, delegatedService, err := findDelegatedAdministratorByTwoPartKeyCached(ctx, conn, accountID, servicePrincipal)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Organizations Delegated Administrator %s not found, removing from state", d.Id())
@@ -127,7 +135,7 @@ func resourceDelegatedAdministratorRead(ctx context.Context, d *schema.ResourceD
 
 	d.Set(names.AttrAccountID, accountID)
 	d.Set(names.AttrARN, delegatedAccount.Arn)
-	d.Set("delegation_enabled_date", aws.ToTime(delegatedAccount.DelegationEnabledDate).Format(time.RFC3339))
+	d.Set("delegation_enabled_date", aws.ToTime(delegatedService.DelegationEnabledDate).Format(time.RFC3339))
 	d.Set(names.AttrEmail, delegatedAccount.Email)
 	d.Set("joined_method", delegatedAccount.JoinedMethod)
 	d.Set("joined_timestamp", aws.ToTime(delegatedAccount.JoinedTimestamp).Format(time.RFC3339))
@@ -153,6 +161,8 @@ func resourceDelegatedAdministratorDelete(ctx context.Context, d *schema.Resourc
 		ServicePrincipal: aws.String(servicePrincipal),
 	})
 
+	invalidateDelegatedAdministratorCaches()
+
 	if errs.IsA[*awstypes.AccountNotRegisteredException](err) {
 		return diags
 	}
@@ -174,6 +184,54 @@ func findDelegatedAdministratorByTwoPartKey(ctx context.Context, conn *organizat
 	})
 }
 
+// findDelegatedAdministratorByTwoPartKeyCached is the equivalent of findDelegatedAdministratorByTwoPartKey
+// that serves the account and service delegation from short-lived listings shared by all resources.
+// Refreshing N delegations makes one ListDelegatedAdministrators call plus one ListDelegatedServicesForAccount
+// call per distinct delegated account, rather than N ListDelegatedAdministrators calls.
+func findDelegatedAdministratorByTwoPartKeyCached(ctx context.Context, conn *organizations.Client, accountID, servicePrincipal string) (*awstypes.DelegatedAdministrator, *awstypes.DelegatedService, error) {
+	delegatedAdministrators, err := delegatedAdministratorsCache.Get(ctx, conn, func(ctx context.Context) ([]awstypes.DelegatedAdministrator, error) {
+		input := &organizations.ListDelegatedAdministratorsInput{}
+
+		return findDelegatedAdministrators(ctx, conn, input, tfslices.PredicateTrue[*awstypes.DelegatedAdministrator]())
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delegatedAccount, err := tfresource.AssertSingleValueResult(tfslices.Filter(delegatedAdministrators, func(v awstypes.DelegatedAdministrator) bool {
+		return aws.ToString(v.Id) == accountID
+	}))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delegatedServices, err := delegatedServicesCache.Get(ctx, delegatedServicesCacheKey{conn, accountID}, func(ctx context.Context) ([]awstypes.DelegatedService, error) {
+		return findDelegatedServicesByAccountID(ctx, conn, accountID)
+	})
+
+	if errs.IsA[*awstypes.AccountNotRegisteredException](err) {
+		return nil, nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delegatedService, err := tfresource.AssertSingleValueResult(tfslices.Filter(delegatedServices, func(v awstypes.DelegatedService) bool {
+		return aws.ToString(v.ServicePrincipal) == servicePrincipal
+	}))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return delegatedAccount, delegatedService, nil
+}
+
 func findDelegatedAdministrator(ctx context.Context, conn *organizations.Client, input *organizations.ListDelegatedAdministratorsInput, filter tfslices.Predicate[*awstypes.DelegatedAdministrator]) (*awstypes.DelegatedAdministrator, error) {
 	output, err := findDelegatedAdministrators(ctx, conn, input, filter)
 
@@ -205,6 +263,62 @@ func findDelegatedAdministrators(ctx context.Context, conn *organizations.Client
 	return output, nil
 }
 
+// delegatedAdministratorServicePrincipals are the service principals known to support delegated administration.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_integrate_services_list.html.
+var delegatedAdministratorServicePrincipals = []string{
+	"access-analyzer.amazonaws.com",
+	"account.amazonaws.com",
+	"auditmanager.amazonaws.com",
+	"backup.amazonaws.com",
+	"cloudtrail.amazonaws.com",
+	"compute-optimizer.amazonaws.com",
+	"config-multiaccountsetup.amazonaws.com",
+	"config.amazonaws.com",
+	"cost-optimization-hub.bcm.amazonaws.com",
+	"detective.amazonaws.com",
+	"devops-guru.amazonaws.com",
+	"fms.amazonaws.com",
+	"guardduty.amazonaws.com",
+	"health.amazonaws.com",
+	"iam.amazonaws.com",
+	"inspector2.amazonaws.com",
+	"ipam.amazonaws.com",
+	"license-manager.amazonaws.com",
+	"license-manager.member-account.amazonaws.com",
+	"macie.amazonaws.com",
+	"member.org.stacksets.cloudformation.amazonaws.com",
+	"networkmanager.amazonaws.com",
+	"reachabilityanalyzer.networkinsights.amazonaws.com",
+	"securityhub.amazonaws.com",
+	"securitylake.amazonaws.com",
+	"servicecatalog.amazonaws.com",
+	"ssm.amazonaws.com",
+	"sso.amazonaws.com",
+	"storage-lens.s3.amazonaws.com",
+}
+
+// validateDelegatedAdministratorServicePrincipal warns, rather than errors, on service principals that aren't
+// known to support delegated administration, so that newly launched services can be used before the list is updated.
+func validateDelegatedAdministratorServicePrincipal(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := v.(string)
+	if !ok {
+		return diags
+	}
+
+	if !slices.Contains(delegatedAdministratorServicePrincipals, value) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Unrecognized service principal",
+			Detail:        fmt.Sprintf("%q is not a service principal known to support delegated administration. Registration will fail if AWS Organizations does not support it.", value),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
 const delegatedAdministratorResourceIDSeparator = "/"
 
 func delegatedAdministratorCreateResourceID(accountID, servicePrincipal string) string {
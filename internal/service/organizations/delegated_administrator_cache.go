@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/cache"
+)
+
+const (
+	delegatedAdministratorReadCacheTTL = 30 * time.Second
+)
+
+type delegatedServicesCacheKey struct {
+	conn      *organizations.Client
+	accountID string
+}
+
+var (
+	// Organizations is a global service with low API rate limits. Delegating many services to the same
+	// account results in one resource per service, so the listings used by resource Read are shared
+	// between all delegated administrator resources of a provider instance (keyed by client).
+	delegatedAdministratorsCache = cache.New[*organizations.Client, []awstypes.DelegatedAdministrator](delegatedAdministratorReadCacheTTL)
+	delegatedServicesCache       = cache.New[delegatedServicesCacheKey, []awstypes.DelegatedService](delegatedAdministratorReadCacheTTL)
+)
+
+// invalidateDelegatedAdministratorCaches discards all cached listings.
+// It must be called after any change to delegated administrators.
+func invalidateDelegatedAdministratorCaches() {
+	delegatedAdministratorsCache.Invalidate()
+	delegatedServicesCache.Invalidate()
+}
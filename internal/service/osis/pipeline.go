@@ -95,8 +95,32 @@ func (r *pipelineResource) Schema(ctx context.Context, request resource.SchemaRe
 					stringvalidator.LengthBetween(3, 28),
 				},
 			},
+			"pipeline_state": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.PipelineStatusActive, awstypes.PipelineStatusStopped)...),
+				},
+			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"vpc_endpoint_service": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vpc_endpoints": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcEndpointModel](ctx),
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				ElementType: fwtypes.NewObjectTypeOf[vpcEndpointModel](ctx),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"buffer_options": schema.ListNestedBlock{
@@ -220,6 +244,12 @@ func (r *pipelineResource) Create(ctx context.Context, request resource.CreateRe
 	// Additional fields.
 	input.Tags = getTagsIn(ctx)
 
+	if err := validatePipelineConfiguration(ctx, conn, input.PipelineConfigurationBody); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+
+		return
+	}
+
 	// Retry for IAM eventual consistency.
 	_, err := tfresource.RetryWhenIsA[*awstypes.ValidationException](ctx, propagationTimeout, func() (interface{}, error) {
 		return conn.CreatePipeline(ctx, input)
@@ -241,9 +271,25 @@ func (r *pipelineResource) Create(ctx context.Context, request resource.CreateRe
 		return
 	}
 
+	if data.PipelineState.ValueString() == string(awstypes.PipelineStatusStopped) {
+		pipeline, err = stopPipeline(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+
+			return
+		}
+	}
+
 	// Set values for unknowns.
 	data.IngestEndpointUrls.SetValue = fwflex.FlattenFrameworkStringValueSet(ctx, pipeline.IngestEndpointUrls)
 	data.PipelineARN = fwflex.StringToFramework(ctx, pipeline.PipelineArn)
+	data.PipelineState = fwflex.StringValueToFramework(ctx, pipelineState(pipeline.Status))
+	data.VPCEndpointService = fwflex.StringToFramework(ctx, pipeline.VpcEndpointService)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, pipeline.VpcEndpoints, &data.VPCEndpoints)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -284,6 +330,8 @@ func (r *pipelineResource) Read(ctx context.Context, request resource.ReadReques
 		return
 	}
 
+	data.PipelineState = fwflex.StringValueToFramework(ctx, pipelineState(pipeline.Status))
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -300,6 +348,18 @@ func (r *pipelineResource) Update(ctx context.Context, request resource.UpdateRe
 
 	conn := r.Meta().OpenSearchIngestionClient(ctx)
 
+	name := new.PipelineName.ValueString()
+	stopped := string(awstypes.PipelineStatusStopped)
+
+	// Start a stopped pipeline before updating it so that the new configuration is applied to a running pipeline.
+	if old.PipelineState.ValueString() == stopped && new.PipelineState.ValueString() != stopped {
+		if _, err := startPipeline(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("starting OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+
+			return
+		}
+	}
+
 	if !new.BufferOptions.Equal(old.BufferOptions) ||
 		!new.EncryptionAtRestOptions.Equal(old.EncryptionAtRestOptions) ||
 		!new.LogPublishingOptions.Equal(old.LogPublishingOptions) ||
@@ -312,7 +372,14 @@ func (r *pipelineResource) Update(ctx context.Context, request resource.UpdateRe
 			return
 		}
 
-		name := new.PipelineName.ValueString()
+		if !new.PipelineConfigurationBody.Equal(old.PipelineConfigurationBody) {
+			if err := validatePipelineConfiguration(ctx, conn, input.PipelineConfigurationBody); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+
+				return
+			}
+		}
+
 		_, err := conn.UpdatePipeline(ctx, input)
 
 		if err != nil {
@@ -328,6 +395,14 @@ func (r *pipelineResource) Update(ctx context.Context, request resource.UpdateRe
 		}
 	}
 
+	if old.PipelineState.ValueString() != stopped && new.PipelineState.ValueString() == stopped {
+		if _, err := stopPipeline(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+
+			return
+		}
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -393,6 +468,69 @@ func findPipelineByName(ctx context.Context, conn *osis.Client, name string) (*a
 	return output.Pipeline, nil
 }
 
+// validatePipelineConfiguration checks the pipeline configuration with the service
+// so that configuration errors are reported before the pipeline is changed.
+func validatePipelineConfiguration(ctx context.Context, conn *osis.Client, body *string) error {
+	input := &osis.ValidatePipelineInput{
+		PipelineConfigurationBody: body,
+	}
+
+	output, err := conn.ValidatePipeline(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("validating pipeline configuration: %w", err)
+	}
+
+	if aws.ToBool(output.IsValid) {
+		return nil
+	}
+
+	var messages []error
+	for _, v := range output.Errors {
+		messages = append(messages, errors.New(aws.ToString(v.Message)))
+	}
+
+	if len(messages) == 0 {
+		return errors.New("pipeline configuration is not valid")
+	}
+
+	return fmt.Errorf("pipeline configuration is not valid: %w", errors.Join(messages...))
+}
+
+func startPipeline(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+	input := &osis.StartPipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	if _, err := conn.StartPipeline(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return waitPipelineStarted(ctx, conn, name, timeout)
+}
+
+func stopPipeline(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+	input := &osis.StopPipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	if _, err := conn.StopPipeline(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return waitPipelineStopped(ctx, conn, name, timeout)
+}
+
+// pipelineState returns the desired state that corresponds to a pipeline status.
+func pipelineState(status awstypes.PipelineStatus) string {
+	switch status {
+	case awstypes.PipelineStatusStopped, awstypes.PipelineStatusStopping:
+		return string(awstypes.PipelineStatusStopped)
+	default:
+		return string(awstypes.PipelineStatusActive)
+	}
+}
+
 func statusPipeline(ctx context.Context, conn *osis.Client, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findPipelineByName(ctx, conn, name)
@@ -455,6 +593,52 @@ func waitPipelineUpdated(ctx context.Context, conn *osis.Client, name string, ti
 	return nil, err
 }
 
+func waitPipelineStarted(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PipelineStatusStopped, awstypes.PipelineStatusStarting),
+		Target:     enum.Slice(awstypes.PipelineStatusActive),
+		Refresh:    statusPipeline(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipelineStopped(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PipelineStatusActive, awstypes.PipelineStatusStopping),
+		Target:     enum.Slice(awstypes.PipelineStatusStopped),
+		Refresh:    statusPipeline(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitPipelineDeleted(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.PipelineStatusDeleting),
@@ -489,9 +673,12 @@ type pipelineResourceModel struct {
 	PipelineARN               types.String                                                  `tfsdk:"pipeline_arn"`
 	PipelineConfigurationBody types.String                                                  `tfsdk:"pipeline_configuration_body"`
 	PipelineName              types.String                                                  `tfsdk:"pipeline_name"`
+	PipelineState             types.String                                                  `tfsdk:"pipeline_state"`
 	Tags                      tftags.Map                                                    `tfsdk:"tags"`
 	TagsAll                   tftags.Map                                                    `tfsdk:"tags_all"`
 	Timeouts                  timeouts.Value                                                `tfsdk:"timeouts"`
+	VPCEndpointService        types.String                                                  `tfsdk:"vpc_endpoint_service"`
+	VPCEndpoints              fwtypes.ListNestedObjectValueOf[vpcEndpointModel]             `tfsdk:"vpc_endpoints"`
 	VPCOptions                fwtypes.ListNestedObjectValueOf[vpcOptionsModel]              `tfsdk:"vpc_options"`
 }
 
@@ -526,3 +713,8 @@ type vpcOptionsModel struct {
 	SecurityGroupIDs fwtypes.SetValueOf[types.String] `tfsdk:"security_group_ids"`
 	SubnetIDs        fwtypes.SetValueOf[types.String] `tfsdk:"subnet_ids"`
 }
+
+type vpcEndpointModel struct {
+	VPCEndpointID types.String `tfsdk:"vpc_endpoint_id"`
+	VPCID         types.String `tfsdk:"vpc_id"`
+}
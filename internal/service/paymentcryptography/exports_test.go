@@ -5,8 +5,9 @@ package paymentcryptography
 
 // Exports for use in tests only.
 var (
-	ResourceKey      = newResourceKey
-	ResourceKeyAlias = newResourceKeyAlias
+	ResourceImportedKey = newResourceImportedKey
+	ResourceKey         = newResourceKey
+	ResourceKeyAlias    = newResourceKeyAlias
 
 	FindKeyByID        = findKeyByID
 	FindKeyAliasByName = findkeyAliasByName
@@ -0,0 +1,581 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/paymentcryptography"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/paymentcryptography/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for resource registration to the Provider. DO NOT EDIT.
+// @FrameworkResource("aws_paymentcryptography_imported_key", name="Imported Key")
+// @Tags(identifierAttribute="arn")
+func newResourceImportedKey(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceImportedKey{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameImportedKey = "Imported Key"
+)
+
+type resourceImportedKey struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceImportedKey) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	keyMaterialPath := path.MatchRoot("key_material")
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"deletion_window_in_days": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(defaultDeletionWindowInDays),
+				Validators: []validator.Int64{
+					int64validator.Between(3, 180),
+				},
+			},
+			names.AttrEnabled: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"exportable": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_check_value": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_check_value_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.KeyCheckValueAlgorithm](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_material_wo_version": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"key_origin": schema.StringAttribute{
+				Computed:   true,
+				CustomType: fwtypes.StringEnumType[awstypes.KeyOrigin](),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_state": schema.StringAttribute{
+				Computed:   true,
+				CustomType: fwtypes.StringEnumType[awstypes.KeyState](),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"key_material": schema.SingleNestedBlock{
+				CustomType: fwtypes.NewObjectTypeOf[importKeyMaterialModel](ctx),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
+				},
+				Blocks: map[string]schema.Block{
+					"key_cryptogram": schema.SingleNestedBlock{
+						CustomType: fwtypes.NewObjectTypeOf[importKeyCryptogramModel](ctx),
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(
+								keyMaterialPath.AtName("key_cryptogram"),
+								keyMaterialPath.AtName("root_certificate_public_key"),
+								keyMaterialPath.AtName("tr34_key_block"),
+							),
+						},
+						Attributes: map[string]schema.Attribute{
+							"exportable": schema.BoolAttribute{
+								Required: true,
+							},
+							"import_token": schema.StringAttribute{
+								Required: true,
+							},
+							"wrapped_key_cryptogram_wo": schema.StringAttribute{
+								Required:  true,
+								Sensitive: true,
+								WriteOnly: true,
+							},
+							"wrapping_spec": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.WrappingKeySpec](),
+								Optional:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"key_attributes": importedKeyAttributesBlock(ctx),
+						},
+					},
+					"root_certificate_public_key": schema.SingleNestedBlock{
+						CustomType: fwtypes.NewObjectTypeOf[rootCertificatePublicKeyModel](ctx),
+						Attributes: map[string]schema.Attribute{
+							"public_key_certificate": schema.StringAttribute{
+								Required: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"key_attributes": importedKeyAttributesBlock(ctx),
+						},
+					},
+					"tr34_key_block": schema.SingleNestedBlock{
+						CustomType: fwtypes.NewObjectTypeOf[importTr34KeyBlockModel](ctx),
+						Attributes: map[string]schema.Attribute{
+							"certificate_authority_public_key_identifier": schema.StringAttribute{
+								Required: true,
+							},
+							"import_token": schema.StringAttribute{
+								Required: true,
+							},
+							"key_block_format": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.Tr34KeyBlockFormat](),
+								Required:   true,
+							},
+							"random_nonce": schema.StringAttribute{
+								Optional: true,
+							},
+							"signing_key_certificate": schema.StringAttribute{
+								Required: true,
+							},
+							"wrapped_key_block_wo": schema.StringAttribute{
+								Required:  true,
+								Sensitive: true,
+								WriteOnly: true,
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func importedKeyAttributesBlock(ctx context.Context) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		CustomType: fwtypes.NewObjectTypeOf[keyAttributesModel](ctx),
+		Validators: []validator.Object{
+			objectvalidator.IsRequired(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"key_algorithm": schema.StringAttribute{
+				Required:   true,
+				CustomType: fwtypes.StringEnumType[awstypes.KeyAlgorithm](),
+			},
+			"key_class": schema.StringAttribute{
+				Required:   true,
+				CustomType: fwtypes.StringEnumType[awstypes.KeyClass](),
+			},
+			"key_usage": schema.StringAttribute{
+				Required:   true,
+				CustomType: fwtypes.StringEnumType[awstypes.KeyUsage](),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"key_modes_of_use": schema.SingleNestedBlock{
+				CustomType: fwtypes.NewObjectTypeOf[keyModesOfUseModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"decrypt": schema.BoolAttribute{
+						Optional: true,
+					},
+					"derive_key": schema.BoolAttribute{
+						Optional: true,
+					},
+					"encrypt": schema.BoolAttribute{
+						Optional: true,
+					},
+					"generate": schema.BoolAttribute{
+						Optional: true,
+					},
+					"no_restrictions": schema.BoolAttribute{
+						Optional: true,
+					},
+					"sign": schema.BoolAttribute{
+						Optional: true,
+					},
+					"unwrap": schema.BoolAttribute{
+						Optional: true,
+					},
+					"verify": schema.BoolAttribute{
+						Optional: true,
+					},
+					"wrap": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceImportedKey) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().PaymentCryptographyClient(ctx)
+
+	var plan, config resourceImportedKeyModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	// Write-only attributes are only available in the configuration.
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	keyMaterial, diags := expandImportKeyMaterial(ctx, config.KeyMaterial)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	in := &paymentcryptography.ImportKeyInput{
+		Enabled:                flex.BoolFromFramework(ctx, plan.Enabled),
+		KeyCheckValueAlgorithm: plan.KeyCheckValueAlgorithm.ValueEnum(),
+		KeyMaterial:            keyMaterial,
+		Tags:                   getTagsIn(ctx),
+	}
+
+	out, err := conn.ImportKey(ctx, in)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionCreating, ResNameImportedKey, "", err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.Key == nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionCreating, ResNameImportedKey, "", nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.KeyArn = flex.StringToFramework(ctx, out.Key.KeyArn)
+	plan.setId()
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	created, err := waitKeyCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionWaitingForCreation, ResNameImportedKey, plan.KeyArn.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, created, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceImportedKey) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().PaymentCryptographyClient(ctx)
+
+	var state resourceImportedKeyModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findKeyByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionSetting, ResNameImportedKey, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceImportedKey) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceImportedKeyModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PaymentCryptographyClient(ctx)
+
+	if !old.Enabled.Equal(new.Enabled) {
+		var err error
+		if new.Enabled.ValueBool() {
+			_, err = conn.StartKeyUsage(ctx, &paymentcryptography.StartKeyUsageInput{
+				KeyIdentifier: flex.StringFromFramework(ctx, new.ID),
+			})
+		} else {
+			_, err = conn.StopKeyUsage(ctx, &paymentcryptography.StopKeyUsageInput{
+				KeyIdentifier: flex.StringFromFramework(ctx, new.ID),
+			})
+		}
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionUpdating, ResNameImportedKey, new.KeyArn.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		out, err := findKeyByID(ctx, conn, new.ID.ValueString())
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionSetting, ResNameImportedKey, new.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		response.Diagnostics.Append(flex.Flatten(ctx, out, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceImportedKey) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().PaymentCryptographyClient(ctx)
+
+	var state resourceImportedKeyModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	in := &paymentcryptography.DeleteKeyInput{
+		DeleteKeyInDays: flex.Int32FromFramework(ctx, state.DeletionWindowInDays),
+		KeyIdentifier:   state.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteKey(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		if errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "not in CREATE_COMPLETE state.") {
+			return
+		}
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionDeleting, ResNameImportedKey, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitKeyDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.PaymentCryptography, create.ErrActionWaitingForDeletion, ResNameImportedKey, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceImportedKey) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func (r *resourceImportedKey) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func expandImportKeyMaterial(ctx context.Context, v fwtypes.ObjectValueOf[importKeyMaterialModel]) (awstypes.ImportKeyMaterial, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !data.KeyCryptogram.IsNull():
+		tfObject, d := data.KeyCryptogram.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := awstypes.ImportKeyCryptogram{
+			Exportable:           flex.BoolFromFramework(ctx, tfObject.Exportable),
+			ImportToken:          flex.StringFromFramework(ctx, tfObject.ImportToken),
+			WrappedKeyCryptogram: flex.StringFromFramework(ctx, tfObject.WrappedKeyCryptogramWO),
+			WrappingSpec:         tfObject.WrappingSpec.ValueEnum(),
+		}
+		apiObject.KeyAttributes, d = expandKeyAttributes(ctx, tfObject.KeyAttributes)
+		diags.Append(d...)
+
+		return &awstypes.ImportKeyMaterialMemberKeyCryptogram{Value: apiObject}, diags
+
+	case !data.RootCertificatePublicKey.IsNull():
+		tfObject, d := data.RootCertificatePublicKey.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := awstypes.RootCertificatePublicKey{
+			PublicKeyCertificate: flex.StringFromFramework(ctx, tfObject.PublicKeyCertificate),
+		}
+		apiObject.KeyAttributes, d = expandKeyAttributes(ctx, tfObject.KeyAttributes)
+		diags.Append(d...)
+
+		return &awstypes.ImportKeyMaterialMemberRootCertificatePublicKey{Value: apiObject}, diags
+
+	case !data.Tr34KeyBlock.IsNull():
+		tfObject, d := data.Tr34KeyBlock.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := awstypes.ImportTr34KeyBlock{
+			CertificateAuthorityPublicKeyIdentifier: flex.StringFromFramework(ctx, tfObject.CertificateAuthorityPublicKeyIdentifier),
+			ImportToken:                             flex.StringFromFramework(ctx, tfObject.ImportToken),
+			KeyBlockFormat:                          tfObject.KeyBlockFormat.ValueEnum(),
+			RandomNonce:                             flex.StringFromFramework(ctx, tfObject.RandomNonce),
+			SigningKeyCertificate:                   flex.StringFromFramework(ctx, tfObject.SigningKeyCertificate),
+			WrappedKeyBlock:                         flex.StringFromFramework(ctx, tfObject.WrappedKeyBlockWO),
+		}
+
+		return &awstypes.ImportKeyMaterialMemberTr34KeyBlock{Value: apiObject}, diags
+	}
+
+	return nil, diags
+}
+
+func expandKeyAttributes(ctx context.Context, v fwtypes.ObjectValueOf[keyAttributesModel]) (*awstypes.KeyAttributes, diag.Diagnostics) {
+	var apiObject awstypes.KeyAttributes
+	diags := flex.Expand(ctx, v, &apiObject)
+
+	return &apiObject, diags
+}
+
+type resourceImportedKeyModel struct {
+	KeyArn                 types.String                                        `tfsdk:"arn"`
+	DeletionWindowInDays   types.Int64                                         `tfsdk:"deletion_window_in_days"`
+	Enabled                types.Bool                                          `tfsdk:"enabled"`
+	Exportable             types.Bool                                          `tfsdk:"exportable"`
+	ID                     types.String                                        `tfsdk:"id"`
+	KeyCheckValue          types.String                                        `tfsdk:"key_check_value"`
+	KeyCheckValueAlgorithm fwtypes.StringEnum[awstypes.KeyCheckValueAlgorithm] `tfsdk:"key_check_value_algorithm"`
+	KeyMaterial            fwtypes.ObjectValueOf[importKeyMaterialModel]       `tfsdk:"key_material" autoflex:"-"`
+	KeyMaterialWOVersion   types.Int64                                         `tfsdk:"key_material_wo_version" autoflex:"-"`
+	KeyOrigin              fwtypes.StringEnum[awstypes.KeyOrigin]              `tfsdk:"key_origin"`
+	KeyState               fwtypes.StringEnum[awstypes.KeyState]               `tfsdk:"key_state"`
+	Tags                   tftags.Map                                          `tfsdk:"tags"`
+	TagsAll                tftags.Map                                          `tfsdk:"tags_all"`
+	Timeouts               timeouts.Value                                      `tfsdk:"timeouts"`
+}
+
+func (k *resourceImportedKeyModel) setId() {
+	k.ID = k.KeyArn
+}
+
+type importKeyMaterialModel struct {
+	KeyCryptogram            fwtypes.ObjectValueOf[importKeyCryptogramModel]      `tfsdk:"key_cryptogram"`
+	RootCertificatePublicKey fwtypes.ObjectValueOf[rootCertificatePublicKeyModel] `tfsdk:"root_certificate_public_key"`
+	Tr34KeyBlock             fwtypes.ObjectValueOf[importTr34KeyBlockModel]       `tfsdk:"tr34_key_block"`
+}
+
+type importKeyCryptogramModel struct {
+	Exportable             types.Bool                                   `tfsdk:"exportable"`
+	ImportToken            types.String                                 `tfsdk:"import_token"`
+	KeyAttributes          fwtypes.ObjectValueOf[keyAttributesModel]    `tfsdk:"key_attributes"`
+	WrappedKeyCryptogramWO types.String                                 `tfsdk:"wrapped_key_cryptogram_wo"`
+	WrappingSpec           fwtypes.StringEnum[awstypes.WrappingKeySpec] `tfsdk:"wrapping_spec"`
+}
+
+type rootCertificatePublicKeyModel struct {
+	KeyAttributes        fwtypes.ObjectValueOf[keyAttributesModel] `tfsdk:"key_attributes"`
+	PublicKeyCertificate types.String                              `tfsdk:"public_key_certificate"`
+}
+
+type importTr34KeyBlockModel struct {
+	CertificateAuthorityPublicKeyIdentifier types.String                                    `tfsdk:"certificate_authority_public_key_identifier"`
+	ImportToken                             types.String                                    `tfsdk:"import_token"`
+	KeyBlockFormat                          fwtypes.StringEnum[awstypes.Tr34KeyBlockFormat] `tfsdk:"key_block_format"`
+	RandomNonce                             types.String                                    `tfsdk:"random_nonce"`
+	SigningKeyCertificate                   types.String                                    `tfsdk:"signing_key_certificate"`
+	WrappedKeyBlockWO                       types.String                                    `tfsdk:"wrapped_key_block_wo"`
+}
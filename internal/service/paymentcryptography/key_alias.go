@@ -160,7 +160,7 @@ func (r *keyAliasResource) Update(ctx context.Context, request resource.UpdateRe
 		_, err := conn.UpdateAlias(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading PaymentCryptography key Alias (%s)", new.ID.String()), err.Error())
+			response.Diagnostics.AddError(fmt.Sprintf("updating PaymentCryptography key Alias (%s)", new.ID.String()), err.Error())
 			return
 		}
 	}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_quicksight_asset_bundle_export_job", name="Asset Bundle Export Job")
+func newAssetBundleExportJobResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &assetBundleExportJobResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	resNameAssetBundleExportJob = "Asset Bundle Export Job"
+)
+
+type assetBundleExportJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *assetBundleExportJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"asset_bundle_export_job_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrAWSAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"download_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"export_format": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AssetBundleExportFormat](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"include_all_dependencies": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_folder_members": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IncludeFolderMembers](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_folder_memberships": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_permissions": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_tags": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AssetBundleExportJobStatus](),
+				Computed:   true,
+			},
+			"resource_arns": schema.SetAttribute{
+				CustomType: fwtypes.SetOfARNType,
+				Required:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+			"validation_strategy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleValidationStrategyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"strict_mode_for_all_resources": schema.BoolAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *assetBundleExportJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var plan assetBundleExportJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AWSAccountID.IsUnknown() || plan.AWSAccountID.IsNull() {
+		plan.AWSAccountID = types.StringValue(r.Meta().AccountID(ctx))
+	}
+	awsAccountID, jobID := fwflex.StringValueFromFramework(ctx, plan.AWSAccountID), fwflex.StringValueFromFramework(ctx, plan.AssetBundleExportJobID)
+
+	var input quicksight.StartAssetBundleExportJobInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, plan, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.StartAssetBundleExportJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, resNameAssetBundleExportJob, jobID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = fwflex.StringValueToFramework(ctx, assetBundleJobCreateResourceID(awsAccountID, jobID))
+
+	output, err := waitAssetBundleExportJobSuccessful(ctx, conn, awsAccountID, jobID, r.CreateTimeout(ctx, plan.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionWaitingForCreation, resNameAssetBundleExportJob, jobID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	validationStrategy := plan.ValidationStrategy
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, output, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ValidationStrategy = validationStrategy
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *assetBundleExportJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var state assetBundleExportJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsAccountID, jobID, err := assetBundleJobParseResourceID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, resNameAssetBundleExportJob, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findAssetBundleExportJobByTwoPartKey(ctx, conn, awsAccountID, jobID)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, resNameAssetBundleExportJob, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The API reports a default validation strategy when none was requested.
+	validationStrategy := state.ValidationStrategy
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, output, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ValidationStrategy = validationStrategy
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *assetBundleExportJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Asset bundle export jobs can't be deleted; they expire on their own.
+}
+
+func findAssetBundleExportJobByTwoPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string) (*quicksight.DescribeAssetBundleExportJobOutput, error) {
+	input := &quicksight.DescribeAssetBundleExportJobInput{
+		AssetBundleExportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+	}
+
+	output, err := conn.DescribeAssetBundleExportJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAssetBundleExportJob(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAssetBundleExportJobByTwoPartKey(ctx, conn, awsAccountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobStatus), nil
+	}
+}
+
+func waitAssetBundleExportJobSuccessful(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string, timeout time.Duration) (*quicksight.DescribeAssetBundleExportJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.AssetBundleExportJobStatusQueuedForImmediateExecution, awstypes.AssetBundleExportJobStatusInProgress),
+		Target:     enum.Slice(awstypes.AssetBundleExportJobStatusSuccessful),
+		Refresh:    statusAssetBundleExportJob(ctx, conn, awsAccountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.DescribeAssetBundleExportJobOutput); ok {
+		if output.JobStatus == awstypes.AssetBundleExportJobStatusFailed {
+			tfresource.SetLastError(err, assetBundleExportJobErrors(output.Errors))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func assetBundleExportJobErrors(apiObjects []awstypes.AssetBundleExportJobError) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, fmt.Errorf("%s (%s): %s", aws.ToString(apiObject.Type), aws.ToString(apiObject.Arn), aws.ToString(apiObject.Message)))
+	}
+
+	return errors.Join(errs...)
+}
+
+const assetBundleJobResourceIDSeparator = ","
+
+func assetBundleJobCreateResourceID(awsAccountID, jobID string) string {
+	parts := []string{awsAccountID, jobID}
+	id := strings.Join(parts, assetBundleJobResourceIDSeparator)
+
+	return id
+}
+
+func assetBundleJobParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, assetBundleJobResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sJOB_ID", id, assetBundleJobResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+type assetBundleExportJobResourceModel struct {
+	ARN                      types.String                                                        `tfsdk:"arn"`
+	AssetBundleExportJobID   types.String                                                        `tfsdk:"asset_bundle_export_job_id"`
+	AWSAccountID             types.String                                                        `tfsdk:"aws_account_id"`
+	DownloadURL              types.String                                                        `tfsdk:"download_url"`
+	ExportFormat             fwtypes.StringEnum[awstypes.AssetBundleExportFormat]                `tfsdk:"export_format"`
+	ID                       types.String                                                        `tfsdk:"id"`
+	IncludeAllDependencies   types.Bool                                                          `tfsdk:"include_all_dependencies"`
+	IncludeFolderMembers     fwtypes.StringEnum[awstypes.IncludeFolderMembers]                   `tfsdk:"include_folder_members"`
+	IncludeFolderMemberships types.Bool                                                          `tfsdk:"include_folder_memberships"`
+	IncludePermissions       types.Bool                                                          `tfsdk:"include_permissions"`
+	IncludeTags              types.Bool                                                          `tfsdk:"include_tags"`
+	JobStatus                fwtypes.StringEnum[awstypes.AssetBundleExportJobStatus]             `tfsdk:"job_status"`
+	ResourceARNs             fwtypes.SetValueOf[fwtypes.ARN]                                     `tfsdk:"resource_arns"`
+	Timeouts                 timeouts.Value                                                      `tfsdk:"timeouts"`
+	ValidationStrategy       fwtypes.ListNestedObjectValueOf[assetBundleValidationStrategyModel] `tfsdk:"validation_strategy"`
+}
+
+type assetBundleValidationStrategyModel struct {
+	StrictModeForAllResources types.Bool `tfsdk:"strict_mode_for_all_resources"`
+}
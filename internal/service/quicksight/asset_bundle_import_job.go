@@ -0,0 +1,718 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_quicksight_asset_bundle_import_job", name="Asset Bundle Import Job")
+func newAssetBundleImportJobResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &assetBundleImportJobResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	resNameAssetBundleImportJob = "Asset Bundle Import Job"
+)
+
+type assetBundleImportJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *assetBundleImportJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"asset_bundle_import_job_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrAWSAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"failure_action": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AssetBundleImportFailureAction](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AssetBundleImportJobStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"asset_bundle_import_source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"body": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("s3_uri")),
+							},
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"s3_uri": schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"override_parameters": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportOverrideParametersModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"analysis":  assetBundleImportOverrideParametersBlock[assetBundleImportAnalysisOverrideParametersModel](ctx, "analysis_id"),
+						"dashboard": assetBundleImportOverrideParametersBlock[assetBundleImportDashboardOverrideParametersModel](ctx, "dashboard_id"),
+						"data_set":  assetBundleImportOverrideParametersBlock[assetBundleImportDataSetOverrideParametersModel](ctx, "data_set_id"),
+						"folder": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportFolderOverrideParametersModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"folder_id": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Optional: true,
+									},
+									"parent_folder_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+							},
+						},
+						"resource_id_override_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportResourceIDOverrideConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"prefix_for_all_resources": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"theme": assetBundleImportOverrideParametersBlock[assetBundleImportThemeOverrideParametersModel](ctx, "theme_id"),
+					},
+				},
+			},
+			"override_permissions": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportOverridePermissionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"analysis": assetBundleImportOverridePermissionsBlock[assetBundleImportAnalysisOverridePermissionsModel](ctx, "analysis_ids"),
+						"dashboard": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportDashboardOverridePermissionsModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"dashboard_ids": schema.SetAttribute{
+										CustomType: fwtypes.SetOfStringType,
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"link_sharing_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleLinkSharingConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												names.AttrPermissions: assetBundleResourcePermissionsBlock(ctx),
+											},
+										},
+									},
+									names.AttrPermissions: assetBundleResourcePermissionsBlock(ctx),
+								},
+							},
+						},
+						"data_set":    assetBundleImportOverridePermissionsBlock[assetBundleImportDataSetOverridePermissionsModel](ctx, "data_set_ids"),
+						"data_source": assetBundleImportOverridePermissionsBlock[assetBundleImportDataSourceOverridePermissionsModel](ctx, "data_source_ids"),
+						"folder":      assetBundleImportOverridePermissionsBlock[assetBundleImportFolderOverridePermissionsModel](ctx, "folder_ids"),
+						"theme":       assetBundleImportOverridePermissionsBlock[assetBundleImportThemeOverridePermissionsModel](ctx, "theme_ids"),
+					},
+				},
+			},
+			"override_tags": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportOverrideTagsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"analysis":       assetBundleImportOverrideTagsBlock[assetBundleImportAnalysisOverrideTagsModel](ctx, "analysis_ids"),
+						"dashboard":      assetBundleImportOverrideTagsBlock[assetBundleImportDashboardOverrideTagsModel](ctx, "dashboard_ids"),
+						"data_set":       assetBundleImportOverrideTagsBlock[assetBundleImportDataSetOverrideTagsModel](ctx, "data_set_ids"),
+						"data_source":    assetBundleImportOverrideTagsBlock[assetBundleImportDataSourceOverrideTagsModel](ctx, "data_source_ids"),
+						"folder":         assetBundleImportOverrideTagsBlock[assetBundleImportFolderOverrideTagsModel](ctx, "folder_ids"),
+						"theme":          assetBundleImportOverrideTagsBlock[assetBundleImportThemeOverrideTagsModel](ctx, "theme_ids"),
+						"vpc_connection": assetBundleImportOverrideTagsBlock[assetBundleImportVPCConnectionOverrideTagsModel](ctx, "vpc_connection_ids"),
+					},
+				},
+			},
+			"override_validation_strategy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleValidationStrategyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"strict_mode_for_all_resources": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func assetBundleImportOverrideParametersBlock[T any](ctx context.Context, idAttribute string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				idAttribute: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrName: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func assetBundleImportOverridePermissionsBlock[T any](ctx context.Context, idsAttribute string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				idsAttribute: schema.SetAttribute{
+					CustomType: fwtypes.SetOfStringType,
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrPermissions: assetBundleResourcePermissionsBlock(ctx),
+			},
+		},
+	}
+}
+
+func assetBundleResourcePermissionsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleResourcePermissionsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrActions: schema.SetAttribute{
+					CustomType: fwtypes.SetOfStringType,
+					Required:   true,
+				},
+				"principals": schema.SetAttribute{
+					CustomType: fwtypes.SetOfStringType,
+					Required:   true,
+				},
+			},
+		},
+	}
+}
+
+func assetBundleImportOverrideTagsBlock[T any](ctx context.Context, idsAttribute string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				idsAttribute: schema.SetAttribute{
+					CustomType: fwtypes.SetOfStringType,
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"tag": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleTagModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrKey: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrValue: schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *assetBundleImportJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var plan assetBundleImportJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AWSAccountID.IsUnknown() || plan.AWSAccountID.IsNull() {
+		plan.AWSAccountID = types.StringValue(r.Meta().AccountID(ctx))
+	}
+	awsAccountID, jobID := fwflex.StringValueFromFramework(ctx, plan.AWSAccountID), fwflex.StringValueFromFramework(ctx, plan.AssetBundleImportJobID)
+
+	var input quicksight.StartAssetBundleImportJobInput
+	// The override_tags blocks carry "Tags" fields, which AutoFlex ignores by default.
+	resp.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, fwflex.WithNoIgnoredFieldNames())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, err := expandAssetBundleImportSource(ctx, plan.ImportSource)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("asset_bundle_import_source"), "Invalid asset bundle import source", err.Error())
+		return
+	}
+	input.AssetBundleImportSource = source
+
+	_, err = conn.StartAssetBundleImportJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, resNameAssetBundleImportJob, jobID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = fwflex.StringValueToFramework(ctx, assetBundleJobCreateResourceID(awsAccountID, jobID))
+
+	output, err := waitAssetBundleImportJobSuccessful(ctx, conn, awsAccountID, jobID, r.CreateTimeout(ctx, plan.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionWaitingForCreation, resNameAssetBundleImportJob, jobID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	plan.FailureAction = fwtypes.StringEnumValue(output.FailureAction)
+	plan.JobStatus = fwtypes.StringEnumValue(output.JobStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *assetBundleImportJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var state assetBundleImportJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsAccountID, jobID, err := assetBundleJobParseResourceID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, resNameAssetBundleImportJob, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findAssetBundleImportJobByTwoPartKey(ctx, conn, awsAccountID, jobID)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, resNameAssetBundleImportJob, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The import source and overrides are not read back: the API returns a presigned URL in place of
+	// an inline body and reports defaulted overrides that were never configured.
+	state.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	state.AssetBundleImportJobID = fwflex.StringToFramework(ctx, output.AssetBundleImportJobId)
+	state.AWSAccountID = fwflex.StringValueToFramework(ctx, awsAccountID)
+	state.FailureAction = fwtypes.StringEnumValue(output.FailureAction)
+	state.JobStatus = fwtypes.StringEnumValue(output.JobStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *assetBundleImportJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Asset bundle import jobs can't be deleted and the imported assets are not removed.
+}
+
+func expandAssetBundleImportSource(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[assetBundleImportSourceModel]) (*awstypes.AssetBundleImportSource, error) {
+	data, diags := tfList.ToPtr(ctx)
+	if diags.HasError() || data == nil {
+		return nil, errors.New("exactly one asset_bundle_import_source block is required")
+	}
+
+	apiObject := &awstypes.AssetBundleImportSource{
+		S3Uri: fwflex.StringFromFramework(ctx, data.S3URI),
+	}
+
+	if v := data.Body.ValueString(); v != "" {
+		body, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, fmt.Errorf("body must be base64 encoded: %w", err)
+		}
+
+		apiObject.Body = body
+	}
+
+	return apiObject, nil
+}
+
+func findAssetBundleImportJobByTwoPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string) (*quicksight.DescribeAssetBundleImportJobOutput, error) {
+	input := &quicksight.DescribeAssetBundleImportJobInput{
+		AssetBundleImportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+	}
+
+	output, err := conn.DescribeAssetBundleImportJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAssetBundleImportJob(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAssetBundleImportJobByTwoPartKey(ctx, conn, awsAccountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobStatus), nil
+	}
+}
+
+func waitAssetBundleImportJobSuccessful(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string, timeout time.Duration) (*quicksight.DescribeAssetBundleImportJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.AssetBundleImportJobStatusQueuedForImmediateExecution,
+			awstypes.AssetBundleImportJobStatusInProgress,
+			awstypes.AssetBundleImportJobStatusFailedRollbackInProgress,
+		),
+		Target:     enum.Slice(awstypes.AssetBundleImportJobStatusSuccessful),
+		Refresh:    statusAssetBundleImportJob(ctx, conn, awsAccountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.DescribeAssetBundleImportJobOutput); ok {
+		switch output.JobStatus {
+		case awstypes.AssetBundleImportJobStatusFailed, awstypes.AssetBundleImportJobStatusFailedRollbackCompleted, awstypes.AssetBundleImportJobStatusFailedRollbackError:
+			tfresource.SetLastError(err, errors.Join(assetBundleImportJobErrors(output.Errors), assetBundleImportJobErrors(output.RollbackErrors)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func assetBundleImportJobErrors(apiObjects []awstypes.AssetBundleImportJobError) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, fmt.Errorf("%s (%s): %s", aws.ToString(apiObject.Type), aws.ToString(apiObject.Arn), aws.ToString(apiObject.Message)))
+	}
+
+	return errors.Join(errs...)
+}
+
+type assetBundleImportJobResourceModel struct {
+	ARN                        types.String                                                               `tfsdk:"arn"`
+	AssetBundleImportJobID     types.String                                                               `tfsdk:"asset_bundle_import_job_id"`
+	AWSAccountID               types.String                                                               `tfsdk:"aws_account_id"`
+	FailureAction              fwtypes.StringEnum[awstypes.AssetBundleImportFailureAction]                `tfsdk:"failure_action"`
+	ID                         types.String                                                               `tfsdk:"id"`
+	ImportSource               fwtypes.ListNestedObjectValueOf[assetBundleImportSourceModel]              `tfsdk:"asset_bundle_import_source"`
+	JobStatus                  fwtypes.StringEnum[awstypes.AssetBundleImportJobStatus]                    `tfsdk:"job_status"`
+	OverrideParameters         fwtypes.ListNestedObjectValueOf[assetBundleImportOverrideParametersModel]  `tfsdk:"override_parameters"`
+	OverridePermissions        fwtypes.ListNestedObjectValueOf[assetBundleImportOverridePermissionsModel] `tfsdk:"override_permissions"`
+	OverrideTags               fwtypes.ListNestedObjectValueOf[assetBundleImportOverrideTagsModel]        `tfsdk:"override_tags"`
+	OverrideValidationStrategy fwtypes.ListNestedObjectValueOf[assetBundleValidationStrategyModel]        `tfsdk:"override_validation_strategy"`
+	Timeouts                   timeouts.Value                                                             `tfsdk:"timeouts"`
+}
+
+type assetBundleImportSourceModel struct {
+	Body  types.String `tfsdk:"body"`
+	S3URI types.String `tfsdk:"s3_uri"`
+}
+
+type assetBundleImportOverrideParametersModel struct {
+	Analyses                        fwtypes.ListNestedObjectValueOf[assetBundleImportAnalysisOverrideParametersModel]      `tfsdk:"analysis"`
+	Dashboards                      fwtypes.ListNestedObjectValueOf[assetBundleImportDashboardOverrideParametersModel]     `tfsdk:"dashboard"`
+	DataSets                        fwtypes.ListNestedObjectValueOf[assetBundleImportDataSetOverrideParametersModel]       `tfsdk:"data_set"`
+	Folders                         fwtypes.ListNestedObjectValueOf[assetBundleImportFolderOverrideParametersModel]        `tfsdk:"folder"`
+	ResourceIdOverrideConfiguration fwtypes.ListNestedObjectValueOf[assetBundleImportResourceIDOverrideConfigurationModel] `tfsdk:"resource_id_override_configuration"`
+	Themes                          fwtypes.ListNestedObjectValueOf[assetBundleImportThemeOverrideParametersModel]         `tfsdk:"theme"`
+}
+
+type assetBundleImportAnalysisOverrideParametersModel struct {
+	AnalysisID types.String `tfsdk:"analysis_id"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type assetBundleImportDashboardOverrideParametersModel struct {
+	DashboardID types.String `tfsdk:"dashboard_id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+type assetBundleImportDataSetOverrideParametersModel struct {
+	DataSetID types.String `tfsdk:"data_set_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+type assetBundleImportFolderOverrideParametersModel struct {
+	FolderID        types.String `tfsdk:"folder_id"`
+	Name            types.String `tfsdk:"name"`
+	ParentFolderARN fwtypes.ARN  `tfsdk:"parent_folder_arn"`
+}
+
+type assetBundleImportResourceIDOverrideConfigurationModel struct {
+	PrefixForAllResources types.String `tfsdk:"prefix_for_all_resources"`
+}
+
+type assetBundleImportThemeOverrideParametersModel struct {
+	Name    types.String `tfsdk:"name"`
+	ThemeID types.String `tfsdk:"theme_id"`
+}
+
+type assetBundleImportOverridePermissionsModel struct {
+	Analyses    fwtypes.ListNestedObjectValueOf[assetBundleImportAnalysisOverridePermissionsModel]   `tfsdk:"analysis"`
+	Dashboards  fwtypes.ListNestedObjectValueOf[assetBundleImportDashboardOverridePermissionsModel]  `tfsdk:"dashboard"`
+	DataSets    fwtypes.ListNestedObjectValueOf[assetBundleImportDataSetOverridePermissionsModel]    `tfsdk:"data_set"`
+	DataSources fwtypes.ListNestedObjectValueOf[assetBundleImportDataSourceOverridePermissionsModel] `tfsdk:"data_source"`
+	Folders     fwtypes.ListNestedObjectValueOf[assetBundleImportFolderOverridePermissionsModel]     `tfsdk:"folder"`
+	Themes      fwtypes.ListNestedObjectValueOf[assetBundleImportThemeOverridePermissionsModel]      `tfsdk:"theme"`
+}
+
+type assetBundleResourcePermissionsModel struct {
+	Actions    fwtypes.SetValueOf[types.String] `tfsdk:"actions"`
+	Principals fwtypes.SetValueOf[types.String] `tfsdk:"principals"`
+}
+
+type assetBundleLinkSharingConfigurationModel struct {
+	Permissions fwtypes.ListNestedObjectValueOf[assetBundleResourcePermissionsModel] `tfsdk:"permissions"`
+}
+
+type assetBundleImportAnalysisOverridePermissionsModel struct {
+	AnalysisIDs fwtypes.SetValueOf[types.String]                                     `tfsdk:"analysis_ids"`
+	Permissions fwtypes.ListNestedObjectValueOf[assetBundleResourcePermissionsModel] `tfsdk:"permissions"`
+}
+
+type assetBundleImportDashboardOverridePermissionsModel struct {
+	DashboardIDs             fwtypes.SetValueOf[types.String]                                          `tfsdk:"dashboard_ids"`
+	LinkSharingConfiguration fwtypes.ListNestedObjectValueOf[assetBundleLinkSharingConfigurationModel] `tfsdk:"link_sharing_configuration"`
+	Permissions              fwtypes.ListNestedObjectValueOf[assetBundleResourcePermissionsModel]      `tfsdk:"permissions"`
+}
+
+type assetBundleImportDataSetOverridePermissionsModel struct {
+	DataSetIDs  fwtypes.SetValueOf[types.String]                                     `tfsdk:"data_set_ids"`
+	Permissions fwtypes.ListNestedObjectValueOf[assetBundleResourcePermissionsModel] `tfsdk:"permissions"`
+}
+
+type assetBundleImportDataSourceOverridePermissionsModel struct {
+	DataSourceIDs fwtypes.SetValueOf[types.String]                                     `tfsdk:"data_source_ids"`
+	Permissions   fwtypes.ListNestedObjectValueOf[assetBundleResourcePermissionsModel] `tfsdk:"permissions"`
+}
+
+type assetBundleImportFolderOverridePermissionsModel struct {
+	FolderIDs   fwtypes.SetValueOf[types.String]                                     `tfsdk:"folder_ids"`
+	Permissions fwtypes.ListNestedObjectValueOf[assetBundleResourcePermissionsModel] `tfsdk:"permissions"`
+}
+
+type assetBundleImportThemeOverridePermissionsModel struct {
+	Permissions fwtypes.ListNestedObjectValueOf[assetBundleResourcePermissionsModel] `tfsdk:"permissions"`
+	ThemeIDs    fwtypes.SetValueOf[types.String]                                     `tfsdk:"theme_ids"`
+}
+
+type assetBundleImportOverrideTagsModel struct {
+	Analyses       fwtypes.ListNestedObjectValueOf[assetBundleImportAnalysisOverrideTagsModel]      `tfsdk:"analysis"`
+	Dashboards     fwtypes.ListNestedObjectValueOf[assetBundleImportDashboardOverrideTagsModel]     `tfsdk:"dashboard"`
+	DataSets       fwtypes.ListNestedObjectValueOf[assetBundleImportDataSetOverrideTagsModel]       `tfsdk:"data_set"`
+	DataSources    fwtypes.ListNestedObjectValueOf[assetBundleImportDataSourceOverrideTagsModel]    `tfsdk:"data_source"`
+	Folders        fwtypes.ListNestedObjectValueOf[assetBundleImportFolderOverrideTagsModel]        `tfsdk:"folder"`
+	Themes         fwtypes.ListNestedObjectValueOf[assetBundleImportThemeOverrideTagsModel]         `tfsdk:"theme"`
+	VPCConnections fwtypes.ListNestedObjectValueOf[assetBundleImportVPCConnectionOverrideTagsModel] `tfsdk:"vpc_connection"`
+}
+
+type assetBundleTagModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type assetBundleImportAnalysisOverrideTagsModel struct {
+	AnalysisIDs fwtypes.SetValueOf[types.String]                     `tfsdk:"analysis_ids"`
+	Tags        fwtypes.ListNestedObjectValueOf[assetBundleTagModel] `tfsdk:"tag"`
+}
+
+type assetBundleImportDashboardOverrideTagsModel struct {
+	DashboardIDs fwtypes.SetValueOf[types.String]                     `tfsdk:"dashboard_ids"`
+	Tags         fwtypes.ListNestedObjectValueOf[assetBundleTagModel] `tfsdk:"tag"`
+}
+
+type assetBundleImportDataSetOverrideTagsModel struct {
+	DataSetIDs fwtypes.SetValueOf[types.String]                     `tfsdk:"data_set_ids"`
+	Tags       fwtypes.ListNestedObjectValueOf[assetBundleTagModel] `tfsdk:"tag"`
+}
+
+type assetBundleImportDataSourceOverrideTagsModel struct {
+	DataSourceIDs fwtypes.SetValueOf[types.String]                     `tfsdk:"data_source_ids"`
+	Tags          fwtypes.ListNestedObjectValueOf[assetBundleTagModel] `tfsdk:"tag"`
+}
+
+type assetBundleImportFolderOverrideTagsModel struct {
+	FolderIDs fwtypes.SetValueOf[types.String]                     `tfsdk:"folder_ids"`
+	Tags      fwtypes.ListNestedObjectValueOf[assetBundleTagModel] `tfsdk:"tag"`
+}
+
+type assetBundleImportThemeOverrideTagsModel struct {
+	Tags     fwtypes.ListNestedObjectValueOf[assetBundleTagModel] `tfsdk:"tag"`
+	ThemeIDs fwtypes.SetValueOf[types.String]                     `tfsdk:"theme_ids"`
+}
+
+type assetBundleImportVPCConnectionOverrideTagsModel struct {
+	Tags             fwtypes.ListNestedObjectValueOf[assetBundleTagModel] `tfsdk:"tag"`
+	VPCConnectionIDs fwtypes.SetValueOf[types.String]                     `tfsdk:"vpc_connection_ids"`
+}
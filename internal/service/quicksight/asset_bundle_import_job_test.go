@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQuickSightAssetBundleImportJob_invalidBody(t *testing.T) {
+	ctx := acctest.Context(t)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAssetBundleImportJobConfig_body(rId, "not base64!"),
+				ExpectError: regexache.MustCompile(`body must be base64 encoded`),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAssetBundleImportJob_sourceConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAssetBundleImportJobConfig_sourceConflict(rId),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccAssetBundleImportJobConfig_body(rId, body string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_asset_bundle_import_job" "test" {
+  asset_bundle_import_job_id = %[1]q
+  failure_action             = "ROLLBACK"
+
+  asset_bundle_import_source {
+    body = %[2]q
+  }
+}
+`, rId, body)
+}
+
+func testAccAssetBundleImportJobConfig_sourceConflict(rId string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_asset_bundle_import_job" "test" {
+  asset_bundle_import_job_id = %[1]q
+
+  asset_bundle_import_source {
+    body   = "e30="
+    s3_uri = "s3://example/bundle.qs"
+  }
+}
+`, rId)
+}
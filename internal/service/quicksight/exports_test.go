@@ -5,24 +5,26 @@ package quicksight
 
 // Exports for use in tests only.
 var (
-	ResourceAccountSubscription = resourceAccountSubscription
-	ResourceAnalysis            = resourceAnalysis
-	ResourceDashboard           = resourceDashboard
-	ResourceDataSet             = resourceDataSet
-	ResourceDataSource          = resourceDataSource
-	ResourceFolder              = resourceFolder
-	ResourceFolderMembership    = newFolderMembershipResource
-	ResourceGroup               = resourceGroup
-	ResourceGroupMembership     = resourceGroupMembership
-	ResourceIAMPolicyAssignment = newIAMPolicyAssignmentResource
-	ResourceIngestion           = newIngestionResource
-	ResourceNamespace           = newNamespaceResource
-	ResourceRefreshSchedule     = newRefreshScheduleResource
-	ResourceTemplate            = resourceTemplate
-	ResourceTemplateAlias       = newTemplateAliasResource
-	ResourceTheme               = resourceTheme
-	ResourceUser                = resourceUser
-	ResourceVPCConnection       = newVPCConnectionResource
+	ResourceAccountSubscription  = resourceAccountSubscription
+	ResourceAnalysis             = resourceAnalysis
+	ResourceAssetBundleExportJob = newAssetBundleExportJobResource
+	ResourceAssetBundleImportJob = newAssetBundleImportJobResource
+	ResourceDashboard            = resourceDashboard
+	ResourceDataSet              = resourceDataSet
+	ResourceDataSource           = resourceDataSource
+	ResourceFolder               = resourceFolder
+	ResourceFolderMembership     = newFolderMembershipResource
+	ResourceGroup                = resourceGroup
+	ResourceGroupMembership      = resourceGroupMembership
+	ResourceIAMPolicyAssignment  = newIAMPolicyAssignmentResource
+	ResourceIngestion            = newIngestionResource
+	ResourceNamespace            = newNamespaceResource
+	ResourceRefreshSchedule      = newRefreshScheduleResource
+	ResourceTemplate             = resourceTemplate
+	ResourceTemplateAlias        = newTemplateAliasResource
+	ResourceTheme                = resourceTheme
+	ResourceUser                 = resourceUser
+	ResourceVPCConnection        = newVPCConnectionResource
 
 	DashboardLatestVersion                = dashboardLatestVersion
 	DefaultGroupNamespace                 = defaultGroupNamespace
@@ -30,6 +32,8 @@ var (
 	DefaultUserNamespace                  = defaultUserNamespace
 	FindAccountSubscriptionByID           = findAccountSubscriptionByID
 	FindAnalysisByTwoPartKey              = findAnalysisByTwoPartKey
+	FindAssetBundleExportJobByTwoPartKey  = findAssetBundleExportJobByTwoPartKey
+	FindAssetBundleImportJobByTwoPartKey  = findAssetBundleImportJobByTwoPartKey
 	FindDashboardByThreePartKey           = findDashboardByThreePartKey
 	FindDataSetByTwoPartKey               = findDataSetByTwoPartKey
 	FindDataSourceByTwoPartKey            = findDataSourceByTwoPartKey
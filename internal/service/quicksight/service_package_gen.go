@@ -20,6 +20,16 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newAssetBundleExportJobResource,
+			TypeName: "aws_quicksight_asset_bundle_export_job",
+			Name:     "Asset Bundle Export Job",
+		},
+		{
+			Factory:  newAssetBundleImportJobResource,
+			TypeName: "aws_quicksight_asset_bundle_import_job",
+			Name:     "Asset Bundle Import Job",
+		},
 		{
 			Factory:  newFolderMembershipResource,
 			TypeName: "aws_quicksight_folder_membership",
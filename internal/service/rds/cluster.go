@@ -1515,6 +1515,12 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 		if err == nil {
 			d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+
+			// Write forwarding is only meaningful for secondary clusters, and switching over
+			// the global cluster must not cause the new primary to report drift.
+			if globalClusterWriterARN(globalCluster) != clusterARN && dbc.GlobalWriteForwardingRequested != nil {
+				d.Set("enable_global_write_forwarding", dbc.GlobalWriteForwardingRequested)
+			}
 		} else if tfresource.NotFound(err) || tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Access Denied to API Version: APIGlobalDatabases") { //nolint:revive // Keep comments
 			// Ignore the following API error for regions/partitions that do not support RDS Global Clusters:
 			// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -97,11 +98,21 @@ func resourceClusterParameterGroup() *schema.Resource {
 				},
 				Set: resourceParameterHash,
 			},
+			"pending_reboot_parameters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.ComputedIf("pending_reboot_parameters", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange(names.AttrParameter)
+			}),
+		),
 	}
 }
 
@@ -190,6 +201,11 @@ func resourceClusterParameterGroupRead(ctx context.Context, d *schema.ResourceDa
 
 	parameters = append(parameters, systemParameters...)
 
+	// Use the apply methods reported by AWS, before they are replaced by the configured ones.
+	d.Set("pending_reboot_parameters", pendingRebootParameterNames(parameters))
+
+	parameters = normalizeParameterApplyMethods(parameters, configParameters)
+
 	if err := d.Set(names.AttrParameter, flattenParameters(parameters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
 	}
@@ -208,16 +224,40 @@ func resourceClusterParameterGroupUpdate(ctx context.Context, d *schema.Resource
 		o, n := d.GetChange(names.AttrParameter)
 		os, ns := o.(*schema.Set), n.(*schema.Set)
 
-		for chunk := range slices.Chunk(expandParameters(ns.Difference(os).List()), maxParamModifyChunk) {
-			input := &rds.ModifyDBClusterParameterGroupInput{
-				DBClusterParameterGroupName: aws.String(d.Id()),
-				Parameters:                  chunk,
-			}
-
-			_, err := conn.ModifyDBClusterParameterGroup(ctx, input)
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "modifying RDS Cluster Parameter Group (%s): %s", d.Id(), err)
+		if parameters := expandParameters(ns.Difference(os).List()); len(parameters) > 0 {
+			for len(parameters) > 0 {
+				// Skip parameters that already have the desired value, e.g. those applied
+				// before an earlier apply failed part way through.
+				current, err := findDBClusterParameters(ctx, conn, &rds.DescribeDBClusterParametersInput{
+					DBClusterParameterGroupName: aws.String(d.Id()),
+					Source:                      aws.String(parameterSourceUser),
+				}, tfslices.PredicateTrue[*types.Parameter]())
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Parameter Group (%s) user parameters: %s", d.Id(), err)
+				}
+
+				if parameters = unappliedParameters(parameters, current); len(parameters) == 0 {
+					break
+				}
+
+				chunk := parameters[:min(len(parameters), maxParamModifyChunk)]
+				parameters = parameters[len(chunk):]
+
+				input := &rds.ModifyDBClusterParameterGroupInput{
+					DBClusterParameterGroupName: aws.String(d.Id()),
+					Parameters:                  chunk,
+				}
+
+				_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, parameterGroupModifyTimeout, func() (interface{}, error) {
+					return conn.ModifyDBClusterParameterGroup(ctx, input)
+				}, errCodeThrottling)
+
+				if err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "modifying RDS Cluster Parameter Group (%s): %s", d.Id(), err)
+					// Record the parameters that were applied so that the rest are planned again.
+					return append(diags, resourceClusterParameterGroupRead(ctx, d, meta)...)
+				}
 			}
 		}
 
@@ -117,11 +117,13 @@ const (
 )
 
 const (
-	globalClusterStatusAvailable = "available"
-	globalClusterStatusCreating  = "creating"
-	globalClusterStatusDeleting  = "deleting"
-	globalClusterStatusModifying = "modifying"
-	globalClusterStatusUpgrading = "upgrading"
+	globalClusterStatusAvailable     = "available"
+	globalClusterStatusCreating      = "creating"
+	globalClusterStatusDeleting      = "deleting"
+	globalClusterStatusFailingOver   = "failing-over"
+	globalClusterStatusModifying     = "modifying"
+	globalClusterStatusSwitchingOver = "switching-over"
+	globalClusterStatusUpgrading     = "upgrading"
 )
 
 const (
@@ -323,3 +325,11 @@ const (
 	parameterSourceSystem        = "system"
 	parameterSourceUser          = "user"
 )
+
+const (
+	parameterApplyTypeStatic = "static"
+)
+
+const (
+	parameterGroupModifyTimeout = 3 * time.Minute
+)
@@ -7,6 +7,7 @@ const (
 	errCodeInvalidAction               = "InvalidAction"
 	errCodeInvalidParameterCombination = "InvalidParameterCombination"
 	errCodeInvalidParameterValue       = "InvalidParameterValue"
+	errCodeThrottling                  = "Throttling"
 	errCodeValidationError             = "ValidationError"
 )
 
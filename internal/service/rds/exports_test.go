@@ -63,7 +63,9 @@ var (
 	NewBlueGreenOrchestrator                   = newBlueGreenOrchestrator
 	ParameterGroupModifyChunk                  = parameterGroupModifyChunk
 	ParseDBInstanceARN                         = parseDBInstanceARN
+	PendingRebootParameterNames                = pendingRebootParameterNames
 	ProxyTargetParseResourceID                 = proxyTargetParseResourceID
+	UnappliedParameters                        = unappliedParameters
 	WaitBlueGreenDeploymentDeleted             = waitBlueGreenDeploymentDeleted
 	WaitBlueGreenDeploymentAvailable           = waitBlueGreenDeploymentAvailable
 	WaitDBInstanceAvailable                    = waitDBInstanceAvailable
@@ -51,6 +51,11 @@ func resourceGlobalCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allow_data_loss": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -139,6 +144,12 @@ func resourceGlobalCluster() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_primary_cluster_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -233,6 +244,9 @@ func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
 	d.Set(names.AttrStorageEncrypted, globalCluster.StorageEncrypted)
+	if v := globalClusterWriterARN(globalCluster); v != "" {
+		d.Set("target_primary_cluster_arn", v)
+	}
 
 	oldEngineVersion, newEngineVersion := d.Get(names.AttrEngineVersion).(string), aws.ToString(globalCluster.EngineVersion)
 
@@ -264,7 +278,13 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange("target_primary_cluster_arn") {
+		if err := globalClusterChangePrimary(ctx, conn, d.Id(), d.Get("target_primary_cluster_arn").(string), d.Get("allow_data_loss").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.HasChangesExcept("allow_data_loss", "target_primary_cluster_arn", names.AttrTags, names.AttrTagsAll) {
 		input := &rds.ModifyGlobalClusterInput{
 			DeletionProtection:      aws.Bool(d.Get(names.AttrDeletionProtection).(bool)),
 			GlobalClusterIdentifier: aws.String(d.Id()),
@@ -530,6 +550,28 @@ func waitGlobalClusterDeleted(ctx context.Context, conn *rds.Client, id string,
 	return nil, err
 }
 
+func waitGlobalClusterPrimaryChanged(ctx context.Context, conn *rds.Client, id, dbClusterARN string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		output, err := findGlobalClusterByID(ctx, conn, id)
+
+		if err != nil {
+			return false, err
+		}
+
+		switch status := aws.ToString(output.Status); status {
+		case globalClusterStatusAvailable:
+			return globalClusterWriterARN(output) == dbClusterARN, nil
+		case globalClusterStatusFailingOver, globalClusterStatusModifying, globalClusterStatusSwitchingOver:
+			return false, nil
+		default:
+			return false, fmt.Errorf("unexpected status: %s", status)
+		}
+	}, tfresource.WaitOpts{
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	})
+}
+
 func waitGlobalClusterMemberRemoved(ctx context.Context, conn *rds.Client, dbClusterARN string, timeout time.Duration) (*types.GlobalCluster, error) { //nolint:unparam
 	outputRaw, err := tfresource.RetryUntilNotFound(ctx, timeout, func() (interface{}, error) {
 		return findGlobalClusterByDBClusterARN(ctx, conn, dbClusterARN)
@@ -735,6 +777,85 @@ func globalClusterUpgradeMinorEngineVersion(ctx context.Context, conn *rds.Clien
 	return nil
 }
 
+// globalClusterChangePrimary promotes the specified secondary cluster to be the primary cluster of the RDS Global Cluster.
+// A managed switchover is used unless data loss is allowed, in which case the global cluster is failed over.
+// Once the roles have changed, all members are waited on so that their own state is stable when next read.
+func globalClusterChangePrimary(ctx context.Context, conn *rds.Client, globalClusterID, dbClusterARN string, allowDataLoss bool, timeout time.Duration) error {
+	globalCluster, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Global Cluster (%s): %w", globalClusterID, err)
+	}
+
+	if globalClusterWriterARN(globalCluster) == dbClusterARN {
+		return nil
+	}
+
+	if !slices.ContainsFunc(globalCluster.GlobalClusterMembers, func(v types.GlobalClusterMember) bool {
+		return aws.ToString(v.DBClusterArn) == dbClusterARN
+	}) {
+		return fmt.Errorf("RDS Cluster (%s) is not a member of RDS Global Cluster (%s)", dbClusterARN, globalClusterID)
+	}
+
+	if allowDataLoss {
+		log.Printf("[INFO] Failing over RDS Global Cluster (%s) to %s", globalClusterID, dbClusterARN)
+		input := &rds.FailoverGlobalClusterInput{
+			AllowDataLoss:             aws.Bool(true),
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(dbClusterARN),
+		}
+
+		if _, err := conn.FailoverGlobalCluster(ctx, input); err != nil {
+			return fmt.Errorf("failing over RDS Global Cluster (%s) to %s: %w", globalClusterID, dbClusterARN, err)
+		}
+	} else {
+		log.Printf("[INFO] Switching over RDS Global Cluster (%s) to %s", globalClusterID, dbClusterARN)
+		input := &rds.SwitchoverGlobalClusterInput{
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(dbClusterARN),
+		}
+
+		if _, err := conn.SwitchoverGlobalCluster(ctx, input); err != nil {
+			return fmt.Errorf("switching over RDS Global Cluster (%s) to %s: %w", globalClusterID, dbClusterARN, err)
+		}
+	}
+
+	if err := waitGlobalClusterPrimaryChanged(ctx, conn, globalClusterID, dbClusterARN, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS Global Cluster (%s) primary change to %s: %w", globalClusterID, dbClusterARN, err)
+	}
+
+	for _, member := range globalCluster.GlobalClusterMembers {
+		clusterID, clusterRegion, err := clusterIDAndRegionFromARN(aws.ToString(member.DBClusterArn))
+		if err != nil {
+			return err
+		}
+
+		if clusterID == "" {
+			continue
+		}
+
+		optFn := func(o *rds.Options) {
+			o.Region = clusterRegion
+		}
+
+		if _, err := waitGlobalClusterMemberUpdated(ctx, conn, clusterID, timeout, optFn); err != nil {
+			return fmt.Errorf("waiting for RDS Global Cluster (%s) member (%s) update: %w", globalClusterID, clusterID, err)
+		}
+	}
+
+	return nil
+}
+
+func globalClusterWriterARN(globalCluster *types.GlobalCluster) string {
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.ToBool(v.IsWriter) {
+			return aws.ToString(v.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
 func clusterIDAndRegionFromARN(clusterARN string) (string, string, error) {
 	parsedARN, err := arn.Parse(clusterARN)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -99,6 +100,11 @@ func resourceParameterGroup() *schema.Resource {
 				},
 				Set: resourceParameterHash,
 			},
+			"pending_reboot_parameters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrSkipDestroy: {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -108,7 +114,12 @@ func resourceParameterGroup() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.ComputedIf("pending_reboot_parameters", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange(names.AttrParameter)
+			}),
+		),
 	}
 }
 
@@ -227,6 +238,11 @@ func resourceParameterGroupRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	// Use the apply methods reported by AWS, before they are replaced by the configured ones.
+	d.Set("pending_reboot_parameters", pendingRebootParameterNames(userParams))
+
+	userParams = normalizeParameterApplyMethods(userParams, expandParameters(configParams.List()))
+
 	if err := d.Set(names.AttrParameter, flattenParameters(userParams)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
 	}
@@ -251,7 +267,22 @@ func resourceParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		if parameters := expandParameters(ns.Difference(os).List()); len(parameters) > 0 {
 			// We can only modify 20 parameters at a time, so walk them until
 			// we've got them all.
-			for parameters != nil {
+			for len(parameters) > 0 {
+				// Skip parameters that already have the desired value, e.g. those applied
+				// before an earlier apply failed part way through.
+				current, err := findDBParameters(ctx, conn, &rds.DescribeDBParametersInput{
+					DBParameterGroupName: aws.String(d.Id()),
+					Source:               aws.String(parameterSourceUser),
+				}, tfslices.PredicateTrue[*types.Parameter]())
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading RDS DB Parameter Group (%s) parameters: %s", d.Id(), err)
+				}
+
+				if parameters = unappliedParameters(parameters, current); len(parameters) == 0 {
+					break
+				}
+
 				var paramsToModify []types.Parameter
 				paramsToModify, parameters = parameterGroupModifyChunk(parameters, maxParamModifyChunk)
 
@@ -260,10 +291,14 @@ func resourceParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 					Parameters:           paramsToModify,
 				}
 
-				_, err := conn.ModifyDBParameterGroup(ctx, input)
+				_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, parameterGroupModifyTimeout, func() (interface{}, error) {
+					return conn.ModifyDBParameterGroup(ctx, input)
+				}, errCodeThrottling)
 
 				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "modifying RDS DB Parameter Group (%s): %s", d.Id(), err)
+					diags = sdkdiag.AppendErrorf(diags, "modifying RDS DB Parameter Group (%s): %s", d.Id(), err)
+					// Record the parameters that were applied so that the rest are planned again.
+					return append(diags, resourceParameterGroupRead(ctx, d, meta)...)
 				}
 			}
 		}
@@ -426,6 +461,61 @@ func findDBParameters(ctx context.Context, conn *rds.Client, input *rds.Describe
 	return output, nil
 }
 
+// unappliedParameters returns the desired parameters that differ from the current ones.
+// The apply method of static parameters is ignored, as AWS always reports pending-reboot for them.
+func unappliedParameters(desired, current []types.Parameter) []types.Parameter {
+	parameters := make(map[string]types.Parameter, len(current))
+	for _, p := range current {
+		parameters[strings.ToLower(aws.ToString(p.ParameterName))] = p
+	}
+
+	return tfslices.Filter(desired, func(p types.Parameter) bool {
+		v, ok := parameters[strings.ToLower(aws.ToString(p.ParameterName))]
+		if !ok || aws.ToString(v.ParameterValue) != aws.ToString(p.ParameterValue) {
+			return true
+		}
+
+		return !strings.EqualFold(aws.ToString(v.ApplyType), parameterApplyTypeStatic) && p.ApplyMethod != "" && !strings.EqualFold(string(v.ApplyMethod), string(p.ApplyMethod))
+	})
+}
+
+// normalizeParameterApplyMethods sets the apply method of static parameters to the configured value.
+// AWS always reports pending-reboot for static parameters, regardless of the apply method that was sent.
+func normalizeParameterApplyMethods(parameters, configParameters []types.Parameter) []types.Parameter {
+	applyMethods := make(map[string]types.ApplyMethod, len(configParameters))
+	for _, p := range configParameters {
+		applyMethods[strings.ToLower(aws.ToString(p.ParameterName))] = p.ApplyMethod
+	}
+
+	for i, p := range parameters {
+		if !strings.EqualFold(aws.ToString(p.ApplyType), parameterApplyTypeStatic) {
+			continue
+		}
+
+		if v, ok := applyMethods[strings.ToLower(aws.ToString(p.ParameterName))]; ok && v != "" {
+			parameters[i].ApplyMethod = v
+		}
+	}
+
+	return parameters
+}
+
+// pendingRebootParameterNames returns the names of the user-modified parameters that AWS applies on the next reboot.
+// The parameters must carry the apply methods reported by AWS, which are always pending-reboot for static parameters.
+func pendingRebootParameterNames(parameters []types.Parameter) []string {
+	var names []string
+
+	for _, p := range parameters {
+		if aws.ToString(p.Source) != parameterSourceUser || p.ApplyMethod != types.ApplyMethodPendingReboot {
+			continue
+		}
+
+		names = append(names, strings.ToLower(aws.ToString(p.ParameterName)))
+	}
+
+	return names
+}
+
 func resourceParameterHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -252,6 +252,142 @@ func TestParameterGroupModifyChunk(t *testing.T) {
 	}
 }
 
+func TestUnappliedParameters(t *testing.T) {
+	t.Parallel()
+
+	current := []types.Parameter{
+		{
+			ApplyMethod:    types.ApplyMethodImmediate,
+			ApplyType:      aws.String("dynamic"),
+			ParameterName:  aws.String("character_set_server"),
+			ParameterValue: aws.String("utf8"),
+		},
+		{
+			ApplyMethod:    types.ApplyMethodPendingReboot,
+			ApplyType:      aws.String("static"),
+			ParameterName:  aws.String("performance_schema"),
+			ParameterValue: aws.String("1"),
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Desired  []types.Parameter
+		Expected []types.Parameter
+	}{
+		{
+			Name: "Applied",
+			Desired: []types.Parameter{
+				{
+					ApplyMethod:    types.ApplyMethodImmediate,
+					ParameterName:  aws.String("Character_Set_Server"),
+					ParameterValue: aws.String("utf8"),
+				},
+			},
+			Expected: []types.Parameter{},
+		},
+		{
+			Name: "Static apply method ignored",
+			Desired: []types.Parameter{
+				{
+					ApplyMethod:    types.ApplyMethodImmediate,
+					ParameterName:  aws.String("performance_schema"),
+					ParameterValue: aws.String("1"),
+				},
+			},
+			Expected: []types.Parameter{},
+		},
+		{
+			Name: "Dynamic apply method changed",
+			Desired: []types.Parameter{
+				{
+					ApplyMethod:    types.ApplyMethodPendingReboot,
+					ParameterName:  aws.String("character_set_server"),
+					ParameterValue: aws.String("utf8"),
+				},
+			},
+			Expected: []types.Parameter{
+				{
+					ApplyMethod:    types.ApplyMethodPendingReboot,
+					ParameterName:  aws.String("character_set_server"),
+					ParameterValue: aws.String("utf8"),
+				},
+			},
+		},
+		{
+			Name: "Value changed and new parameter",
+			Desired: []types.Parameter{
+				{
+					ApplyMethod:    types.ApplyMethodPendingReboot,
+					ParameterName:  aws.String("performance_schema"),
+					ParameterValue: aws.String("0"),
+				},
+				{
+					ApplyMethod:    types.ApplyMethodImmediate,
+					ParameterName:  aws.String("character_set_client"),
+					ParameterValue: aws.String("utf8"),
+				},
+			},
+			Expected: []types.Parameter{
+				{
+					ApplyMethod:    types.ApplyMethodPendingReboot,
+					ParameterName:  aws.String("performance_schema"),
+					ParameterValue: aws.String("0"),
+				},
+				{
+					ApplyMethod:    types.ApplyMethodImmediate,
+					ParameterName:  aws.String("character_set_client"),
+					ParameterValue: aws.String("utf8"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		got := tfrds.UnappliedParameters(tc.Desired, current)
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("Case %q: Unapplied did not match\n%#v\n\nGot:\n%#v", tc.Name, tc.Expected, got)
+		}
+	}
+}
+
+func TestPendingRebootParameterNames(t *testing.T) {
+	t.Parallel()
+
+	parameters := []types.Parameter{
+		{
+			ApplyMethod:   types.ApplyMethodImmediate,
+			ApplyType:     aws.String("dynamic"),
+			ParameterName: aws.String("character_set_server"),
+			Source:        aws.String("user"),
+		},
+		{
+			ApplyMethod:   types.ApplyMethodPendingReboot,
+			ApplyType:     aws.String("dynamic"),
+			ParameterName: aws.String("Character_Set_Client"),
+			Source:        aws.String("user"),
+		},
+		{
+			ApplyMethod:   types.ApplyMethodPendingReboot,
+			ApplyType:     aws.String("static"),
+			ParameterName: aws.String("performance_schema"),
+			Source:        aws.String("user"),
+		},
+		{
+			ApplyMethod:   types.ApplyMethodPendingReboot,
+			ApplyType:     aws.String("static"),
+			ParameterName: aws.String("innodb_buffer_pool_size"),
+			Source:        aws.String("system"),
+		},
+	}
+
+	got := tfrds.PendingRebootParameterNames(parameters)
+	want := []string{"character_set_client", "performance_schema"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PendingRebootParameterNames = %v, want %v", got, want)
+	}
+}
+
 func TestAccRDSParameterGroup_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.DBParameterGroup
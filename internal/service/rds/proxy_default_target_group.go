@@ -118,16 +118,25 @@ func resourceProxyDefaultTargetGroupPut(ctx context.Context, d *schema.ResourceD
 		input.ConnectionPoolConfig = expandConnectionPoolConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
-	_, err := conn.ModifyDBProxyTargetGroup(ctx, input)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	// The proxy may still be modifying, e.g. right after it has been created.
+	if _, err := waitDBProxyUpdated(ctx, conn, dbProxyName, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Proxy (%s) update: %s", dbProxyName, err)
+	}
+
+	_, err := tfresource.RetryWhenIsA[*types.InvalidDBProxyStateFault](ctx, timeout, func() (interface{}, error) {
+		return conn.ModifyDBProxyTargetGroup(ctx, input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating RDS DB Proxy Default Target Group (%s): %s", dbProxyName, err)
 	}
 
-	timeout := d.Timeout(schema.TimeoutUpdate)
 	if d.IsNewResource() {
-		timeout = d.Timeout(schema.TimeoutCreate)
-
 		d.SetId(dbProxyName)
 	}
 
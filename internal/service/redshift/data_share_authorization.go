@@ -48,8 +48,10 @@ func (r *resourceDataShareAuthorization) Schema(ctx context.Context, req resourc
 		Attributes: map[string]schema.Attribute{
 			"allow_writes": schema.BoolAttribute{
 				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.Bool{
 					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"consumer_identifier": schema.StringAttribute{
@@ -114,7 +116,7 @@ func (r *resourceDataShareAuthorization) Create(ctx context.Context, req resourc
 		ConsumerIdentifier: aws.String(consumerIdentifier),
 	}
 
-	if !plan.AllowWrites.IsNull() {
+	if !plan.AllowWrites.IsNull() && !plan.AllowWrites.IsUnknown() {
 		in.AllowWrites = plan.AllowWrites.ValueBoolPointer()
 	}
 
@@ -134,6 +136,7 @@ func (r *resourceDataShareAuthorization) Create(ctx context.Context, req resourc
 		return
 	}
 
+	plan.AllowWrites = types.BoolValue(dataShareAuthorizationAllowWrites(out.DataShareAssociations, consumerIdentifier))
 	plan.ManagedBy = flex.StringToFramework(ctx, out.ManagedBy)
 	plan.ProducerARN = flex.StringToFrameworkARN(ctx, out.ProducerArn)
 
@@ -174,6 +177,7 @@ func (r *resourceDataShareAuthorization) Read(ctx context.Context, req resource.
 		return
 	}
 
+	state.AllowWrites = types.BoolValue(dataShareAuthorizationAllowWrites(out.DataShareAssociations, parts[1]))
 	state.ManagedBy = flex.StringToFramework(ctx, out.ManagedBy)
 	state.ProducerARN = flex.StringToFrameworkARN(ctx, out.ProducerArn)
 
@@ -264,6 +268,18 @@ func findDataShareAuthorizationByID(ctx context.Context, conn *redshift.Client,
 	}
 }
 
+// dataShareAuthorizationAllowWrites returns whether the producer allowed writes
+// when authorizing the data share for the specified consumer.
+func dataShareAuthorizationAllowWrites(associations []awstypes.DataShareAssociation, consumerIdentifier string) bool {
+	for _, assoc := range associations {
+		if aws.ToString(assoc.ConsumerIdentifier) == consumerIdentifier {
+			return aws.ToBool(assoc.ProducerAllowedWrites)
+		}
+	}
+
+	return false
+}
+
 type resourceDataShareAuthorizationData struct {
 	AllowWrites        types.Bool   `tfsdk:"allow_writes"`
 	ConsumerIdentifier types.String `tfsdk:"consumer_identifier"`
@@ -41,6 +41,7 @@ func TestAccRedshiftDataShareAuthorization_basic(t *testing.T) {
 				Config: testAccDataShareAuthorizationConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataShareAuthorizationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_writes", acctest.CtFalse),
 					resource.TestCheckResourceAttrPair(resourceName, "consumer_identifier", callerIdentityDataSourceName, names.AttrAccountID),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "data_share_arn", "redshift", regexache.MustCompile(`datashare:+.`)),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "producer_arn", "redshift-serverless", regexache.MustCompile(`namespace/.+$`)),
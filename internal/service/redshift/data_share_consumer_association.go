@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -49,8 +50,10 @@ func (r *resourceDataShareConsumerAssociation) Schema(ctx context.Context, req r
 		Attributes: map[string]schema.Attribute{
 			"allow_writes": schema.BoolAttribute{
 				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.Bool{
 					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"associate_entire_account": schema.BoolAttribute{
@@ -135,7 +138,7 @@ func (r *resourceDataShareConsumerAssociation) Create(ctx context.Context, req r
 		DataShareArn: aws.String(dataShareARN),
 	}
 
-	if !plan.AllowWrites.IsNull() {
+	if !plan.AllowWrites.IsNull() && !plan.AllowWrites.IsUnknown() {
 		in.AllowWrites = plan.AllowWrites.ValueBoolPointer()
 	}
 	if !plan.AssociateEntireAccount.IsNull() {
@@ -164,6 +167,13 @@ func (r *resourceDataShareConsumerAssociation) Create(ctx context.Context, req r
 		return
 	}
 
+	plan.AllowWrites = types.BoolValue(false)
+	for _, assoc := range out.DataShareAssociations {
+		if dataShareConsumerAssociationMatches(assoc, dataShareARN, associateEntireAccountString, consumerARN, consumerRegion) {
+			plan.AllowWrites = types.BoolValue(aws.ToBool(assoc.ConsumerAcceptedWrites))
+			break
+		}
+	}
 	plan.ProducerARN = flex.StringToFrameworkARN(ctx, out.ProducerArn)
 	plan.ManagedBy = flex.StringToFramework(ctx, out.ManagedBy)
 
@@ -199,7 +209,7 @@ func (r *resourceDataShareConsumerAssociation) Read(ctx context.Context, req res
 		state.ConsumerRegion = types.StringValue(parts[3])
 	}
 
-	out, err := findDataShareConsumerAssociationByID(ctx, conn, state.ID.ValueString())
+	out, assoc, err := findDataShareConsumerAssociationByID(ctx, conn, state.ID.ValueString())
 	if tfresource.NotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -212,6 +222,7 @@ func (r *resourceDataShareConsumerAssociation) Read(ctx context.Context, req res
 		return
 	}
 
+	state.AllowWrites = types.BoolValue(aws.ToBool(assoc.ConsumerAcceptedWrites))
 	state.ProducerARN = flex.StringToFrameworkARN(ctx, out.ProducerArn)
 	state.ManagedBy = flex.StringToFramework(ctx, out.ManagedBy)
 
@@ -272,10 +283,10 @@ func (r *resourceDataShareConsumerAssociation) ConfigValidators(_ context.Contex
 	}
 }
 
-func findDataShareConsumerAssociationByID(ctx context.Context, conn *redshift.Client, id string) (*awstypes.DataShare, error) {
+func findDataShareConsumerAssociationByID(ctx context.Context, conn *redshift.Client, id string) (*awstypes.DataShare, *awstypes.DataShareAssociation, error) {
 	parts, err := intflex.ExpandResourceId(id, dataShareConsumerAssociationIDPartCount, true)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	dataShareARN := parts[0]
 	associateEntireAccount := parts[1]
@@ -289,47 +300,59 @@ func findDataShareConsumerAssociationByID(ctx context.Context, conn *redshift.Cl
 	out, err := conn.DescribeDataShares(ctx, in)
 	if errs.IsAErrorMessageContains[*awstypes.InvalidDataShareFault](err, "because the ARN doesn't exist.") ||
 		errs.IsAErrorMessageContains[*awstypes.InvalidDataShareFault](err, "either doesn't exist or isn't associated with this data consumer") {
-		return nil, &retry.NotFoundError{
+		return nil, nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: in,
 		}
 	}
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if out == nil || len(out.DataShares) == 0 {
-		return nil, tfresource.NewEmptyResultError(in)
+		return nil, nil, tfresource.NewEmptyResultError(in)
 	}
 	if len(out.DataShares) != 1 {
-		return nil, tfresource.NewTooManyResultsError(len(out.DataShares), in)
+		return nil, nil, tfresource.NewTooManyResultsError(len(out.DataShares), in)
 	}
 
 	share := out.DataShares[0]
 
-	// The data share should include an association in an "ACTIVE" status where
-	// one of the following is true:
-	// - `associate_entire_account` is `true` and `ConsumerIdentifier` matches the
-	//   account number of the data share ARN.
-	// - `consumer_arn` is set and `ConsumerIdentifier` matches its value.
-	// - `consumer_region` is set and `ConsumerRegion` matches its value.
+	// The data share should include a matching association in an "ACTIVE" status.
 	for _, assoc := range share.DataShareAssociations {
-		if assoc.Status == awstypes.DataShareStatusActive {
-			if associateEntireAccount == "true" && accountIDFromARN(dataShareARN) == aws.ToString(assoc.ConsumerIdentifier) ||
-				consumerARN != "" && consumerARN == aws.ToString(assoc.ConsumerIdentifier) ||
-				consumerRegion != "" && consumerRegion == aws.ToString(assoc.ConsumerRegion) {
-				return &share, nil
-			}
+		if assoc.Status == awstypes.DataShareStatusActive && dataShareConsumerAssociationMatches(assoc, dataShareARN, associateEntireAccount, consumerARN, consumerRegion) {
+			return &share, &assoc, nil
 		}
 	}
 
-	return nil, &retry.NotFoundError{
+	return nil, nil, &retry.NotFoundError{
 		LastError:   err,
 		LastRequest: in,
 	}
 }
 
+// dataShareConsumerAssociationMatches returns whether the association corresponds to
+// the consumer association arguments, i.e. one of the following is true:
+//   - `associate_entire_account` is `true` and `ConsumerIdentifier` matches the
+//     account number of the data share ARN.
+//   - `consumer_arn` is set and `ConsumerIdentifier` identifies the same namespace.
+//   - `consumer_region` is set and `ConsumerRegion` matches its value.
+func dataShareConsumerAssociationMatches(assoc awstypes.DataShareAssociation, dataShareARN, associateEntireAccount, consumerARN, consumerRegion string) bool {
+	consumerIdentifier := aws.ToString(assoc.ConsumerIdentifier)
+
+	switch {
+	case associateEntireAccount == "true":
+		return accountIDFromARN(dataShareARN) == consumerIdentifier
+	case consumerARN != "":
+		return consumerARN == consumerIdentifier || namespaceIDFromARN(consumerARN) != "" && namespaceIDFromARN(consumerARN) == namespaceIDFromARN(consumerIdentifier)
+	case consumerRegion != "":
+		return consumerRegion == aws.ToString(assoc.ConsumerRegion)
+	}
+
+	return false
+}
+
 type resourceDataShareConsumerAssociationData struct {
 	AllowWrites            types.Bool   `tfsdk:"allow_writes"`
 	AssociateEntireAccount types.Bool   `tfsdk:"associate_entire_account"`
@@ -352,3 +375,23 @@ func accountIDFromARN(s string) string {
 	}
 	return parsed.AccountID
 }
+
+// namespaceIDFromARN returns the namespace ID from a provisioned
+// ("arn:aws:redshift:region:account:namespace:id") or serverless
+// ("arn:aws:redshift-serverless:region:account:namespace/id") namespace ARN.
+//
+// If the string is not a valid namespace ARN, an empty string is returned.
+func namespaceIDFromARN(s string) string {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return ""
+	}
+
+	for _, prefix := range []string{"namespace:", "namespace/"} {
+		if v, ok := strings.CutPrefix(parsed.Resource, prefix); ok {
+			return v
+		}
+	}
+
+	return ""
+}
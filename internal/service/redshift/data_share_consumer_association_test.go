@@ -41,6 +41,7 @@ func TestAccRedshiftDataShareConsumerAssociation_basic(t *testing.T) {
 				Config: testAccDataShareConsumerAssociationConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataShareConsumerAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_writes", acctest.CtFalse),
 					resource.TestCheckResourceAttrPair(resourceName, "consumer_region", regionDataSourceName, names.AttrName),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "data_share_arn", "redshift", regexache.MustCompile(`datashare:+.`)),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "producer_arn", "redshift-serverless", regexache.MustCompile(`namespace/.+$`)),
@@ -113,6 +114,38 @@ func TestAccRedshiftDataShareConsumerAssociation_associateEntireAccount(t *testi
 	})
 }
 
+func TestAccRedshiftDataShareConsumerAssociation_consumerARNServerless(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataShareConsumerAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationConfig_consumerARNServerless(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareConsumerAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_writes", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "consumer_arn", "aws_redshiftserverless_namespace.consumer", names.AttrARN),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "producer_arn", "redshift-serverless", regexache.MustCompile(`namespace/.+$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDataShareConsumerAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftClient(ctx)
@@ -122,7 +155,7 @@ func testAccCheckDataShareConsumerAssociationDestroy(ctx context.Context) resour
 				continue
 			}
 
-			_, err := tfredshift.FindDataShareConsumerAssociationByID(ctx, conn, rs.Primary.ID)
+			_, _, err := tfredshift.FindDataShareConsumerAssociationByID(ctx, conn, rs.Primary.ID)
 			if errs.IsAErrorMessageContains[*awstypes.InvalidDataShareFault](err, "because the ARN doesn't exist.") ||
 				errs.IsAErrorMessageContains[*awstypes.InvalidDataShareFault](err, "either doesn't exist or isn't associated with this data consumer") {
 				return nil
@@ -150,7 +183,7 @@ func testAccCheckDataShareConsumerAssociationExists(ctx context.Context, name st
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftClient(ctx)
-		_, err := tfredshift.FindDataShareConsumerAssociationByID(ctx, conn, rs.Primary.ID)
+		_, _, err := tfredshift.FindDataShareConsumerAssociationByID(ctx, conn, rs.Primary.ID)
 		if err != nil {
 			return create.Error(names.Redshift, create.ErrActionCheckingExistence, tfredshift.ResNameDataShareConsumerAssociation, rs.Primary.ID, err)
 		}
@@ -239,3 +272,21 @@ resource "aws_redshift_data_share_consumer_association" "test" {
 }
 `)
 }
+
+func testAccDataShareConsumerAssociationConfig_consumerARNServerless(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataShareConsumerAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "consumer" {
+  namespace_name = "%[1]s-consumer"
+  db_name        = "test"
+}
+
+resource "aws_redshift_data_share_consumer_association" "test" {
+  depends_on = [aws_redshift_data_share_authorization.test]
+
+  data_share_arn = local.data_share_arn
+  consumer_arn   = aws_redshiftserverless_namespace.consumer.arn
+}
+`, rName))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -60,6 +61,11 @@ func resourceScheduledAction() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9a-z-]{1,63}$`), ""),
 			},
+			"next_invocations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrSchedule: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -117,8 +123,9 @@ func resourceScheduledAction() *schema.Resource {
 										Optional: true,
 									},
 									"number_of_nodes": {
-										Type:     schema.TypeInt,
-										Optional: true,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
 									},
 								},
 							},
@@ -187,13 +194,7 @@ func resourceScheduledActionCreate(ctx context.Context, d *schema.ResourceData,
 		func() (interface{}, error) {
 			return conn.CreateScheduledAction(ctx, input)
 		},
-		func(err error) (bool, error) {
-			if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "The IAM role must delegate access to Amazon Redshift scheduler") {
-				return true, err
-			}
-
-			return false, err
-		},
+		isScheduledActionIAMRolePropagationError,
 	)
 
 	if err != nil {
@@ -234,6 +235,9 @@ func resourceScheduledActionRead(ctx context.Context, d *schema.ResourceData, me
 	}
 	d.Set("iam_role", scheduledAction.IamRole)
 	d.Set(names.AttrName, scheduledAction.ScheduledActionName)
+	d.Set("next_invocations", tfslices.ApplyToAll(scheduledAction.NextInvocations, func(v time.Time) string {
+		return v.Format(time.RFC3339)
+	}))
 	d.Set(names.AttrSchedule, scheduledAction.Schedule)
 	if scheduledAction.StartTime != nil {
 		d.Set(names.AttrStartTime, aws.ToTime(scheduledAction.StartTime).Format(time.RFC3339))
@@ -293,13 +297,28 @@ func resourceScheduledActionUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[DEBUG] Updating Redshift Scheduled Action: %#v", input)
-	_, err := conn.ModifyScheduledAction(ctx, input)
+	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.ModifyScheduledAction(ctx, input)
+		},
+		isScheduledActionIAMRolePropagationError,
+	)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Redshift Scheduled Action (%s): %s", d.Id(), err)
 	}
 
-	return diags
+	return append(diags, resourceScheduledActionRead(ctx, d, meta)...)
+}
+
+// isScheduledActionIAMRolePropagationError reports whether the Redshift scheduler
+// cannot yet assume a newly created IAM role.
+func isScheduledActionIAMRolePropagationError(err error) (bool, error) {
+	if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "The IAM role must delegate access to Amazon Redshift scheduler") {
+		return true, err
+	}
+
+	return false, err
 }
 
 func resourceScheduledActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
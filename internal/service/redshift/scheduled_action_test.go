@@ -42,6 +42,7 @@ func TestAccRedshiftScheduledAction_basicPauseCluster(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "enable", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "end_time", ""),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "next_invocations.0"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrSchedule, "cron(00 23 * * ? *)"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStartTime, ""),
 					resource.TestCheckResourceAttr(resourceName, "target_action.#", "1"),
@@ -64,6 +65,8 @@ func TestAccRedshiftScheduledAction_basicPauseCluster(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "enable", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "end_time", ""),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "next_invocations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "next_invocations.0", "2060-03-04T17:27:00Z"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrSchedule, "at(2060-03-04T17:27:00)"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStartTime, ""),
 					resource.TestCheckResourceAttr(resourceName, "target_action.#", "1"),
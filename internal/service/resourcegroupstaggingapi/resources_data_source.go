@@ -5,12 +5,15 @@ package resourcegroupstaggingapi
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -18,6 +21,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	resourcesPerPageMax = 100
+)
+
 // @SDKDataSource("aws_resourcegroupstaggingapi_resources", name="Resources")
 func dataSourceResources() *schema.Resource {
 	return &schema.Resource{
@@ -28,10 +35,31 @@ func dataSourceResources() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"exclude_resource_type_filters": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"resource_arn_list"},
+			},
 			"include_compliance_details": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"resource_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_arns_only": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"include_compliance_details"},
+			},
 			"resource_arn_list": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -119,6 +147,9 @@ func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	if v, ok := d.GetOk("resource_arn_list"); ok && v.(*schema.Set).Len() > 0 {
 		input.ResourceARNList = flex.ExpandStringValueSet(v.(*schema.Set))
+	} else {
+		// ResourceARNList cannot be combined with any pagination parameter.
+		input.ResourcesPerPage = aws.Int32(resourcesPerPageMax)
 	}
 
 	if v, ok := d.GetOk("tag_filter"); ok {
@@ -129,9 +160,22 @@ func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta i
 		input.ResourceTypeFilters = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	var taggings []types.ResourceTagMapping
+	var excludeResourceTypes []string
+	if v, ok := d.GetOk("exclude_resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+		excludeResourceTypes = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+	maxResults := d.Get("max_results").(int)
+	arnsOnly := d.Get("resource_arns_only").(bool)
+
+	// Each page is flattened as it is read so that only the results are held in memory.
+	// ARNs are only returned in resource_arns when resource_arns_only is set so that they aren't stored twice.
+	arns := make([]string, 0)
+	taggings := make([]map[string]interface{}, 0)
+	count := 0
+	truncated := false
 
 	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+pages:
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
@@ -139,18 +183,70 @@ func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta i
 			return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resources: %s", err)
 		}
 
-		taggings = append(taggings, page.ResourceTagMappingList...)
+		for _, v := range page.ResourceTagMappingList {
+			resourceARN := aws.ToString(v.ResourceARN)
+			if matchesResourceTypeFilters(resourceARN, excludeResourceTypes) {
+				continue
+			}
+
+			if maxResults > 0 && count == maxResults {
+				truncated = true
+				break pages
+			}
+
+			count++
+			if arnsOnly {
+				arns = append(arns, resourceARN)
+			} else {
+				taggings = append(taggings, flattenResourceTagMapping(ctx, v))
+			}
+		}
+	}
+
+	if truncated {
+		diags = sdkdiag.AppendWarningf(diags, "Resource Groups Tagging API Resources results truncated to %d (max_results)", maxResults)
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Partition(ctx))
 
-	if err := d.Set("resource_tag_mapping_list", flattenResourceTagMappings(ctx, taggings)); err != nil {
+	d.Set("resource_arns", arns)
+	if err := d.Set("resource_tag_mapping_list", taggings); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting resource tag mapping list: %s", err)
 	}
 
 	return diags
 }
 
+// matchesResourceTypeFilters returns whether the specified ARN matches any of the resource type filters.
+// Filters are of the form "service[:resourceType]", e.g. "ec2" or "ec2:instance".
+func matchesResourceTypeFilters(v string, filters []string) bool {
+	if len(filters) == 0 {
+		return false
+	}
+
+	parsedARN, err := arn.Parse(v)
+	if err != nil {
+		return false
+	}
+
+	resourceType := parsedARN.Resource
+	if i := strings.IndexAny(resourceType, "/:"); i >= 0 {
+		resourceType = resourceType[:i]
+	}
+
+	for _, filter := range filters {
+		service, typ, found := strings.Cut(filter, ":")
+		if service != parsedARN.Service {
+			continue
+		}
+		if !found || typ == resourceType {
+			return true
+		}
+	}
+
+	return false
+}
+
 func expandTagFilters(filters []interface{}) []types.TagFilter {
 	result := make([]types.TagFilter, len(filters))
 
@@ -169,23 +265,17 @@ func expandTagFilters(filters []interface{}) []types.TagFilter {
 	return result
 }
 
-func flattenResourceTagMappings(ctx context.Context, list []types.ResourceTagMapping) []map[string]interface{} {
-	result := make([]map[string]interface{}, 0, len(list))
-
-	for _, i := range list {
-		l := map[string]interface{}{
-			names.AttrResourceARN: aws.ToString(i.ResourceARN),
-			names.AttrTags:        KeyValueTags(ctx, i.Tags).Map(),
-		}
-
-		if i.ComplianceDetails != nil {
-			l["compliance_details"] = flattenComplianceDetails(i.ComplianceDetails)
-		}
+func flattenResourceTagMapping(ctx context.Context, apiObject types.ResourceTagMapping) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrResourceARN: aws.ToString(apiObject.ResourceARN),
+		names.AttrTags:        KeyValueTags(ctx, apiObject.Tags).Map(),
+	}
 
-		result = append(result, l)
+	if apiObject.ComplianceDetails != nil {
+		tfMap["compliance_details"] = flattenComplianceDetails(apiObject.ComplianceDetails)
 	}
 
-	return result
+	return tfMap
 }
 
 func flattenComplianceDetails(details *types.ComplianceDetails) []map[string]interface{} {
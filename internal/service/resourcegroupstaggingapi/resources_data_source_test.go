@@ -106,6 +106,50 @@ func TestAccResourceGroupsTaggingAPIResourcesDataSource_resourceARNList(t *testi
 	})
 }
 
+func TestAccResourceGroupsTaggingAPIResourcesDataSource_excludeResourceTypeFilters(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_resources.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_excludeResourceTypeFilters(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourcesDataSource_maxResults(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_resources.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_maxResults(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourcesDataSourceConfig_tagFilter(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -176,3 +220,51 @@ data "aws_resourcegroupstaggingapi_resources" "test" {
 }
 `, rName)
 }
+
+func testAccResourcesDataSourceConfig_taggedVPCAndSubnet(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Key = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = aws_vpc.test.id
+  cidr_block = "10.0.1.0/24"
+
+  tags = {
+    Key = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccResourcesDataSourceConfig_excludeResourceTypeFilters(rName string) string {
+	return acctest.ConfigCompose(testAccResourcesDataSourceConfig_taggedVPCAndSubnet(rName), `
+data "aws_resourcegroupstaggingapi_resources" "test" {
+  exclude_resource_type_filters = ["ec2:subnet"]
+  resource_arns_only            = true
+
+  tag_filter {
+    key    = "Key"
+    values = [aws_subnet.test.tags["Key"]]
+  }
+}
+`)
+}
+
+func testAccResourcesDataSourceConfig_maxResults(rName string) string {
+	return acctest.ConfigCompose(testAccResourcesDataSourceConfig_taggedVPCAndSubnet(rName), `
+data "aws_resourcegroupstaggingapi_resources" "test" {
+  max_results = 1
+
+  tag_filter {
+    key    = "Key"
+    values = [aws_subnet.test.tags["Key"]]
+  }
+}
+`)
+}
@@ -52,7 +52,7 @@ func resourceHealthCheck() *schema.Resource {
 			"child_health_threshold": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ValidateFunc: validation.IntAtMost(256),
+				ValidateFunc: validation.IntBetween(0, 256),
 			},
 			"child_healthchecks": {
 				Type:     schema.TypeSet,
@@ -186,6 +186,7 @@ func resourceHealthCheck() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
 			triggersCustomizeDiff,
+			healthCheckTypeCustomizeDiff,
 		),
 	}
 }
@@ -478,6 +479,35 @@ func findHealthCheckByID(ctx context.Context, conn *route53.Client, id string) (
 	return output.HealthCheck, nil
 }
 
+// healthCheckTypeCustomizeDiff surfaces missing type-specific arguments at plan time
+// rather than leaving the API to reject (or silently accept) the health check on apply.
+func healthCheckTypeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrType) {
+		return nil
+	}
+
+	var required []string
+
+	switch awstypes.HealthCheckType(strings.ToUpper(d.Get(names.AttrType).(string))) {
+	case awstypes.HealthCheckTypeCloudwatchMetric:
+		required = []string{"cloudwatch_alarm_name", "cloudwatch_alarm_region"}
+	case awstypes.HealthCheckTypeRecoveryControl:
+		required = []string{"routing_control_arn"}
+	}
+
+	for _, k := range required {
+		if !d.NewValueKnown(k) {
+			continue
+		}
+
+		if v, ok := d.GetOk(k); !ok || v.(string) == "" {
+			return fmt.Errorf("%q is required when type is %q", k, d.Get(names.AttrType).(string))
+		}
+	}
+
+	return nil
+}
+
 func triggersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	// Removal of the triggers argument should _not_ trigger an update
 	if d.HasChange(names.AttrTriggers) {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -155,9 +156,11 @@ func TestAccRoute53HealthCheck_withChildHealthChecks(t *testing.T) {
 		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccHealthCheckConfig_childs,
+				Config: testAccHealthCheckConfig_childs(1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					resource.TestCheckResourceAttr(resourceName, "child_health_threshold", "1"),
+					resource.TestCheckResourceAttr(resourceName, "child_healthchecks.#", "1"),
 				),
 			},
 			{
@@ -165,6 +168,19 @@ func TestAccRoute53HealthCheck_withChildHealthChecks(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccHealthCheckConfig_childs(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					resource.TestCheckResourceAttr(resourceName, "child_health_threshold", "2"),
+					resource.TestCheckResourceAttr(resourceName, "child_healthchecks.#", "2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
 		},
 	})
 }
@@ -288,6 +304,23 @@ func TestAccRoute53HealthCheck_cloudWatchAlarmCheck(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_cloudWatchAlarmCheckMissingRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthCheckConfig_cloudWatchAlarmNoRegion,
+				ExpectError: regexache.MustCompile(`"cloudwatch_alarm_region" is required when type is "CLOUDWATCH_METRIC"`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_triggers(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check awstypes.HealthCheck
@@ -582,9 +615,12 @@ resource "aws_route53_health_check" "test" {
 `, ip)
 }
 
-const testAccHealthCheckConfig_childs = `
-resource "aws_route53_health_check" "child1" {
-  fqdn              = "child1.example.com"
+func testAccHealthCheckConfig_childs(threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_health_check" "child" {
+  count = 2
+
+  fqdn              = "child${count.index}.example.com"
   port              = 80
   type              = "HTTP"
   resource_path     = "/"
@@ -594,14 +630,15 @@ resource "aws_route53_health_check" "child1" {
 
 resource "aws_route53_health_check" "test" {
   type                   = "CALCULATED"
-  child_health_threshold = 1
-  child_healthchecks     = [aws_route53_health_check.child1.id]
+  child_health_threshold = %[1]d
+  child_healthchecks     = slice(aws_route53_health_check.child[*].id, 0, %[1]d)
 
   tags = {
     Name = "tf-test-calculated-health-check"
   }
 }
-`
+`, threshold)
+}
 
 func testAccHealthCheckConfig_regions(regions ...string) string {
 	return fmt.Sprintf(`
@@ -645,6 +682,14 @@ resource "aws_route53_health_check" "test" {
 }
 `
 
+const testAccHealthCheckConfig_cloudWatchAlarmNoRegion = `
+resource "aws_route53_health_check" "test" {
+  type                            = "CLOUDWATCH_METRIC"
+  cloudwatch_alarm_name           = "cloudwatch-healthcheck-alarm"
+  insufficient_data_health_status = "Healthy"
+}
+`
+
 func testAccHealthCheckConfig_triggers(threshold string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_metric_alarm" "test" {
@@ -11,4 +11,5 @@ var (
 
 	FindDNSSECKeyByTwoPartKey = findDNSSECKeyByTwoPartKey
 	FindDomainDetailByName    = findDomainDetailByName
+	HasDomainTransferLock     = hasDomainTransferLock
 )
@@ -9,6 +9,7 @@ import (
 	"log"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -471,7 +472,7 @@ func resourceRegisteredDomainUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChanges("admin_privacy", "billing_contact", "registrant_privacy", "tech_privacy") {
+	if d.HasChanges("admin_privacy", "billing_privacy", "registrant_privacy", "tech_privacy") {
 		if err := modifyDomainContactPrivacy(ctx, conn, d.Id(), d.Get("admin_privacy").(bool), d.Get("billing_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -504,7 +505,12 @@ func hasDomainTransferLock(statusList []string) bool {
 	const (
 		eppStatusClientTransferProhibited = "clientTransferProhibited"
 	)
-	return slices.Contains(statusList, eppStatusClientTransferProhibited)
+	// Status codes may be followed by a link to their ICANN description,
+	// e.g. "clientTransferProhibited https://icann.org/epp#clientTransferProhibited".
+	return slices.ContainsFunc(statusList, func(v string) bool {
+		code, _, _ := strings.Cut(strings.TrimSpace(v), " ")
+		return strings.EqualFold(code, eppStatusClientTransferProhibited)
+	})
 }
 
 func findDomainDetailByName(ctx context.Context, conn *route53domains.Client, name string) (*route53domains.GetDomainDetailOutput, error) {
@@ -9,9 +9,45 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfroute53domains "github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestHasDomainTransferLock(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statusList []string
+		expected   bool
+	}{
+		"empty": {
+			expected: false,
+		},
+		"code": {
+			statusList: []string{"clientTransferProhibited"},
+			expected:   true,
+		},
+		"code with link": {
+			statusList: []string{"clientDeleteProhibited https://icann.org/epp#clientDeleteProhibited", "clientTransferProhibited https://icann.org/epp#clientTransferProhibited"},
+			expected:   true,
+		},
+		"other codes": {
+			statusList: []string{"clientDeleteProhibited", "serverTransferProhibited"},
+			expected:   false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfroute53domains.HasDomainTransferLock(testCase.statusList), testCase.expected; got != want {
+				t.Errorf("HasDomainTransferLock(%v) = %t, want %t", testCase.statusList, got, want)
+			}
+		})
+	}
+}
+
 func testAccRegisteredDomain_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	domainName := acctest.SkipIfEnvVarNotSet(t, "ROUTE53DOMAINS_DOMAIN_NAME")
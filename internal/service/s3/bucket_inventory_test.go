@@ -66,6 +66,39 @@ func TestAccS3BucketInventory_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketInventory_optionalFields(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf types.InventoryConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_inventory.test"
+	inventoryName := t.Name()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketInventoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketInventoryConfig_optionalFields(rName, inventoryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketInventoryExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "optional_fields.#", "4"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", "BucketKeyStatus"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", "ChecksumAlgorithm"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", "ObjectAccessControlList"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", "ObjectOwner"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccS3BucketInventory_encryptWithSSES3(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf types.InventoryConfiguration
@@ -251,6 +284,35 @@ resource "aws_s3_bucket_inventory" "test" {
 `, inventoryName))
 }
 
+func testAccBucketInventoryConfig_optionalFields(bucketName, inventoryName string) string {
+	return acctest.ConfigCompose(testAccBucketInventoryConfig_base(bucketName), fmt.Sprintf(`
+resource "aws_s3_bucket_inventory" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+
+  included_object_versions = "Current"
+
+  optional_fields = [
+    "BucketKeyStatus",
+    "ChecksumAlgorithm",
+    "ObjectAccessControlList",
+    "ObjectOwner",
+  ]
+
+  schedule {
+    frequency = "Daily"
+  }
+
+  destination {
+    bucket {
+      format     = "CSV"
+      bucket_arn = aws_s3_bucket.test.arn
+    }
+  }
+}
+`, inventoryName))
+}
+
 func testAccBucketInventoryConfig_encryptSSE(bucketName, inventoryName string) string {
 	return acctest.ConfigCompose(testAccBucketInventoryConfig_base(bucketName), fmt.Sprintf(`
 resource "aws_s3_bucket_inventory" "test" {
@@ -430,6 +430,126 @@ func findBucketNotificationConfiguration(ctx context.Context, conn *s3.Client, b
 	return output, nil
 }
 
+// modifyBucketNotificationConfiguration performs a read-modify-write of a bucket's notification configuration.
+// Concurrent modifications by this provider are serialized by bucket.
+// The configuration is re-read on each attempt so that a retry after a concurrent modification by another writer
+// (OperationAborted) applies `f` to the latest configuration instead of overwriting it with a stale copy.
+func modifyBucketNotificationConfiguration(ctx context.Context, conn *s3.Client, bucket string, f func(*types.NotificationConfiguration) error) error {
+	mutexKey := "s3-bucket-notification-" + bucket
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	_, err := tfresource.RetryWhen(ctx, bucketPropagationTimeout,
+		func() (interface{}, error) {
+			output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+			if err != nil {
+				return nil, err
+			}
+
+			notificationConfiguration := newNotificationConfiguration(output)
+			if err := f(notificationConfiguration); err != nil {
+				return nil, err
+			}
+
+			input := &s3.PutBucketNotificationConfigurationInput{
+				Bucket:                    aws.String(bucket),
+				NotificationConfiguration: notificationConfiguration,
+			}
+
+			return conn.PutBucketNotificationConfiguration(ctx, input)
+		},
+		func(err error) (bool, error) {
+			// A missing bucket on read is final.
+			if tfresource.NotFound(err) {
+				return false, err
+			}
+
+			if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeOperationAborted) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "NotificationConfiguration is not valid, expected CreateBucketConfiguration") {
+		err = errDirectoryBucket(err)
+	}
+
+	return err
+}
+
+func newNotificationConfiguration(output *s3.GetBucketNotificationConfigurationOutput) *types.NotificationConfiguration {
+	return &types.NotificationConfiguration{
+		EventBridgeConfiguration:     output.EventBridgeConfiguration,
+		LambdaFunctionConfigurations: output.LambdaFunctionConfigurations,
+		QueueConfigurations:          output.QueueConfigurations,
+		TopicConfigurations:          output.TopicConfigurations,
+	}
+}
+
+const bucketNotificationTargetResourceIDSeparator = ":"
+
+func bucketNotificationTargetCreateResourceID(bucket, configurationID string) string {
+	return bucket + bucketNotificationTargetResourceIDSeparator + configurationID
+}
+
+func bucketNotificationTargetParseResourceID(id string) (string, string, error) {
+	// Bucket names cannot contain the separator, but configuration IDs can.
+	bucket, configurationID, found := strings.Cut(id, bucketNotificationTargetResourceIDSeparator)
+
+	if found && bucket != "" && configurationID != "" {
+		return bucket, configurationID, nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected bucket-name%[2]sconfiguration-id", id, bucketNotificationTargetResourceIDSeparator)
+}
+
+func expandNotificationConfigurationFilter(d *schema.ResourceData) *types.NotificationConfigurationFilter {
+	var filterRules []types.FilterRule
+
+	if v, ok := d.GetOk("filter_prefix"); ok {
+		filterRules = append(filterRules, types.FilterRule{
+			Name:  types.FilterRuleNamePrefix,
+			Value: aws.String(v.(string)),
+		})
+	}
+	if v, ok := d.GetOk("filter_suffix"); ok {
+		filterRules = append(filterRules, types.FilterRule{
+			Name:  types.FilterRuleNameSuffix,
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	if len(filterRules) == 0 {
+		return nil
+	}
+
+	return &types.NotificationConfigurationFilter{
+		Key: &types.S3KeyFilter{
+			FilterRules: filterRules,
+		},
+	}
+}
+
+func setNotificationConfigurationFilter(d *schema.ResourceData, filter *types.NotificationConfigurationFilter) {
+	var prefix, suffix string
+
+	if filter != nil {
+		tfMap := flattenNotificationConfigurationFilter(filter)
+		if v, ok := tfMap["filter_prefix"].(string); ok {
+			prefix = v
+		}
+		if v, ok := tfMap["filter_suffix"].(string); ok {
+			suffix = v
+		}
+	}
+
+	d.Set("filter_prefix", prefix)
+	d.Set("filter_suffix", suffix)
+}
+
 func flattenNotificationConfigurationFilter(filter *types.NotificationConfigurationFilter) map[string]interface{} {
 	filterRules := map[string]interface{}{}
 	if filter.Key == nil || filter.Key.FilterRules == nil {
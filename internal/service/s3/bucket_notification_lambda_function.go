@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKResource("aws_s3_bucket_notification_lambda_function", name="Bucket Notification Lambda Function")
+func resourceBucketNotificationLambdaFunction() *schema.Resource {
+	return bucketNotificationLambdaFunction.resource()
+}
+
+var bucketNotificationLambdaFunction = &bucketNotificationTarget[types.LambdaFunctionConfiguration]{
+	name:                  "Lambda Function",
+	arnAttribute:          "lambda_function_arn",
+	configurationIDPrefix: "tf-s3-lambda-",
+	configurations: func(v *types.NotificationConfiguration) *[]types.LambdaFunctionConfiguration {
+		return &v.LambdaFunctionConfigurations
+	},
+	configurationID: func(v *types.LambdaFunctionConfiguration) string {
+		return aws.ToString(v.Id)
+	},
+	expand: func(d *schema.ResourceData, configurationID string) types.LambdaFunctionConfiguration {
+		return types.LambdaFunctionConfiguration{
+			Events:            flex.ExpandStringyValueSet[types.Event](d.Get("events").(*schema.Set)),
+			Filter:            expandNotificationConfigurationFilter(d),
+			Id:                aws.String(configurationID),
+			LambdaFunctionArn: aws.String(d.Get("lambda_function_arn").(string)),
+		}
+	},
+	flatten: func(d *schema.ResourceData, v *types.LambdaFunctionConfiguration) {
+		d.Set("events", v.Events)
+		setNotificationConfigurationFilter(d, v.Filter)
+		d.Set("lambda_function_arn", v.LambdaFunctionArn)
+	},
+}
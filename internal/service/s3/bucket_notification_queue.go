@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKResource("aws_s3_bucket_notification_queue", name="Bucket Notification Queue")
+func resourceBucketNotificationQueue() *schema.Resource {
+	return bucketNotificationQueue.resource()
+}
+
+var bucketNotificationQueue = &bucketNotificationTarget[types.QueueConfiguration]{
+	name:                  "Queue",
+	arnAttribute:          "queue_arn",
+	configurationIDPrefix: "tf-s3-queue-",
+	configurations: func(v *types.NotificationConfiguration) *[]types.QueueConfiguration {
+		return &v.QueueConfigurations
+	},
+	configurationID: func(v *types.QueueConfiguration) string {
+		return aws.ToString(v.Id)
+	},
+	expand: func(d *schema.ResourceData, configurationID string) types.QueueConfiguration {
+		return types.QueueConfiguration{
+			Events:   flex.ExpandStringyValueSet[types.Event](d.Get("events").(*schema.Set)),
+			Filter:   expandNotificationConfigurationFilter(d),
+			Id:       aws.String(configurationID),
+			QueueArn: aws.String(d.Get("queue_arn").(string)),
+		}
+	},
+	flatten: func(d *schema.ResourceData, v *types.QueueConfiguration) {
+		d.Set("events", v.Events)
+		setNotificationConfigurationFilter(d, v.Filter)
+		d.Set("queue_arn", v.QueueArn)
+	},
+}
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketNotificationQueue_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.QueueConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "configuration_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "tf-acc-test/"),
+					resource.TestCheckResourceAttr(resourceName, "filter_suffix", ".mp4"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_arn", "aws_sqs_queue.test.0", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationQueue_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.QueueConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationQueueExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketNotificationQueue(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationQueue_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 types.QueueConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_s3_bucket_notification_queue.test"
+	resourceName2 := "aws_s3_bucket_notification_queue.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationQueueConfig_multiple(rName, "s3:ObjectRestore:Post"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationQueueExists(ctx, resourceName1, &v1),
+					testAccCheckBucketNotificationQueueExists(ctx, resourceName2, &v2),
+					resource.TestCheckResourceAttrPair(resourceName2, "queue_arn", "aws_sqs_queue.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName2, "events.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName2, "events.*", "s3:ObjectRestore:Post"),
+				),
+			},
+			{
+				Config: testAccBucketNotificationQueueConfig_multiple(rName, "s3:ObjectRestore:Completed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationQueueExists(ctx, resourceName1, &v1),
+					testAccCheckBucketNotificationQueueExists(ctx, resourceName2, &v2),
+					resource.TestCheckResourceAttr(resourceName1, "events.#", "2"),
+					resource.TestCheckResourceAttr(resourceName2, "events.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName2, "events.*", "s3:ObjectRestore:Completed"),
+				),
+			},
+			{
+				Config: testAccBucketNotificationQueueConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketNotificationQueueExists(ctx, resourceName1, &v1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationQueue_duplicateConfigurationID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketNotificationQueueConfig_duplicateConfigurationID(rName),
+				ExpectError: regexache.MustCompile(`notification configuration ID \(` + rName + `\) already exists`),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketNotificationQueueDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_notification_queue" {
+				continue
+			}
+
+			bucket, configurationID, err := tfs3.BucketNotificationTargetParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfs3.FindBucketNotificationQueueConfiguration(ctx, conn, bucket, configurationID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Notification Queue %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketNotificationQueueExists(ctx context.Context, n string, v *types.QueueConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket, configurationID, err := tfs3.BucketNotificationTargetParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindBucketNotificationQueueConfiguration(ctx, conn, bucket, configurationID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBucketNotificationQueueConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "sqs:SendMessage",
+      "Resource": "arn:${data.aws_partition.current.partition}:sqs:*:*:%[1]s-${count.index}",
+      "Condition": {
+        "ArnEquals": {
+          "aws:SourceArn": "${aws_s3_bucket.test.arn}"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccBucketNotificationQueueConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationQueueConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_queue" "test" {
+  bucket           = aws_s3_bucket.test.id
+  configuration_id = %[1]q
+  queue_arn        = aws_sqs_queue.test[0].arn
+
+  events = [
+    "s3:ObjectCreated:*",
+    "s3:ObjectRemoved:Delete",
+  ]
+
+  filter_prefix = "tf-acc-test/"
+  filter_suffix = ".mp4"
+}
+`, rName))
+}
+
+func testAccBucketNotificationQueueConfig_multiple(rName, event string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationQueueConfig_basic(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_notification_queue" "test2" {
+  bucket    = aws_s3_bucket.test.id
+  queue_arn = aws_sqs_queue.test[1].arn
+
+  events = [%[1]q]
+}
+`, event))
+}
+
+func testAccBucketNotificationQueueConfig_duplicateConfigurationID(rName string) string {
+	return acctest.ConfigCompose(testAccBucketNotificationQueueConfig_basic(rName), `
+resource "aws_s3_bucket_notification_queue" "test2" {
+  bucket           = aws_s3_bucket.test.id
+  configuration_id = aws_s3_bucket_notification_queue.test.configuration_id
+  queue_arn        = aws_sqs_queue.test[1].arn
+
+  events = ["s3:ObjectRestore:Post"]
+}
+`)
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// bucketNotificationTarget implements a resource that manages a single entry in one of the
+// lambda function, queue or topic configuration lists of a bucket's notification configuration.
+type bucketNotificationTarget[T any] struct {
+	name                  string // Used in messages, e.g. "Queue".
+	arnAttribute          string // Name of the destination ARN attribute.
+	configurationIDPrefix string
+	// configurations returns the list of entries managed by the resource.
+	configurations  func(*types.NotificationConfiguration) *[]T
+	configurationID func(*T) string
+	expand          func(*schema.ResourceData, string) T
+	flatten         func(*schema.ResourceData, *T)
+}
+
+func (t *bucketNotificationTarget[T]) resource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: t.create,
+		ReadWithoutTimeout:   t.read,
+		UpdateWithoutTimeout: t.update,
+		DeleteWithoutTimeout: t.delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"configuration_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			t.arnAttribute: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func (t *bucketNotificationTarget[T]) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	configurationID := id.PrefixedUniqueId(t.configurationIDPrefix)
+	if v, ok := d.GetOk("configuration_id"); ok {
+		configurationID = v.(string)
+	}
+	configuration := t.expand(d, configurationID)
+
+	err := modifyBucketNotificationConfiguration(ctx, conn, bucket, func(notificationConfiguration *types.NotificationConfiguration) error {
+		if notificationConfigurationIDExists(notificationConfiguration, configurationID) {
+			return fmt.Errorf("notification configuration ID (%s) already exists", configurationID)
+		}
+
+		configurations := t.configurations(notificationConfiguration)
+		*configurations = append(*configurations, configuration)
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket (%s) Notification %s (%s): %s", bucket, t.name, configurationID, err)
+	}
+
+	d.SetId(bucketNotificationTargetCreateResourceID(bucket, configurationID))
+
+	_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return t.find(ctx, conn, bucket, configurationID)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Notification %s (%s) create: %s", t.name, d.Id(), err)
+	}
+
+	return append(diags, t.read(ctx, d, meta)...)
+}
+
+func (t *bucketNotificationTarget[T]) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, configurationID, err := bucketNotificationTargetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	configuration, err := t.find(ctx, conn, bucket, configurationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Notification %s (%s) not found, removing from state", t.name, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification %s (%s): %s", t.name, d.Id(), err)
+	}
+
+	d.Set(names.AttrBucket, bucket)
+	d.Set("configuration_id", configurationID)
+	t.flatten(d, configuration)
+
+	return diags
+}
+
+func (t *bucketNotificationTarget[T]) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, configurationID, err := bucketNotificationTargetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	configuration := t.expand(d, configurationID)
+
+	err = modifyBucketNotificationConfiguration(ctx, conn, bucket, func(notificationConfiguration *types.NotificationConfiguration) error {
+		configurations := t.configurations(notificationConfiguration)
+		*configurations = append(slices.DeleteFunc(*configurations, t.hasConfigurationID(configurationID)), configuration)
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Notification %s (%s): %s", t.name, d.Id(), err)
+	}
+
+	return append(diags, t.read(ctx, d, meta)...)
+}
+
+func (t *bucketNotificationTarget[T]) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, configurationID, err := bucketNotificationTargetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Notification %s: %s", t.name, d.Id())
+	err = modifyBucketNotificationConfiguration(ctx, conn, bucket, func(notificationConfiguration *types.NotificationConfiguration) error {
+		configurations := t.configurations(notificationConfiguration)
+		*configurations = slices.DeleteFunc(*configurations, t.hasConfigurationID(configurationID))
+
+		return nil
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket Notification %s (%s): %s", t.name, d.Id(), err)
+	}
+
+	return diags
+}
+
+func (t *bucketNotificationTarget[T]) find(ctx context.Context, conn *s3.Client, bucket, configurationID string) (*T, error) {
+	output, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	configurations := *t.configurations(newNotificationConfiguration(output))
+	i := slices.IndexFunc(configurations, t.hasConfigurationID(configurationID))
+
+	if i == -1 {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return &configurations[i], nil
+}
+
+func (t *bucketNotificationTarget[T]) hasConfigurationID(configurationID string) func(T) bool {
+	return func(v T) bool {
+		return t.configurationID(&v) == configurationID
+	}
+}
+
+// notificationConfigurationIDExists returns whether any entry of the notification configuration has the specified ID.
+func notificationConfigurationIDExists(notificationConfiguration *types.NotificationConfiguration, configurationID string) bool {
+	return slices.ContainsFunc(notificationConfiguration.LambdaFunctionConfigurations, func(v types.LambdaFunctionConfiguration) bool {
+		return aws.ToString(v.Id) == configurationID
+	}) || slices.ContainsFunc(notificationConfiguration.QueueConfigurations, func(v types.QueueConfiguration) bool {
+		return aws.ToString(v.Id) == configurationID
+	}) || slices.ContainsFunc(notificationConfiguration.TopicConfigurations, func(v types.TopicConfiguration) bool {
+		return aws.ToString(v.Id) == configurationID
+	})
+}
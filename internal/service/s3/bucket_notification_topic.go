@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_bucket_notification_topic", name="Bucket Notification Topic")
+func resourceBucketNotificationTopic() *schema.Resource {
+	return bucketNotificationTopic.resource()
+}
+
+var bucketNotificationTopic = &bucketNotificationTarget[types.TopicConfiguration]{
+	name:                  "Topic",
+	arnAttribute:          names.AttrTopicARN,
+	configurationIDPrefix: "tf-s3-topic-",
+	configurations: func(v *types.NotificationConfiguration) *[]types.TopicConfiguration {
+		return &v.TopicConfigurations
+	},
+	configurationID: func(v *types.TopicConfiguration) string {
+		return aws.ToString(v.Id)
+	},
+	expand: func(d *schema.ResourceData, configurationID string) types.TopicConfiguration {
+		return types.TopicConfiguration{
+			Events:   flex.ExpandStringyValueSet[types.Event](d.Get("events").(*schema.Set)),
+			Filter:   expandNotificationConfigurationFilter(d),
+			Id:       aws.String(configurationID),
+			TopicArn: aws.String(d.Get(names.AttrTopicARN).(string)),
+		}
+	},
+	flatten: func(d *schema.ResourceData, v *types.TopicConfiguration) {
+		d.Set("events", v.Events)
+		setNotificationConfigurationFilter(d, v.Filter)
+		d.Set(names.AttrTopicARN, v.TopicArn)
+	},
+}
@@ -143,6 +143,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_notification",
 			Name:     "Bucket Notification",
 		},
+		{
+			Factory:  resourceBucketNotificationLambdaFunction,
+			TypeName: "aws_s3_bucket_notification_lambda_function",
+			Name:     "Bucket Notification Lambda Function",
+		},
+		{
+			Factory:  resourceBucketNotificationQueue,
+			TypeName: "aws_s3_bucket_notification_queue",
+			Name:     "Bucket Notification Queue",
+		},
+		{
+			Factory:  resourceBucketNotificationTopic,
+			TypeName: "aws_s3_bucket_notification_topic",
+			Name:     "Bucket Notification Topic",
+		},
 		{
 			Factory:  resourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
@@ -1303,6 +1303,7 @@ func resourceDomain() *schema.Resource {
 						"docker_settings": {
 							Type:     schema.TypeList,
 							Optional: true,
+							Computed: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -1390,6 +1391,20 @@ func resourceDomain() *schema.Resource {
 							MaxItems: 3,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"trusted_identity_propagation_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrStatus: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.FeatureStatus](),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -1405,7 +1420,6 @@ func resourceDomain() *schema.Resource {
 			"retention_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -1566,7 +1580,8 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	// retention_policy is only sent on DeleteDomain.
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "retention_policy") {
 		input := &sagemaker.UpdateDomainInput{
 			DomainId: aws.String(d.Id()),
 		}
@@ -1684,6 +1699,26 @@ func expandDomainSettings(l []interface{}) *awstypes.DomainSettings {
 		config.RStudioServerProDomainSettings = expandRStudioServerProDomainSettings(v)
 	}
 
+	if v, ok := m["trusted_identity_propagation_settings"].([]interface{}); ok && len(v) > 0 {
+		config.TrustedIdentityPropagationSettings = expandTrustedIdentityPropagationSettings(v)
+	}
+
+	return config
+}
+
+func expandTrustedIdentityPropagationSettings(l []interface{}) *awstypes.TrustedIdentityPropagationSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &awstypes.TrustedIdentityPropagationSettings{}
+
+	if v, ok := m[names.AttrStatus].(string); ok && v != "" {
+		config.Status = awstypes.FeatureStatus(v)
+	}
+
 	return config
 }
 
@@ -1760,6 +1795,10 @@ func expandDomainSettingsUpdate(l []interface{}) *awstypes.DomainSettingsForUpda
 		config.RStudioServerProDomainSettingsForUpdate = expandRStudioServerProDomainSettingsUpdate(v)
 	}
 
+	if v, ok := m["trusted_identity_propagation_settings"].([]interface{}); ok && len(v) > 0 {
+		config.TrustedIdentityPropagationSettings = expandTrustedIdentityPropagationSettings(v)
+	}
+
 	return config
 }
 
@@ -3029,10 +3068,23 @@ func flattenDomainSettings(config *awstypes.DomainSettings) []map[string]interfa
 	}
 
 	m := map[string]interface{}{
-		"docker_settings":                     flattenDockerSettings(config.DockerSettings),
-		"execution_role_identity_config":      config.ExecutionRoleIdentityConfig,
-		"r_studio_server_pro_domain_settings": flattenRStudioServerProDomainSettings(config.RStudioServerProDomainSettings),
-		names.AttrSecurityGroupIDs:            flex.FlattenStringValueSet(config.SecurityGroupIds),
+		"docker_settings":                       flattenDockerSettings(config.DockerSettings),
+		"execution_role_identity_config":        config.ExecutionRoleIdentityConfig,
+		"r_studio_server_pro_domain_settings":   flattenRStudioServerProDomainSettings(config.RStudioServerProDomainSettings),
+		names.AttrSecurityGroupIDs:              flex.FlattenStringValueSet(config.SecurityGroupIds),
+		"trusted_identity_propagation_settings": flattenTrustedIdentityPropagationSettings(config.TrustedIdentityPropagationSettings),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenTrustedIdentityPropagationSettings(config *awstypes.TrustedIdentityPropagationSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		names.AttrStatus: config.Status,
 	}
 
 	return []map[string]interface{}{m}
@@ -71,6 +71,7 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"workspaceSettings":                                       testAccDomain_workspaceSettings,
 			"domainSettings":                                          testAccDomain_domainSettings,
 			"domainSettingsDockerSettingsUpdated":                     testAccDomain_domainSettingsDockerSettingsUpdated,
+			"domainSettingsTrustedIdentityPropagationSettings":        testAccDomain_domainSettingsTrustedIdentityPropagationSettings,
 			"rSessionAppSettings":                                     testAccDomain_rSessionAppSettings,
 			"rStudioServerProAppSettings":                             testAccDomain_rStudioServerProAppSettings,
 			"rStudioServerProDomainSettings":                          testAccDomain_rStudioServerProDomainSettings,
@@ -83,6 +84,7 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"code":                                                    testAccDomain_jupyterServerAppSettings_code,
 			"efs":                                                     testAccDomain_efs,
 			"posix":                                                   testAccDomain_posix,
+			"retentionPolicyUpdated":                                  testAccDomain_retentionPolicyUpdated,
 			"spaceStorageSettings":                                    testAccDomain_spaceStorageSettings,
 			"studioWebPortalSettings_hiddenAppTypes":                  testAccDomain_studioWebPortalSettings_hiddenAppTypes,
 			"studioWebPortalSettings_hiddenInstanceTypes":             testAccDomain_studioWebPortalSettings_hiddenInstanceTypes,
@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
@@ -32,6 +35,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	secretReplicationTimeout = 10 * time.Minute
+)
+
 // @SDKResource("aws_secretsmanager_secret", name="Secret")
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/secretsmanager;secretsmanager.DescribeSecretOutput")
@@ -206,6 +213,12 @@ func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret (%s) create: %s", d.Id(), err)
 	}
 
+	if len(input.AddReplicaRegions) > 0 {
+		if _, err := waitSecretReplicasInSync(ctx, conn, d.Id(), secretReplicationTimeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret (%s) replication: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk(names.AttrPolicy); ok && v.(string) != "" && v.(string) != "{}" {
 		policy, err := structure.NormalizeJsonString(v.(string))
 		if err != nil {
@@ -308,6 +321,10 @@ func resourceSecretUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			if err := addSecretReplicas(ctx, conn, d.Id(), d.Get("force_overwrite_replica_secret").(bool), expandReplicaRegionTypes(add)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
+
+			if _, err := waitSecretReplicasInSync(ctx, conn, d.Id(), secretReplicationTimeout); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret (%s) replication: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -430,13 +447,20 @@ func removeSecretReplicas(ctx context.Context, conn *secretsmanager.Client, id s
 		SecretId:             aws.String(id),
 	}
 
-	_, err := conn.RemoveRegionsFromReplication(ctx, input)
+	output, err := conn.RemoveRegionsFromReplication(ctx, input)
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
 		return nil
 	}
 
 	if err != nil {
+		return fmt.Errorf("removing Secrets Manager Secret (%s) replicas (%s): %w", id, strings.Join(regions, ", "), err)
+	}
+
+	// Regions that could not be removed are reported back with a Failed status.
+	if err := replicationStatusError(tfslices.Filter(output.ReplicationStatus, func(v types.ReplicationStatusType) bool {
+		return slices.Contains(regions, aws.ToString(v.Region))
+	})); err != nil {
 		return fmt.Errorf("removing Secrets Manager Secret (%s) replicas: %w", id, err)
 	}
 
@@ -508,6 +532,64 @@ func findSecretByID(ctx context.Context, conn *secretsmanager.Client, id string)
 	return output, nil
 }
 
+func statusSecretReplication(ctx context.Context, conn *secretsmanager.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSecretByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := types.StatusTypeInSync
+		for _, v := range output.ReplicationStatus {
+			switch v.Status {
+			case types.StatusTypeFailed:
+				return output, string(types.StatusTypeFailed), nil
+			case types.StatusTypeInProgress:
+				status = types.StatusTypeInProgress
+			}
+		}
+
+		return output, string(status), nil
+	}
+}
+
+func waitSecretReplicasInSync(ctx context.Context, conn *secretsmanager.Client, id string, timeout time.Duration) (*secretsmanager.DescribeSecretOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.StatusTypeInProgress),
+		Target:  enum.Slice(types.StatusTypeInSync),
+		Refresh: statusSecretReplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*secretsmanager.DescribeSecretOutput); ok {
+		tfresource.SetLastError(err, replicationStatusError(output.ReplicationStatus))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// replicationStatusError returns an error naming each replica Region whose replication has failed.
+func replicationStatusError(apiObjects []types.ReplicationStatusType) error {
+	var errList []error
+
+	for _, v := range apiObjects {
+		if v.Status == types.StatusTypeFailed {
+			errList = append(errList, fmt.Errorf("%s: %s", aws.ToString(v.Region), aws.ToString(v.StatusMessage)))
+		}
+	}
+
+	return errors.Join(errList...)
+}
+
 func expandReplicaRegionType(tfMap map[string]interface{}) *types.ReplicaRegionType {
 	if tfMap == nil {
 		return nil
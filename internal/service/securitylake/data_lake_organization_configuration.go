@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_securitylake_data_lake_organization_configuration", name="Data Lake Organization Configuration")
+func newDataLakeOrganizationConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dataLakeOrganizationConfigurationResource{}
+
+	return r, nil
+}
+
+type dataLakeOrganizationConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"auto_enable_new_account": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeAutoEnableNewAccountConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrRegion: schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrSource: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeAutoEnableNewAccountSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"source_name": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AwsLogSourceName](),
+										Required:   true,
+									},
+									"source_version": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dataLakeOrganizationConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateDataLakeOrganizationConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.CreateDataLakeOrganizationConfigurationOutput, error) {
+		return conn.CreateDataLakeOrganizationConfiguration(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Security Lake Data Lake Organization Configuration", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, r.Meta().Region(ctx))
+
+	output, err := findDataLakeOrganizationConfiguration(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake Organization Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dataLakeOrganizationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findDataLakeOrganizationConfiguration(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake Organization Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new dataLakeOrganizationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.AutoEnableNewAccount.Equal(old.AutoEnableNewAccount) {
+		// There is no update API, so the previous configuration is removed before the new one is created.
+		deleteInput := &securitylake.DeleteDataLakeOrganizationConfigurationInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, old, deleteInput)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.DeleteDataLakeOrganizationConfigurationOutput, error) {
+			return conn.DeleteDataLakeOrganizationConfiguration(ctx, deleteInput)
+		})
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Data Lake Organization Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		createInput := &securitylake.CreateDataLakeOrganizationConfigurationInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, createInput)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err = retryDataLakeConflictWithMutex(ctx, func() (*securitylake.CreateDataLakeOrganizationConfigurationOutput, error) {
+			return conn.CreateDataLakeOrganizationConfiguration(ctx, createInput)
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Data Lake Organization Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findDataLakeOrganizationConfiguration(ctx, conn)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake Organization Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *dataLakeOrganizationConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dataLakeOrganizationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.DeleteDataLakeOrganizationConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.DeleteDataLakeOrganizationConfigurationOutput, error) {
+		return conn.DeleteDataLakeOrganizationConfiguration(ctx, input)
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake Organization Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findDataLakeOrganizationConfiguration(ctx context.Context, conn *securitylake.Client) (*securitylake.GetDataLakeOrganizationConfigurationOutput, error) {
+	input := &securitylake.GetDataLakeOrganizationConfigurationInput{}
+
+	output, err := conn.GetDataLakeOrganizationConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AutoEnableNewAccount) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type dataLakeOrganizationConfigurationResourceModel struct {
+	AutoEnableNewAccount fwtypes.ListNestedObjectValueOf[dataLakeAutoEnableNewAccountConfigurationModel] `tfsdk:"auto_enable_new_account"`
+	ID                   types.String                                                                    `tfsdk:"id"`
+}
+
+type dataLakeAutoEnableNewAccountConfigurationModel struct {
+	Region  types.String                                                             `tfsdk:"region"`
+	Sources fwtypes.ListNestedObjectValueOf[dataLakeAutoEnableNewAccountSourceModel] `tfsdk:"source"`
+}
+
+type dataLakeAutoEnableNewAccountSourceModel struct {
+	SourceName    fwtypes.StringEnum[awstypes.AwsLogSourceName] `tfsdk:"source_name"`
+	SourceVersion types.String                                  `tfsdk:"source_version"`
+}
@@ -5,15 +5,17 @@ package securitylake
 
 // Exports for use in tests only.
 var (
-	ResourceAWSLogSource           = newAWSLogSourceResource
-	ResourceCustomLogSource        = newCustomLogSourceResource
-	ResourceDataLake               = newDataLakeResource
-	ResourceSubscriber             = newSubscriberResource
-	ResourceSubscriberNotification = newSubscriberNotificationResource
+	ResourceAWSLogSource                      = newAWSLogSourceResource
+	ResourceCustomLogSource                   = newCustomLogSourceResource
+	ResourceDataLake                          = newDataLakeResource
+	ResourceDataLakeOrganizationConfiguration = newDataLakeOrganizationConfigurationResource
+	ResourceSubscriber                        = newSubscriberResource
+	ResourceSubscriberNotification            = newSubscriberNotificationResource
 
 	FindAWSLogSourceBySourceName             = findAWSLogSourceBySourceName
 	FindCustomLogSourceBySourceName          = findCustomLogSourceBySourceName
 	FindDataLakeByARN                        = findDataLakeByARN
+	FindDataLakeOrganizationConfiguration    = findDataLakeOrganizationConfiguration
 	FindDataLakes                            = findDataLakes
 	FindSubscriberByID                       = findSubscriberByID
 	FindSubscriberNotificationBySubscriberID = findSubscriberNotificationBySubscriberID
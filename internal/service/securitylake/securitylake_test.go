@@ -45,6 +45,10 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"lifecycleUpdate":    testAccDataLake_lifeCycleUpdate,
 			"replication":        testAccDataLake_replication,
 		},
+		"DataLakeOrganizationConfiguration": {
+			acctest.CtBasic:      testAccDataLakeOrganizationConfiguration_basic,
+			acctest.CtDisappears: testAccDataLakeOrganizationConfiguration_disappears,
+		},
 		"Subscriber": {
 			"accessType":         testAccSubscriber_accessType,
 			acctest.CtBasic:      testAccSubscriber_basic,
@@ -38,6 +38,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newDataLakeOrganizationConfigurationResource,
+			TypeName: "aws_securitylake_data_lake_organization_configuration",
+			Name:     "Data Lake Organization Configuration",
+		},
 		{
 			Factory:  newSubscriberResource,
 			TypeName: "aws_securitylake_subscriber",
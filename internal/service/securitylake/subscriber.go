@@ -788,7 +788,17 @@ func (rd *subscriberResourceModel) refreshFromOutput(ctx context.Context, subscr
 		return diags
 	}
 
-	rd.AccessTypes = fwflex.StringValueToFramework(ctx, subscriber.AccessTypes[0])
+	switch {
+	case slices.Any(subscriber.AccessTypes, slices.PredicateEquals(awstypes.AccessType(rd.AccessTypes.ValueString()))):
+		// Keep the configured access type.
+	case len(subscriber.AccessTypes) > 0:
+		rd.AccessTypes = fwflex.StringValueToFramework(ctx, subscriber.AccessTypes[0])
+	case subscriber.ResourceShareArn != nil:
+		// Lake Formation subscribers are not always returned with an access type, but they are the only ones with a resource share.
+		rd.AccessTypes = fwflex.StringValueToFramework(ctx, awstypes.AccessTypeLakeformation)
+	default:
+		rd.AccessTypes = fwflex.StringValueToFramework(ctx, awstypes.AccessTypeS3)
+	}
 	rd.SubscriberIdentity = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &subscriberIdentity)
 	rd.ResourceShareArn = fwflex.StringToFrameworkLegacy(ctx, subscriber.ResourceShareArn)
 	rd.ResourceShareName = fwflex.StringToFramework(ctx, subscriber.ResourceShareName)
@@ -29,9 +29,9 @@ import (
 // @SDKResource("aws_service_discovery_instance", name="Instance")
 func resourceInstance() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceInstancePut,
+		CreateWithoutTimeout: resourceInstanceCreate,
 		ReadWithoutTimeout:   resourceInstanceRead,
-		UpdateWithoutTimeout: resourceInstancePut,
+		UpdateWithoutTimeout: resourceInstanceUpdate,
 		DeleteWithoutTimeout: resourceInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -69,32 +69,17 @@ func resourceInstance() *schema.Resource {
 	}
 }
 
-func resourceInstancePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx)
 
 	instanceID := d.Get(names.AttrInstanceID).(string)
-	input := &servicediscovery.RegisterInstanceInput{
-		Attributes:       flex.ExpandStringValueMap(d.Get(names.AttrAttributes).(map[string]interface{})),
-		CreatorRequestId: aws.String(id.UniqueId()),
-		InstanceId:       aws.String(instanceID),
-		ServiceId:        aws.String(d.Get("service_id").(string)),
-	}
-
-	output, err := conn.RegisterInstance(ctx, input)
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "registering Service Discovery Instance (%s): %s", instanceID, err)
+	if err := registerInstance(ctx, conn, d.Get("service_id").(string), instanceID, d.Get(names.AttrAttributes).(map[string]interface{})); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	d.SetId(instanceID)
 
-	if output != nil && output.OperationId != nil {
-		if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Service Discovery Instance (%s) create: %s", d.Id(), err)
-		}
-	}
-
 	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
@@ -120,6 +105,12 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if _, ok := attributes["AWS_EC2_INSTANCE_ID"]; ok {
 		delete(attributes, "AWS_INSTANCE_IPV4")
 	}
+	// AWS_INIT_HEALTH_STATUS is only applied at registration and isn't updated by UpdateInstanceCustomHealthStatus.
+	if v, ok := d.Get(names.AttrAttributes).(map[string]interface{})[attributeInitHealthStatus].(string); ok {
+		if _, ok := attributes[attributeInitHealthStatus]; ok {
+			attributes[attributeInitHealthStatus] = v
+		}
+	}
 
 	d.Set(names.AttrAttributes, attributes)
 	d.Set(names.AttrInstanceID, instance.Id)
@@ -127,6 +118,41 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx)
+
+	serviceID, instanceID := d.Get("service_id").(string), d.Get(names.AttrInstanceID).(string)
+	o, n := d.GetChange(names.AttrAttributes)
+	oldAttributes, newAttributes := o.(map[string]interface{}), n.(map[string]interface{})
+
+	if status, ok := onlyInitHealthStatusChanged(oldAttributes, newAttributes); ok {
+		input := &servicediscovery.UpdateInstanceCustomHealthStatusInput{
+			InstanceId: aws.String(instanceID),
+			ServiceId:  aws.String(serviceID),
+			Status:     awstypes.CustomHealthStatus(status),
+		}
+
+		_, err := conn.UpdateInstanceCustomHealthStatus(ctx, input)
+
+		switch {
+		case err == nil:
+			return append(diags, resourceInstanceRead(ctx, d, meta)...)
+		case errs.IsA[*awstypes.CustomHealthNotFound](err):
+			// The service has no custom health check configuration, re-register the instance.
+		default:
+			return sdkdiag.AppendErrorf(diags, "updating Service Discovery Instance (%s) custom health status: %s", d.Id(), err)
+		}
+	}
+
+	// RegisterInstance is an upsert, so the instance is updated in place without first being deregistered.
+	if err := registerInstance(ctx, conn, serviceID, instanceID, newAttributes); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return append(diags, resourceInstanceRead(ctx, d, meta)...)
+}
+
 func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx)
@@ -155,6 +181,52 @@ func resourceInstanceImport(ctx context.Context, d *schema.ResourceData, meta in
 	return []*schema.ResourceData{d}, nil
 }
 
+const (
+	attributeInitHealthStatus = "AWS_INIT_HEALTH_STATUS"
+)
+
+// onlyInitHealthStatusChanged returns the new AWS_INIT_HEALTH_STATUS value if that is the only attribute that differs.
+func onlyInitHealthStatusChanged(o, n map[string]interface{}) (string, bool) {
+	if len(o) != len(n) {
+		return "", false
+	}
+
+	for k, v := range n {
+		if ov, ok := o[k]; !ok || (k != attributeInitHealthStatus && ov != v) {
+			return "", false
+		}
+	}
+
+	if o[attributeInitHealthStatus] == n[attributeInitHealthStatus] {
+		return "", false
+	}
+
+	return n[attributeInitHealthStatus].(string), true
+}
+
+func registerInstance(ctx context.Context, conn *servicediscovery.Client, serviceID, instanceID string, attributes map[string]interface{}) error {
+	input := &servicediscovery.RegisterInstanceInput{
+		Attributes:       flex.ExpandStringValueMap(attributes),
+		CreatorRequestId: aws.String(id.UniqueId()),
+		InstanceId:       aws.String(instanceID),
+		ServiceId:        aws.String(serviceID),
+	}
+
+	output, err := conn.RegisterInstance(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("registering Service Discovery Service (%s) Instance (%s): %w", serviceID, instanceID, err)
+	}
+
+	if output != nil && output.OperationId != nil {
+		if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId)); err != nil {
+			return fmt.Errorf("waiting for Service Discovery Service (%s) Instance (%s) register: %w", serviceID, instanceID, err)
+		}
+	}
+
+	return nil
+}
+
 func deregisterInstance(ctx context.Context, conn *servicediscovery.Client, serviceID, instanceID string) error {
 	input := &servicediscovery.DeregisterInstanceInput{
 		InstanceId: aws.String(instanceID),
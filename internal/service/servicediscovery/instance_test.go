@@ -10,6 +10,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -154,6 +155,58 @@ func TestAccServiceDiscoveryInstance_http(t *testing.T) {
 	})
 }
 
+func TestAccServiceDiscoveryInstance_customHealthStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_service_discovery_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceDiscoveryEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceDiscoveryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_customHealth(rName, domainName, "AWS_INSTANCE_IPV4 = \"172.18.0.12\" \n    AWS_INIT_HEALTH_STATUS = \"UNHEALTHY\""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INIT_HEALTH_STATUS", "UNHEALTHY"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_customHealth(rName, domainName, "AWS_INSTANCE_IPV4 = \"172.18.0.12\" \n    AWS_INIT_HEALTH_STATUS = \"HEALTHY\""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INIT_HEALTH_STATUS", "HEALTHY"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "172.18.0.12"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_customHealth(rName, domainName, "AWS_INSTANCE_IPV4 = \"172.18.0.13\" \n    AWS_INIT_HEALTH_STATUS = \"HEALTHY\""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "172.18.0.13"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -243,6 +296,23 @@ func testAccInstanceConfig_http(rName, domainName, attributes string) string {
 	)
 }
 
+func testAccInstanceConfig_customHealth(rName, domainName, attributes string) string {
+	return acctest.ConfigCompose(fmt.Sprintf(`
+resource "aws_service_discovery_http_namespace" "test" {
+  name = %[2]q
+}
+
+resource "aws_service_discovery_service" "test" {
+  name         = %[1]q
+  namespace_id = aws_service_discovery_http_namespace.test.id
+
+  health_check_custom_config {
+    failure_threshold = 1
+  }
+}`, rName, domainName),
+		testAccInstanceConfig_basic(rName, attributes))
+}
+
 func testAccInstanceConfig_privateNamespace(rName, domainName string) string {
 	return fmt.Sprintf(`
 resource "aws_service_discovery_private_dns_namespace" "test" {
@@ -43,6 +43,20 @@ func resourceConfigurationSet() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"archiving_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"archive_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -204,6 +218,10 @@ func resourceConfigurationSetCreate(ctx context.Context, d *schema.ResourceData,
 		Tags:                 getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("archiving_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ArchivingOptions = expandArchivingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.DeliveryOptions = expandDeliveryOptions(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -265,6 +283,13 @@ func resourceConfigurationSetRead(ctx context.Context, d *schema.ResourceData, m
 		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, resNameConfigurationSet, d.Id(), err)
 	}
 
+	if output.ArchivingOptions != nil && aws.ToString(output.ArchivingOptions.ArchiveArn) != "" {
+		if err := d.Set("archiving_options", []interface{}{flattenArchivingOptions(output.ArchivingOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting archiving_options: %s", err)
+		}
+	} else {
+		d.Set("archiving_options", nil)
+	}
 	d.Set(names.AttrARN, configurationSetARN(ctx, meta.(*conns.AWSClient), aws.ToString(output.ConfigurationSetName)))
 	d.Set("configuration_set_name", output.ConfigurationSetName)
 	if output.DeliveryOptions != nil {
@@ -302,8 +327,9 @@ func resourceConfigurationSetRead(ctx context.Context, d *schema.ResourceData, m
 	} else {
 		d.Set("tracking_options", nil)
 	}
-	if output.VdmOptions != nil {
-		if err := d.Set("vdm_options", []interface{}{flattenVDMOptions(output.VdmOptions)}); err != nil {
+	// Settings inherited from the account-level VDM attributes are returned without a status.
+	if v := flattenVDMOptions(output.VdmOptions); len(v) > 0 {
+		if err := d.Set("vdm_options", []interface{}{v}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting vdm_options: %s", err)
 		}
 	} else {
@@ -317,6 +343,22 @@ func resourceConfigurationSetUpdate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
 
+	if d.HasChanges("archiving_options") {
+		input := &sesv2.PutConfigurationSetArchivingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("archiving_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ArchiveArn = expandArchivingOptions(v.([]interface{})[0].(map[string]interface{})).ArchiveArn
+		}
+
+		_, err := conn.PutConfigurationSetArchivingOptions(ctx, input)
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.SESV2, create.ErrActionUpdating, resNameConfigurationSet, d.Id(), err)
+		}
+	}
+
 	if d.HasChanges("delivery_options") {
 		input := &sesv2.PutConfigurationSetDeliveryOptionsInput{
 			ConfigurationSetName: aws.String(d.Id()),
@@ -497,6 +539,18 @@ func findConfigurationSet(ctx context.Context, conn *sesv2.Client, input *sesv2.
 	return output, nil
 }
 
+func flattenArchivingOptions(apiObject *types.ArchivingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"archive_arn": aws.ToString(apiObject.ArchiveArn),
+	}
+
+	return tfMap
+}
+
 func flattenDeliveryOptions(apiObject *types.DeliveryOptions) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -582,11 +636,11 @@ func flattenVDMOptions(apiObject *types.VdmOptions) map[string]interface{} {
 
 	tfMap := map[string]interface{}{}
 
-	if v := apiObject.DashboardOptions; v != nil {
+	if v := apiObject.DashboardOptions; v != nil && v.EngagementMetrics != "" {
 		tfMap["dashboard_options"] = []interface{}{flattenDashboardOptions(v)}
 	}
 
-	if v := apiObject.GuardianOptions; v != nil {
+	if v := apiObject.GuardianOptions; v != nil && v.OptimizedSharedDelivery != "" {
 		tfMap["guardian_options"] = []interface{}{flattenGuardianOptions(v)}
 	}
 
@@ -617,6 +671,20 @@ func flattenGuardianOptions(apiObject *types.GuardianOptions) map[string]interfa
 	return tfMap
 }
 
+func expandArchivingOptions(tfMap map[string]interface{}) *types.ArchivingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ArchivingOptions{}
+
+	if v, ok := tfMap["archive_arn"].(string); ok && v != "" {
+		apiObject.ArchiveArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandDeliveryOptions(tfMap map[string]interface{}) *types.DeliveryOptions {
 	if tfMap == nil {
 		return nil
@@ -28,6 +28,18 @@ func dataSourceConfigurationSet() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"archiving_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"archive_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"configuration_set_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -167,6 +179,13 @@ func dataSourceConfigurationSetRead(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(aws.ToString(output.ConfigurationSetName))
 
+	if output.ArchivingOptions != nil && aws.ToString(output.ArchivingOptions.ArchiveArn) != "" {
+		if err := d.Set("archiving_options", []interface{}{flattenArchivingOptions(output.ArchivingOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting archiving_options: %s", err)
+		}
+	} else {
+		d.Set("archiving_options", nil)
+	}
 	d.Set(names.AttrARN, configurationSetARN(ctx, meta.(*conns.AWSClient), aws.ToString(output.ConfigurationSetName)))
 	d.Set("configuration_set_name", output.ConfigurationSetName)
 	if output.DeliveryOptions != nil {
@@ -204,8 +223,9 @@ func dataSourceConfigurationSetRead(ctx context.Context, d *schema.ResourceData,
 	} else {
 		d.Set("tracking_options", nil)
 	}
-	if output.VdmOptions != nil {
-		if err := d.Set("vdm_options", []interface{}{flattenVDMOptions(output.VdmOptions)}); err != nil {
+	// Settings inherited from the account-level VDM attributes are returned without a status.
+	if v := flattenVDMOptions(output.VdmOptions); len(v) > 0 {
+		if err := d.Set("vdm_options", []interface{}{v}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting vdm_options: %s", err)
 		}
 	} else {
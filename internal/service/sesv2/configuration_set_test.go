@@ -255,6 +255,42 @@ func TestAccSESV2ConfigurationSet_suppressedReasonsEmpty(t *testing.T) {
 	})
 }
 
+func TestAccSESV2ConfigurationSet_archivingOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	archiveARN := acctest.SkipIfEnvVarNotSet(t, "SES_MAIL_MANAGER_ARCHIVE_ARN")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetConfig_archivingOptions(rName, archiveARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "archiving_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "archiving_options.0.archive_arn", archiveARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "archiving_options.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSESV2ConfigurationSet_engagementMetrics(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -289,6 +325,14 @@ func TestAccSESV2ConfigurationSet_engagementMetrics(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "vdm_options.0.dashboard_options.0.engagement_metrics", string(types.FeatureStatusDisabled)),
 				),
 			},
+			{
+				// Removing the configuration set override falls back to the account-level VDM attributes.
+				Config: testAccConfigurationSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -479,3 +523,15 @@ resource "aws_sesv2_configuration_set" "test" {
 }
 `, rName, optimizedSharedDelivery)
 }
+
+func testAccConfigurationSetConfig_archivingOptions(rName, archiveARN string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  archiving_options {
+    archive_arn = %[2]q
+  }
+}
+`, rName, archiveARN)
+}
@@ -231,7 +231,7 @@ func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, met
 	// Note: the instance may be in a deleting mode, hence the retry
 	// when creating the step function. This can happen when we are
 	// updating the resource (since there is no update API call).
-	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+	output, err := tfresource.RetryGWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (*sfn.CreateStateMachineOutput, error) {
 		return conn.CreateStateMachine(ctx, input)
 	}, "StateMachineDeleting", "AccessDeniedException")
 
@@ -239,7 +239,7 @@ func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "creating Step Functions State Machine (%s): %s", name, err)
 	}
 
-	d.SetId(aws.ToString(outputRaw.(*sfn.CreateStateMachineOutput).StateMachineArn))
+	d.SetId(aws.ToString(output.StateMachineArn))
 
 	return append(diags, resourceStateMachineRead(ctx, d, meta)...)
 }
@@ -360,11 +360,12 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 
 		// Handle eventual consistency after update.
-		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError { // nosemgrep:ci.helper-schema-retry-RetryContext-without-TimeoutError-check
+		errNotUpdated := fmt.Errorf("Step Functions State Machine (%s) eventual consistency", d.Id())
+		_, err = tfresource.RetryGWhenWithBackoff(ctx, d.Timeout(schema.TimeoutUpdate), func() (*sfn.DescribeStateMachineOutput, error) {
 			output, err := findStateMachineByARN(ctx, conn, d.Id())
 
 			if err != nil {
-				return retry.NonRetryableError(err)
+				return nil, err
 			}
 
 			if d.HasChange("definition") && !verify.JSONBytesEqual([]byte(aws.ToString(output.Definition)), []byte(d.Get("definition").(string))) ||
@@ -376,10 +377,12 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 				d.HasChange("encryption_configuration.0.kms_key_id") && output.EncryptionConfiguration != nil && output.EncryptionConfiguration.KmsKeyId != nil && aws.ToString(output.EncryptionConfiguration.KmsKeyId) != d.Get("encryption_configuration.0.kms_key_id") ||
 				d.HasChange("encryption_configuration.0.encryption_type") && output.EncryptionConfiguration != nil && string(output.EncryptionConfiguration.Type) != d.Get("encryption_configuration.0.encryption_type").(string) ||
 				d.HasChange("encryption_configuration.0.kms_data_key_reuse_period_seconds") && output.EncryptionConfiguration != nil && output.EncryptionConfiguration.KmsDataKeyReusePeriodSeconds != nil && aws.ToInt32(output.EncryptionConfiguration.KmsDataKeyReusePeriodSeconds) != int32(d.Get("encryption_configuration.0.kms_data_key_reuse_period_seconds").(int)) {
-				return retry.RetryableError(fmt.Errorf("Step Functions State Machine (%s) eventual consistency", d.Id()))
+				return nil, errNotUpdated
 			}
 
-			return nil
+			return output, nil
+		}, func(err error) (bool, error) {
+			return errors.Is(err, errNotUpdated), err
 		})
 
 		if err != nil {
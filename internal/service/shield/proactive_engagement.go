@@ -95,10 +95,27 @@ func (r *proactiveEngagementResource) Create(ctx context.Context, request resour
 		return
 	}
 
-	_, err := conn.AssociateProactiveEngagementDetails(ctx, input)
+	// Proactive engagement can't be configured until DRT access and emergency contacts have propagated,
+	// which surfaces as an InvalidOperationException when they are created in the same apply.
+	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.AssociateProactiveEngagementDetails(ctx, input)
+		},
+		func(err error) (bool, error) {
+			// "InvalidOperationException: Proactive engagement details are already associated with the subscription. Please use Enable/DisableProactiveEngagement APIs to update it's status".
+			if errs.IsAErrorMessageContains[*awstypes.InvalidOperationException](err, "already associated") {
+				return false, nil
+			}
+
+			if errs.IsA[*awstypes.InvalidOperationException](err) {
+				return true, err
+			}
 
-	// "InvalidOperationException: Proactive engagement details are already associated with the subscription. Please use Enable/DisableProactiveEngagement APIs to update it's status".
-	if err != nil && !errs.IsA[*awstypes.InvalidOperationException](err) {
+			return false, err
+		},
+	)
+
+	if err != nil {
 		response.Diagnostics.AddError("creating Shield Proactive Engagement", err.Error())
 
 		return
@@ -249,7 +266,9 @@ func enableProactiveEngagement(ctx context.Context, conn *shield.Client) diag.Di
 	var diags diag.Diagnostics
 	input := &shield.EnableProactiveEngagementInput{}
 
-	_, err := conn.EnableProactiveEngagement(ctx, input)
+	_, err := tfresource.RetryWhenIsA[*awstypes.InvalidOperationException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.EnableProactiveEngagement(ctx, input)
+	})
 
 	if err != nil {
 		diags.AddError("enabling Shield proactive engagement", err.Error())
@@ -23,6 +23,7 @@ func ResourceProtectionHealthCheckAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: ResourceProtectionHealthCheckAssociationCreate,
 		ReadWithoutTimeout:   ResourceProtectionHealthCheckAssociationRead,
+		UpdateWithoutTimeout: ResourceProtectionHealthCheckAssociationUpdate,
 		DeleteWithoutTimeout: ResourceProtectionHealthCheckAssociationDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -37,7 +38,6 @@ func ResourceProtectionHealthCheckAssociation() *schema.Resource {
 			"health_check_arn": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 		},
 	}
@@ -102,6 +102,44 @@ func ResourceProtectionHealthCheckAssociationRead(ctx context.Context, d *schema
 	return diags
 }
 
+func ResourceProtectionHealthCheckAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldClient(ctx)
+
+	if d.HasChange("health_check_arn") {
+		protectionId := d.Get("shield_protection_id").(string)
+		o, n := d.GetChange("health_check_arn")
+		oldHealthCheckArn, newHealthCheckArn := o.(string), n.(string)
+
+		// A protection can only have one associated health check, so the old one is disassociated first.
+		inputD := &shield.DisassociateHealthCheckInput{
+			ProtectionId:   aws.String(protectionId),
+			HealthCheckArn: aws.String(oldHealthCheckArn),
+		}
+
+		_, err := conn.DisassociateHealthCheck(ctx, inputD)
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return sdkdiag.AppendErrorf(diags, "disassociating Route53 Health Check (%s) from Shield Protected resource (%s): %s", oldHealthCheckArn, protectionId, err)
+		}
+
+		inputA := &shield.AssociateHealthCheckInput{
+			ProtectionId:   aws.String(protectionId),
+			HealthCheckArn: aws.String(newHealthCheckArn),
+		}
+
+		_, err = conn.AssociateHealthCheck(ctx, inputA)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "associating Route53 Health Check (%s) with Shield Protected resource (%s): %s", newHealthCheckArn, protectionId, err)
+		}
+
+		d.SetId(ProtectionHealthCheckAssociationCreateResourceID(protectionId, newHealthCheckArn))
+	}
+
+	return append(diags, ResourceProtectionHealthCheckAssociationRead(ctx, d, meta)...)
+}
+
 func ResourceProtectionHealthCheckAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ShieldClient(ctx)
@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -51,6 +52,61 @@ func TestAccShieldProtectionHealthCheckAssociation_basic(t *testing.T) {
 	})
 }
 
+func TestAccShieldProtectionHealthCheckAssociation_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_protection_health_check_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ShieldEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ShieldServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProtectionHealthCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProtectionHealthCheckAssociationConfig_update(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProtectionHealthCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "health_check_arn", "aws_route53_health_check.test.0", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccProtectionHealthCheckAssociationConfig_update(rName, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProtectionHealthCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "health_check_arn", "aws_route53_health_check.test.1", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProtectionHealthCheckAssociationConfig_update(rName, 0),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProtectionHealthCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "health_check_arn", "aws_route53_health_check.test.0", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccShieldProtectionHealthCheckAssociation_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -200,3 +256,44 @@ resource "aws_shield_protection_health_check_association" "test" {
 }
 `, rName)
 }
+
+func testAccProtectionHealthCheckAssociationConfig_update(rName string, index int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_eip" "test" {
+  domain = "vpc"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_shield_protection" "test" {
+  name         = %[1]q
+  resource_arn = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:eip-allocation/${aws_eip.test.id}"
+}
+
+resource "aws_route53_health_check" "test" {
+  count = 2
+
+  fqdn              = "example.com"
+  port              = 80
+  type              = "HTTP"
+  resource_path     = "/${count.index}"
+  failure_threshold = "5"
+  request_interval  = "30"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_shield_protection_health_check_association" "test" {
+  shield_protection_id = aws_shield_protection.test.id
+  health_check_arn     = aws_route53_health_check.test[%[2]d].arn
+}
+`, rName, index)
+}
@@ -14,10 +14,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -70,7 +72,7 @@ var (
 		"filter_policy": {
 			Type:                  schema.TypeString,
 			Optional:              true,
-			ValidateFunc:          validation.StringIsJSON,
+			ValidateFunc:          validation.Any(validation.StringIsEmpty, validation.StringIsJSON),
 			DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
 			DiffSuppressOnRefresh: true,
 			StateFunc: func(v interface{}) string {
@@ -168,7 +170,10 @@ func resourceTopicSubscription() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: resourceTopicSubscriptionCustomizeDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceTopicSubscriptionCustomizeDiff,
+			resourceTopicSubscriptionValidateCustomizeDiff,
+		),
 
 		Schema: subscriptionSchema,
 	}
@@ -261,6 +266,16 @@ func resourceTopicSubscriptionUpdate(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	// Replacing or re-adding a filter policy resets the scope to the API default,
+	// so always resend the scope alongside the policy.
+	if d.HasChange("filter_policy") && d.Get("filter_policy").(string) != "" {
+		if _, ok := attributes[subscriptionAttributeNameFilterPolicyScope]; !ok {
+			if v := d.Get("filter_policy_scope").(string); v != "" {
+				attributes[subscriptionAttributeNameFilterPolicyScope] = v
+			}
+		}
+	}
+
 	err = putSubscriptionAttributes(ctx, conn, d.Id(), attributes)
 
 	if err != nil {
@@ -575,3 +590,29 @@ func resourceTopicSubscriptionCustomizeDiff(_ context.Context, diff *schema.Reso
 
 	return nil
 }
+
+func resourceTopicSubscriptionValidateCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get(names.AttrProtocol).(string) == subscriptionProtocolFirehose && diff.NewValueKnown("subscription_role_arn") && diff.Get("subscription_role_arn").(string) == "" {
+		return fmt.Errorf("subscription_role_arn is required when protocol is %s", subscriptionProtocolFirehose)
+	}
+
+	if diff.NewValueKnown("redrive_policy") {
+		if v := diff.Get("redrive_policy").(string); v != "" {
+			var policy struct {
+				DeadLetterTargetARN string `json:"deadLetterTargetArn"`
+			}
+
+			if err := json.Unmarshal([]byte(v), &policy); err != nil {
+				return fmt.Errorf("parsing redrive_policy: %w", err)
+			}
+
+			if v := policy.DeadLetterTargetARN; v != "" {
+				if parsedARN, err := arn.Parse(v); err != nil || parsedARN.Service != "sqs" {
+					return fmt.Errorf("redrive_policy deadLetterTargetArn (%s) must be an Amazon SQS queue ARN", v)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -158,6 +158,22 @@ func TestAccSNSTopicSubscription_filterPolicy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "filter_policy_scope", "MessageAttributes"),
 				),
 			},
+			// Test attribute clearing
+			{
+				Config: testAccTopicSubscriptionConfig_filterPolicy(rName, strconv.Quote("")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionExists(ctx, resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "filter_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter_policy_scope", ""),
+				),
+			},
+			{
+				Config: testAccTopicSubscriptionConfig_filterPolicy(rName, strconv.Quote(filterPolicy1)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionExists(ctx, resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "filter_policy", filterPolicy1),
+				),
+			},
 			// Test attribute removal
 			{
 				Config: testAccTopicSubscriptionConfig_basic(rName),
@@ -342,6 +358,43 @@ func TestAccSNSTopicSubscription_filterPolicyScope(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopicSubscription_FilterPolicyScope_policyChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributes map[string]string
+	resourceName := "aws_sns_topic_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionConfig_nestedFilterPolicyScope(rName, strconv.Quote("MessageBody"), false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionExists(ctx, resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "filter_policy_scope", "MessageBody"),
+				),
+			},
+			{
+				Config: testAccTopicSubscriptionConfig_filterPolicy(rName, strconv.Quote("")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionExists(ctx, resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "filter_policy", ""),
+				),
+			},
+			{
+				Config: testAccTopicSubscriptionConfig_nestedFilterPolicyScope(rName, strconv.Quote("MessageBody"), true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionExists(ctx, resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "filter_policy_scope", "MessageBody"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopicSubscription_filterPolicyScope_policyNotSet(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -479,6 +532,24 @@ func TestAccSNSTopicSubscription_redrivePolicy(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopicSubscription_RedrivePolicy_expectNonSQSError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicSubscriptionConfig_redrivePolicyNonSQS(rName),
+				ExpectError: regexache.MustCompile(`must be an Amazon SQS queue ARN`),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopicSubscription_rawMessageDelivery(t *testing.T) {
 	ctx := acctest.Context(t)
 	var attributes map[string]string
@@ -650,6 +721,24 @@ func TestAccSNSTopicSubscription_firehose(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopicSubscription_Firehose_expectSubscriptionRoleError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicSubscriptionConfig_firehoseNoSubscriptionRole(rName),
+				ExpectError: regexache.MustCompile(`subscription_role_arn is required when protocol is firehose`),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopicSubscription_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var attributes map[string]string
@@ -940,6 +1029,53 @@ resource "aws_sns_topic_subscription" "test" {
 `, rName, dlqName)
 }
 
+func testAccTopicSubscriptionConfig_redrivePolicyNonSQS(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  sqs_managed_sse_enabled = true
+}
+
+resource "aws_sns_topic_subscription" "test" {
+  redrive_policy = jsonencode({ deadLetterTargetArn : "arn:${data.aws_partition.current.partition}:sns:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s-dlq" })
+  endpoint       = aws_sqs_queue.test.arn
+  protocol       = "sqs"
+  topic_arn      = aws_sns_topic.test.arn
+}
+`, rName)
+}
+
+func testAccTopicSubscriptionConfig_firehoseNoSubscriptionRole(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_subscription" "test" {
+  endpoint  = "arn:${data.aws_partition.current.partition}:firehose:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:deliverystream/%[1]s"
+  protocol  = "firehose"
+  topic_arn = aws_sns_topic.test.arn
+}
+`, rName)
+}
+
 func testAccTopicSubscriptionConfig_rawMessageDelivery(rName string, rawMessageDelivery bool) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
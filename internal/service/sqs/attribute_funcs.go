@@ -26,15 +26,29 @@ type queueAttributeHandler struct {
 	AttributeName types.QueueAttributeName
 	SchemaKey     string
 	ToSet         func(string, string) (string, error)
+
+	// Optional structured alternative to the JSON attribute.
+	ConfigSchemaKey string
+	ExpandConfig    func([]interface{}) (string, error)
+	FlattenConfig   func(string) ([]interface{}, error)
 }
 
 func (h *queueAttributeHandler) Upsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SQSClient(ctx)
 
-	attrValue, err := structure.NormalizeJsonString(d.Get(h.SchemaKey).(string))
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "%s (%s) is invalid JSON: %s", h.SchemaKey, d.Get(h.SchemaKey).(string), err)
+	var attrValue string
+	var err error
+	if v := h.config(d); len(v) > 0 {
+		attrValue, err = h.ExpandConfig(v)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "expanding %s: %s", h.ConfigSchemaKey, err)
+		}
+	} else {
+		attrValue, err = structure.NormalizeJsonString(d.Get(h.SchemaKey).(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "%s (%s) is invalid JSON: %s", h.SchemaKey, d.Get(h.SchemaKey).(string), err)
+		}
 	}
 
 	attributes := map[types.QueueAttributeName]string{
@@ -96,6 +110,15 @@ func (h *queueAttributeHandler) Read(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.Set(h.SchemaKey, newValue)
+	if v := h.config(d); len(v) > 0 {
+		tfList, err := h.FlattenConfig(newValue)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		if err := d.Set(h.ConfigSchemaKey, tfList); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting %s: %s", h.ConfigSchemaKey, err)
+		}
+	}
 	d.Set("queue_url", d.Id())
 
 	return diags
@@ -128,3 +151,21 @@ func (h *queueAttributeHandler) Delete(ctx context.Context, d *schema.ResourceDa
 
 	return diags
 }
+
+func (h *queueAttributeHandler) CustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The JSON attribute is recomputed from the structured configuration.
+	if h.ConfigSchemaKey != "" && diff.HasChange(h.ConfigSchemaKey) && diff.GetRawConfig().GetAttr(h.SchemaKey).IsNull() {
+		return diff.SetNewComputed(h.SchemaKey)
+	}
+
+	return nil
+}
+
+// config returns any structured configuration that is in use.
+func (h *queueAttributeHandler) config(d *schema.ResourceData) []interface{} {
+	if h.ConfigSchemaKey == "" {
+		return nil
+	}
+
+	return d.Get(h.ConfigSchemaKey).([]interface{})
+}
@@ -5,10 +5,12 @@ package sqs
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -129,25 +131,41 @@ var (
 			Default:  defaultQueueReceiveMessageWaitTimeSeconds,
 		},
 		"redrive_allow_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validation.StringIsJSON,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ValidateFunc:  validation.StringIsJSON,
+			ConflictsWith: []string{"redrive_allow_policy_config"},
 			StateFunc: func(v interface{}) string {
 				json, _ := structure.NormalizeJsonString(v)
 				return json
 			},
 		},
+		"redrive_allow_policy_config": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			Elem:          redriveAllowPolicyConfigSchema(),
+			ConflictsWith: []string{"redrive_allow_policy"},
+		},
 		"redrive_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validation.StringIsJSON,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ValidateFunc:  validation.StringIsJSON,
+			ConflictsWith: []string{"redrive_policy_config"},
 			StateFunc: func(v interface{}) string {
-				json, _ := structure.NormalizeJsonString(v)
+				json, _ := normalizeRedrivePolicy(v.(string))
 				return json
 			},
 		},
+		"redrive_policy_config": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			Elem:          redrivePolicyConfigSchema(),
+			ConflictsWith: []string{"redrive_policy"},
+		},
 		"sqs_managed_sse_enabled": {
 			Type:          schema.TypeBool,
 			Optional:      true,
@@ -232,6 +250,10 @@ func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if err := expandQueueRedriveConfigs(d, attributes); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Attributes = flex.ExpandStringyValueMap(attributes)
 
 	// create is 2 phase: 1. create, 2. wait for propagation
@@ -305,6 +327,26 @@ func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	// The structured redrive configurations are only populated when in use.
+	if v := d.Get("redrive_allow_policy_config").([]interface{}); len(v) > 0 {
+		tfList, err := flattenRedriveAllowPolicyConfig(d.Get("redrive_allow_policy").(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		if err := d.Set("redrive_allow_policy_config", tfList); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting redrive_allow_policy_config: %s", err)
+		}
+	}
+	if v := d.Get("redrive_policy_config").([]interface{}); len(v) > 0 {
+		tfList, err := flattenRedrivePolicyConfig(d.Get("redrive_policy").(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		if err := d.Set("redrive_policy_config", tfList); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting redrive_policy_config: %s", err)
+		}
+	}
+
 	// Backwards compatibility: https://github.com/hashicorp/terraform-provider-aws/issues/19786.
 	if d.Get("kms_data_key_reuse_period_seconds").(int) == 0 {
 		d.Set("kms_data_key_reuse_period_seconds", defaultQueueKMSDataKeyReusePeriodSeconds)
@@ -331,6 +373,10 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
+		if err := expandQueueRedriveConfigs(d, attributes); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input := &sqs.SetQueueAttributesInput{
 			Attributes: flex.ExpandStringyValueMap(attributes),
 			QueueUrl:   aws.String(d.Id()),
@@ -413,6 +459,19 @@ func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO queue")
 	}
 
+	// The JSON attributes are recomputed from the structured configurations.
+	for _, k := range []string{"redrive_allow_policy", "redrive_policy"} {
+		if diff.HasChange(k+"_config") && diff.GetRawConfig().GetAttr(k).IsNull() {
+			if err := diff.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := validateRedrivePolicyDeadLetterTarget(diff, fifoQueue, "redrive_policy", "redrive_policy_config"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -562,10 +621,14 @@ func statusQueueAttributeState(ctx context.Context, conn *sqs.Client, url string
 					if !equivalent {
 						return queueAttributeStateNotEqual
 					}
-				case types.QueueAttributeNameRedriveAllowPolicy, types.QueueAttributeNameRedrivePolicy:
+				case types.QueueAttributeNameRedriveAllowPolicy:
 					if !verify.JSONStringsEqual(g, e) {
 						return queueAttributeStateNotEqual
 					}
+				case types.QueueAttributeNameRedrivePolicy:
+					if !redrivePoliciesAreEquivalent(g, e) {
+						return queueAttributeStateNotEqual
+					}
 				default:
 					if g != e {
 						return queueAttributeStateNotEqual
@@ -623,3 +686,234 @@ func waitQueueDeleted(ctx context.Context, conn *sqs.Client, url string, timeout
 
 	return err
 }
+
+func redriveAllowPolicyConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"redrive_permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(redrivePermission_Values(), false),
+			},
+			"source_queue_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func redrivePolicyConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"dead_letter_target_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"max_receive_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1_000),
+			},
+		},
+	}
+}
+
+// validateRedrivePolicyDeadLetterTarget checks that a queue and its dead-letter queue are of the same type.
+// The dead-letter queue of a FIFO queue must also be a FIFO queue and vice versa.
+func validateRedrivePolicyDeadLetterTarget(diff *schema.ResourceDiff, fifoQueue bool, jsonKey, configKey string) error {
+	var deadLetterTargetARN string
+
+	if v := diff.Get(configKey).([]interface{}); len(v) > 0 && v[0] != nil {
+		deadLetterTargetARN = v[0].(map[string]interface{})["dead_letter_target_arn"].(string)
+	} else if diff.NewValueKnown(jsonKey) {
+		var policy redrivePolicy
+		if err := json.Unmarshal([]byte(diff.Get(jsonKey).(string)), &policy); err == nil {
+			deadLetterTargetARN = policy.DeadLetterTargetARN
+		}
+	}
+
+	// Not yet known.
+	if deadLetterTargetARN == "" {
+		return nil
+	}
+
+	if fifoDeadLetterQueue := strings.HasSuffix(deadLetterTargetARN, fifoQueueNameSuffix); fifoQueue && !fifoDeadLetterQueue {
+		return fmt.Errorf("dead-letter queue (%s) of a FIFO queue must also be a FIFO queue", deadLetterTargetARN)
+	} else if !fifoQueue && fifoDeadLetterQueue {
+		return fmt.Errorf("dead-letter queue (%s) of a standard queue must also be a standard queue", deadLetterTargetARN)
+	}
+
+	return nil
+}
+
+// redrivePolicy is the JSON representation of a queue's RedrivePolicy attribute.
+type redrivePolicy struct {
+	DeadLetterTargetARN string      `json:"deadLetterTargetArn,omitempty"`
+	MaxReceiveCount     json.Number `json:"maxReceiveCount,omitempty"`
+}
+
+// redriveAllowPolicy is the JSON representation of a queue's RedriveAllowPolicy attribute.
+type redriveAllowPolicy struct {
+	RedrivePermission string   `json:"redrivePermission,omitempty"`
+	SourceQueueARNs   []string `json:"sourceQueueArns,omitempty"`
+}
+
+// normalizeRedrivePolicy returns the normalized JSON of a redrive policy.
+// The API accepts maxReceiveCount as either a number or a string but always returns a number.
+func normalizeRedrivePolicy(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+
+	var policy map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	if err := decoder.Decode(&policy); err != nil {
+		return "", err
+	}
+
+	if v, ok := policy["maxReceiveCount"].(string); ok {
+		if _, err := strconv.Atoi(v); err == nil {
+			policy["maxReceiveCount"] = json.Number(v)
+		}
+	}
+
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func redrivePoliciesAreEquivalent(s1, s2 string) bool {
+	v1, err := normalizeRedrivePolicy(s1)
+	if err != nil {
+		return false
+	}
+
+	v2, err := normalizeRedrivePolicy(s2)
+	if err != nil {
+		return false
+	}
+
+	return verify.JSONStringsEqual(v1, v2)
+}
+
+// expandQueueRedriveConfigs sets any changed structured redrive configurations in the specified API attributes.
+func expandQueueRedriveConfigs(d *schema.ResourceData, attributes map[types.QueueAttributeName]string) error {
+	// A removed structured configuration is replaced by any configured JSON value.
+	if d.HasChange("redrive_allow_policy_config") {
+		if v := d.Get("redrive_allow_policy_config").([]interface{}); len(v) > 0 || d.GetRawConfig().GetAttr("redrive_allow_policy").IsNull() {
+			policy, err := expandRedriveAllowPolicyConfig(v)
+			if err != nil {
+				return err
+			}
+
+			attributes[types.QueueAttributeNameRedriveAllowPolicy] = policy
+		}
+	}
+
+	if d.HasChange("redrive_policy_config") {
+		if v := d.Get("redrive_policy_config").([]interface{}); len(v) > 0 || d.GetRawConfig().GetAttr("redrive_policy").IsNull() {
+			policy, err := expandRedrivePolicyConfig(v)
+			if err != nil {
+				return err
+			}
+
+			attributes[types.QueueAttributeNameRedrivePolicy] = policy
+		}
+	}
+
+	return nil
+}
+
+func expandRedriveAllowPolicyConfig(tfList []interface{}) (string, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return "", nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	policy := redriveAllowPolicy{
+		RedrivePermission: tfMap["redrive_permission"].(string),
+	}
+
+	if v, ok := tfMap["source_queue_arns"].(*schema.Set); ok && v.Len() > 0 {
+		policy.SourceQueueARNs = flex.ExpandStringValueSet(v)
+		slices.Sort(policy.SourceQueueARNs)
+	}
+
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func expandRedrivePolicyConfig(tfList []interface{}) (string, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return "", nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	policy := redrivePolicy{
+		DeadLetterTargetARN: tfMap["dead_letter_target_arn"].(string),
+		MaxReceiveCount:     json.Number(strconv.Itoa(tfMap["max_receive_count"].(int))),
+	}
+
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func flattenRedriveAllowPolicyConfig(s string) ([]interface{}, error) {
+	if s == "" {
+		return []interface{}{}, nil
+	}
+
+	var policy redriveAllowPolicy
+	if err := json.Unmarshal([]byte(s), &policy); err != nil {
+		return nil, fmt.Errorf("parsing redrive allow policy (%s): %w", s, err)
+	}
+
+	tfMap := map[string]interface{}{
+		"redrive_permission": policy.RedrivePermission,
+		"source_queue_arns":  policy.SourceQueueARNs,
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
+func flattenRedrivePolicyConfig(s string) ([]interface{}, error) {
+	if s == "" {
+		return []interface{}{}, nil
+	}
+
+	var policy redrivePolicy
+	if err := json.Unmarshal([]byte(s), &policy); err != nil {
+		return nil, fmt.Errorf("parsing redrive policy (%s): %w", s, err)
+	}
+
+	maxReceiveCount, err := policy.MaxReceiveCount.Int64()
+	if err != nil {
+		return nil, fmt.Errorf("parsing redrive policy (%s) maxReceiveCount: %w", s, err)
+	}
+
+	tfMap := map[string]interface{}{
+		"dead_letter_target_arn": policy.DeadLetterTargetARN,
+		"max_receive_count":      int(maxReceiveCount),
+	}
+
+	return []interface{}{tfMap}, nil
+}
@@ -22,6 +22,9 @@ func resourceQueueRedriveAllowPolicy() *schema.Resource {
 			}
 			return new, nil
 		},
+		ConfigSchemaKey: "redrive_allow_policy_config",
+		ExpandConfig:    expandRedriveAllowPolicyConfig,
+		FlattenConfig:   flattenRedriveAllowPolicyConfig,
 	}
 
 	return &schema.Resource{
@@ -33,16 +36,27 @@ func resourceQueueRedriveAllowPolicy() *schema.Resource {
 			},
 			"redrive_allow_policy": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				ExactlyOneOf:     []string{"redrive_allow_policy", "redrive_allow_policy_config"},
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
 				},
 			},
+			"redrive_allow_policy_config": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Elem:         redriveAllowPolicyConfigSchema(),
+				ExactlyOneOf: []string{"redrive_allow_policy", "redrive_allow_policy_config"},
+			},
 		},
 
+		CustomizeDiff: h.CustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -152,6 +152,39 @@ func TestAccSQSQueueRedriveAllowPolicy_byQueue(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueueRedriveAllowPolicy_config(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[types.QueueAttributeName]string
+	resourceName := "aws_sqs_queue_redrive_allow_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveAllowPolicyConfig_config(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, queueResourceName, &queueAttributes),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_allow_policy"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_config.0.redrive_permission", "byQueue"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "redrive_allow_policy_config.0.source_queue_arns.*", "aws_sqs_queue.test_src", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"redrive_allow_policy_config"},
+			},
+		},
+	})
+}
+
 func testAccQueueRedriveAllowPolicyConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -218,3 +251,29 @@ resource "aws_sqs_queue_redrive_allow_policy" "test" {
   redrive_allow_policy = "{\"redrivePermission\": \"byQueue\", \"sourceQueueArns\": [\"${aws_sqs_queue.test_src.arn}\"]}"
 }`, rName)
 }
+
+func testAccQueueRedriveAllowPolicyConfig_config(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test_src" {
+  name = "%[1]s_src"
+
+  redrive_policy_config {
+    dead_letter_target_arn = aws_sqs_queue.test.arn
+    max_receive_count      = 4
+  }
+}
+
+resource "aws_sqs_queue_redrive_allow_policy" "test" {
+  queue_url = aws_sqs_queue.test.id
+
+  redrive_allow_policy_config {
+    redrive_permission = "byQueue"
+    source_queue_arns  = [aws_sqs_queue.test_src.arn]
+  }
+}
+`, rName)
+}
@@ -4,9 +4,12 @@
 package sqs
 
 import (
+	"context"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -22,6 +25,9 @@ func resourceQueueRedrivePolicy() *schema.Resource {
 			}
 			return new, nil
 		},
+		ConfigSchemaKey: "redrive_policy_config",
+		ExpandConfig:    expandRedrivePolicyConfig,
+		FlattenConfig:   flattenRedrivePolicyConfig,
 	}
 
 	return &schema.Resource{
@@ -33,15 +39,29 @@ func resourceQueueRedrivePolicy() *schema.Resource {
 			},
 			"redrive_policy": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsJSON,
+				ExactlyOneOf: []string{"redrive_policy", "redrive_policy_config"},
 				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
+					json, _ := normalizeRedrivePolicy(v.(string))
 					return json
 				},
 			},
+			"redrive_policy_config": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Elem:         redrivePolicyConfigSchema(),
+				ExactlyOneOf: []string{"redrive_policy", "redrive_policy_config"},
+			},
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			h.CustomizeDiff,
+			resourceQueueRedrivePolicyCustomizeDiff,
+		),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -52,3 +72,13 @@ func resourceQueueRedrivePolicy() *schema.Resource {
 		DeleteWithoutTimeout: h.Delete,
 	}
 }
+
+func resourceQueueRedrivePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("queue_url") {
+		return nil
+	}
+
+	fifoQueue := strings.HasSuffix(diff.Get("queue_url").(string), fifoQueueNameSuffix)
+
+	return validateRedrivePolicyDeadLetterTarget(diff, fifoQueue, "redrive_policy", "redrive_policy_config")
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -137,6 +138,63 @@ func TestAccSQSQueueRedrivePolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueueRedrivePolicy_config(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[types.QueueAttributeName]string
+	resourceName := "aws_sqs_queue_redrive_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedrivePolicyConfig_config(rName, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, queueResourceName, &queueAttributes),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_policy"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "redrive_policy_config.0.dead_letter_target_arn", "aws_sqs_queue.test_ddl", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy_config.0.max_receive_count", "4"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"redrive_policy_config"},
+			},
+			{
+				Config: testAccQueueRedrivePolicyConfig_config(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy_config.0.max_receive_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueueRedrivePolicy_expectDeadLetterQueueTypeError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueRedrivePolicyConfig_fifoDeadLetterQueue(rName),
+				ExpectError: regexache.MustCompile(`of a standard queue must also be a standard queue`),
+			},
+		},
+	})
+}
+
 func testAccQueueRedrivePolicyConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -184,3 +242,48 @@ resource "aws_sqs_queue_redrive_policy" "test" {
 }
 `, rName)
 }
+
+func testAccQueueRedrivePolicyConfig_config(rName string, maxReceiveCount int) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test_ddl" {
+  name = "%[1]s_ddl"
+
+  redrive_allow_policy_config {
+    redrive_permission = "byQueue"
+    source_queue_arns  = [aws_sqs_queue.test.arn]
+  }
+}
+
+resource "aws_sqs_queue_redrive_policy" "test" {
+  queue_url = aws_sqs_queue.test.id
+
+  redrive_policy_config {
+    dead_letter_target_arn = aws_sqs_queue.test_ddl.arn
+    max_receive_count      = %[2]d
+  }
+}
+`, rName, maxReceiveCount)
+}
+
+func testAccQueueRedrivePolicyConfig_fifoDeadLetterQueue(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_sqs_queue_redrive_policy" "test" {
+  queue_url = "https://sqs.${data.aws_region.current.name}.amazonaws.com/${data.aws_caller_identity.current.account_id}/%[1]s"
+
+  redrive_policy_config {
+    dead_letter_target_arn = "arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s.fifo"
+    max_receive_count      = 3
+  }
+}
+`, rName)
+}
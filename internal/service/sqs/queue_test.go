@@ -539,6 +539,103 @@ func TestAccSQSQueue_redriveAllowPolicy(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_redrivePolicyConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[types.QueueAttributeName]string
+	resourceName := "aws_sqs_queue.test"
+	dlqResourceName := "aws_sqs_queue.dlq"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_redrivePolicyConfig(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_policy"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "redrive_policy_config.0.dead_letter_target_arn", dlqResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy_config.0.max_receive_count", "3"),
+					resource.TestCheckResourceAttr(dlqResourceName, "redrive_allow_policy_config.#", "1"),
+					resource.TestCheckResourceAttr(dlqResourceName, "redrive_allow_policy_config.0.redrive_permission", "byQueue"),
+					resource.TestCheckResourceAttr(dlqResourceName, "redrive_allow_policy_config.0.source_queue_arns.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"redrive_policy_config"},
+			},
+			{
+				Config: testAccQueueConfig_redrivePolicyConfig(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy_config.0.max_receive_count", "5"),
+				),
+			},
+			{
+				Config: testAccQueueConfig_redrivePolicyConfigRemoved(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "redrive_policy_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_RedrivePolicy_maxReceiveCountString(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[types.QueueAttributeName]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_redrivePolicyMaxReceiveCountString(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_policy"),
+				),
+			},
+			{
+				Config:   testAccQueueConfig_redrivePolicyMaxReceiveCountString(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_FIFOQueue_expectDeadLetterQueueTypeError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueConfig_fifoRedrivePolicyConfigStandardDLQ(rName),
+				ExpectError: regexache.MustCompile(`of a FIFO queue must also be a FIFO queue`),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_fifoQueue(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[types.QueueAttributeName]string
@@ -1187,6 +1284,83 @@ resource "aws_sqs_queue" "dlq" {
 `, rName)
 }
 
+func testAccQueueConfig_redrivePolicyConfig(rName string, maxReceiveCount int) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = "%[1]s-1"
+
+  redrive_policy_config {
+    dead_letter_target_arn = aws_sqs_queue.dlq.arn
+    max_receive_count      = %[2]d
+  }
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-2"
+
+  redrive_allow_policy_config {
+    redrive_permission = "byQueue"
+    source_queue_arns  = ["arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s-1"]
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName, maxReceiveCount)
+}
+
+func testAccQueueConfig_redrivePolicyConfigRemoved(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = "%[1]s-1"
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-2"
+}
+`, rName)
+}
+
+func testAccQueueConfig_redrivePolicyMaxReceiveCountString(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = "%[1]s-1"
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = "3"
+  })
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-2"
+}
+`, rName)
+}
+
+func testAccQueueConfig_fifoRedrivePolicyConfigStandardDLQ(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name       = "%[1]s.fifo"
+  fifo_queue = true
+
+  redrive_policy_config {
+    dead_letter_target_arn = "arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s-dlq"
+    max_receive_count      = 3
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName)
+}
+
 func testAccQueueConfig_fifo(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -94,17 +94,31 @@ func resourceDefaultPatchBaselineCreate(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "Patch Baseline Operating System (%s) does not match %s", pbOS, cOS)
 	}
 
-	input := &ssm.RegisterDefaultPatchBaselineInput{
-		BaselineId: aws.String(baselineID),
+	// Default patch baselines are keyed by operating system, so only (re-)register when the
+	// operating system's current default differs. This allows instances of this resource for
+	// different operating systems to coexist in the same configuration.
+	os := patchBaseline.OperatingSystem
+	current, err := findDefaultPatchBaselineByOperatingSystem(ctx, conn, os)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading SSM Default Patch Baseline (%s): %s", os, err)
 	}
 
-	_, err = conn.RegisterDefaultPatchBaseline(ctx, input)
+	if current == nil || aws.ToString(current.BaselineId) != aws.ToString(patchBaseline.BaselineId) {
+		input := &ssm.RegisterDefaultPatchBaselineInput{
+			BaselineId: aws.String(baselineID),
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "registering SSM Default Patch Baseline (%s): %s", baselineID, err)
+		_, err = conn.RegisterDefaultPatchBaseline(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "registering SSM Default Patch Baseline (%s): %s", baselineID, err)
+		}
 	}
 
-	d.SetId(string(patchBaseline.OperatingSystem))
+	d.SetId(string(os))
 
 	return append(diags, resourceDefaultPatchBaselineRead(ctx, d, meta)...)
 }
@@ -132,7 +146,28 @@ func resourceDefaultPatchBaselineRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceDefaultPatchBaselineDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	return defaultPatchBaselineRestoreOSDefault(ctx, meta.(*conns.AWSClient).SSMClient(ctx), awstypes.OperatingSystem(d.Id()))
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	os := awstypes.OperatingSystem(d.Id())
+	output, err := findDefaultPatchBaselineByOperatingSystem(ctx, conn, os)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Default Patch Baseline (%s): %s", d.Id(), err)
+	}
+
+	// Only restore the AWS-provided default if this operating system's default has not since been
+	// registered to a different patch baseline.
+	if !diffSuppressPatchBaselineID("", aws.ToString(output.BaselineId), d.Get("baseline_id").(string), d) {
+		log.Printf("[WARN] SSM Default Patch Baseline (%s) is registered to %s, not restoring AWS-provided default", d.Id(), aws.ToString(output.BaselineId))
+		return diags
+	}
+
+	return defaultPatchBaselineRestoreOSDefault(ctx, conn, os)
 }
 
 func defaultPatchBaselineRestoreOSDefault(ctx context.Context, conn *ssm.Client, os awstypes.OperatingSystem) diag.Diagnostics {
@@ -173,6 +173,37 @@ func testAccSSMDefaultPatchBaseline_otherOperatingSystem(t *testing.T) {
 	})
 }
 
+func testAccSSMDefaultPatchBaseline_multipleOperatingSystems(t *testing.T) {
+	ctx := acctest.Context(t)
+	var defaultpatchbaseline1, defaultpatchbaseline2 ssm.GetDefaultPatchBaselineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_ssm_default_patch_baseline.test1"
+	resourceName2 := "aws_ssm_default_patch_baseline.test2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultPatchBaselineConfig_multipleOperatingSystems(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultPatchBaselineExists(ctx, resourceName1, &defaultpatchbaseline1),
+					testAccCheckDefaultPatchBaselineExists(ctx, resourceName2, &defaultpatchbaseline2),
+					resource.TestCheckResourceAttrPair(resourceName1, "baseline_id", "aws_ssm_patch_baseline.test1", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName1, names.AttrID, string(awstypes.OperatingSystemAmazonLinux2)),
+					resource.TestCheckResourceAttrPair(resourceName2, "baseline_id", "aws_ssm_patch_baseline.test2", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName2, names.AttrID, string(awstypes.OperatingSystemRedhatEnterpriseLinux)),
+				),
+			},
+		},
+	})
+}
+
 func testAccSSMDefaultPatchBaseline_wrongOperatingSystem(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -423,6 +454,36 @@ resource "aws_ssm_patch_baseline" "test" {
 `, rName, os)
 }
 
+func testAccDefaultPatchBaselineConfig_multipleOperatingSystems(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_default_patch_baseline" "test1" {
+  baseline_id      = aws_ssm_patch_baseline.test1.id
+  operating_system = aws_ssm_patch_baseline.test1.operating_system
+}
+
+resource "aws_ssm_patch_baseline" "test1" {
+  name             = "%[1]s-1"
+  operating_system = "AMAZON_LINUX_2"
+
+  approved_patches                  = ["KB123456"]
+  approved_patches_compliance_level = "CRITICAL"
+}
+
+resource "aws_ssm_default_patch_baseline" "test2" {
+  baseline_id      = aws_ssm_patch_baseline.test2.id
+  operating_system = aws_ssm_patch_baseline.test2.operating_system
+}
+
+resource "aws_ssm_patch_baseline" "test2" {
+  name             = "%[1]s-2"
+  operating_system = "REDHAT_ENTERPRISE_LINUX"
+
+  approved_patches                  = ["KB123456"]
+  approved_patches_compliance_level = "CRITICAL"
+}
+`, rName)
+}
+
 func testAccDefaultPatchBaselineConfig_wrongOperatingSystem(rName string, baselineOS, defaultOS awstypes.OperatingSystem) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_default_patch_baseline" "test" {
@@ -15,6 +15,7 @@ var (
 	ResourceParameter               = resourceParameter
 	ResourcePatchBaseline           = resourcePatchBaseline
 	ResourcePatchGroup              = resourcePatchGroup
+	ResourcePatchGroups             = resourcePatchGroups
 	ResourceResourceDataSync        = resourceResourceDataSync
 	ResourceServiceSetting          = resourceServiceSetting
 
@@ -29,6 +30,7 @@ var (
 	FindParameterByName                                = findParameterByName
 	FindPatchBaselineByID                              = findPatchBaselineByID
 	FindPatchGroupByTwoPartKey                         = findPatchGroupByTwoPartKey
+	FindPatchGroupsByBaselineID                        = findPatchGroupsByBaselineID
 	FindResourceDataSyncByName                         = findResourceDataSyncByName
 	FindServiceSettingByID                             = findServiceSettingByID
 )
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ssm_patch_groups", name="Patch Groups")
+func resourcePatchGroups() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePatchGroupsCreate,
+		ReadWithoutTimeout:   resourcePatchGroupsRead,
+		UpdateWithoutTimeout: resourcePatchGroupsUpdate,
+		DeleteWithoutTimeout: resourcePatchGroupsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"baseline_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePatchBaselineID,
+			},
+			"patch_groups": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+		},
+	}
+}
+
+func resourcePatchGroupsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	baselineID := d.Get("baseline_id").(string)
+
+	for _, patchGroup := range flex.ExpandStringValueSet(d.Get("patch_groups").(*schema.Set)) {
+		if err := registerPatchBaselineForPatchGroup(ctx, conn, baselineID, patchGroup); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating SSM Patch Groups (%s): %s", baselineID, err)
+		}
+	}
+
+	d.SetId(baselineID)
+
+	return append(diags, resourcePatchGroupsRead(ctx, d, meta)...)
+}
+
+func resourcePatchGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	// Only the patch groups managed by this resource are read so that patch groups registered with the
+	// same baseline by other means, e.g. the aws_ssm_patch_group resource, are left alone.
+	// On import all of the baseline's patch groups are read.
+	patchGroups, err := findPatchGroupsByBaselineID(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("patch_groups").(*schema.Set))...)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Patch Groups %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Patch Groups (%s): %s", d.Id(), err)
+	}
+
+	d.Set("baseline_id", d.Id())
+	d.Set("patch_groups", patchGroups)
+
+	return diags
+}
+
+func resourcePatchGroupsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	if d.HasChange("patch_groups") {
+		o, n := d.GetChange("patch_groups")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		for _, patchGroup := range del {
+			if err := deregisterPatchBaselineForPatchGroup(ctx, conn, d.Id(), patchGroup); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating SSM Patch Groups (%s): %s", d.Id(), err)
+			}
+		}
+
+		for _, patchGroup := range add {
+			if err := registerPatchBaselineForPatchGroup(ctx, conn, d.Id(), patchGroup); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating SSM Patch Groups (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourcePatchGroupsRead(ctx, d, meta)...)
+}
+
+func resourcePatchGroupsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	log.Printf("[WARN] Deleting SSM Patch Groups: %s", d.Id())
+	for _, patchGroup := range flex.ExpandStringValueSet(d.Get("patch_groups").(*schema.Set)) {
+		if err := deregisterPatchBaselineForPatchGroup(ctx, conn, d.Id(), patchGroup); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting SSM Patch Groups (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+func registerPatchBaselineForPatchGroup(ctx context.Context, conn *ssm.Client, baselineID, patchGroup string) error {
+	input := &ssm.RegisterPatchBaselineForPatchGroupInput{
+		BaselineId: aws.String(baselineID),
+		PatchGroup: aws.String(patchGroup),
+	}
+
+	_, err := conn.RegisterPatchBaselineForPatchGroup(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("registering patch group (%s): %w", patchGroup, err)
+	}
+
+	return nil
+}
+
+func deregisterPatchBaselineForPatchGroup(ctx context.Context, conn *ssm.Client, baselineID, patchGroup string) error {
+	input := &ssm.DeregisterPatchBaselineForPatchGroupInput{
+		BaselineId: aws.String(baselineID),
+		PatchGroup: aws.String(patchGroup),
+	}
+
+	_, err := conn.DeregisterPatchBaselineForPatchGroup(ctx, input)
+
+	if errs.IsA[*awstypes.DoesNotExistException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deregistering patch group (%s): %w", patchGroup, err)
+	}
+
+	return nil
+}
+
+// findPatchGroupsByBaselineID returns the patch groups registered with the specified baseline,
+// optionally restricted to the specified patch groups.
+func findPatchGroupsByBaselineID(ctx context.Context, conn *ssm.Client, baselineID string, patchGroups ...string) ([]string, error) {
+	input := &ssm.DescribePatchGroupsInput{}
+	output, err := findPatchGroups(ctx, conn, input, func(v *awstypes.PatchGroupPatchBaselineMapping) bool {
+		if v.BaselineIdentity == nil || aws.ToString(v.BaselineIdentity.BaselineId) != baselineID {
+			return false
+		}
+
+		return len(patchGroups) == 0 || slices.Contains(patchGroups, aws.ToString(v.PatchGroup))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.PatchGroupPatchBaselineMapping) string {
+		return aws.ToString(v.PatchGroup)
+	}), nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMPatchGroups_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_patch_groups.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchGroupsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchGroupsConfig_basic(rName, "a", "b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchGroupsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "baseline_id", "aws_ssm_patch_baseline.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "patch_groups.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "patch_groups.*", rName+"-a"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "patch_groups.*", rName+"-b"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPatchGroupsConfig_basic(rName, "b", "c", "d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchGroupsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "patch_groups.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "patch_groups.*", rName+"-b"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "patch_groups.*", rName+"-c"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "patch_groups.*", rName+"-d"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMPatchGroups_patchGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_patch_groups.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchGroupsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchGroupsConfig_patchGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchGroupsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "patch_groups.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "patch_groups.*", rName+"-a"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "patch_groups.*", rName+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMPatchGroups_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_patch_groups.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchGroupsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchGroupsConfig_basic(rName, "a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchGroupsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourcePatchGroups(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPatchGroupsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_patch_groups" {
+				continue
+			}
+
+			_, err := tfssm.FindPatchGroupsByBaselineID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM Patch Groups %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPatchGroupsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := tfssm.FindPatchGroupsByBaselineID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPatchGroupsConfig_basic(rName string, suffixes ...string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  approved_patches = ["KB123456"]
+}
+
+resource "aws_ssm_patch_groups" "test" {
+  baseline_id  = aws_ssm_patch_baseline.test.id
+  patch_groups = [for s in ["%[2]s"] : "%[1]s-${s}"]
+}
+`, rName, strings.Join(suffixes, `", "`))
+}
+
+func testAccPatchGroupsConfig_patchGroup(rName string) string {
+	return acctest.ConfigCompose(testAccPatchGroupsConfig_basic(rName, "a", "b"), fmt.Sprintf(`
+resource "aws_ssm_patch_group" "test" {
+  baseline_id = aws_ssm_patch_baseline.test.id
+  patch_group = "%[1]s-c"
+}
+`, rName))
+}
@@ -146,6 +146,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ssm_patch_group",
 			Name:     "Patch Group",
 		},
+		{
+			Factory:  resourcePatchGroups,
+			TypeName: "aws_ssm_patch_groups",
+			Name:     "Patch Groups",
+		},
 		{
 			Factory:  resourceResourceDataSync,
 			TypeName: "aws_ssm_resource_data_sync",
@@ -16,6 +16,24 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// listTagsForResource lists ssm service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func listTagsForResource(ctx context.Context, conn *ssm.Client, identifier, resourceType string, optFns ...func(*ssm.Options)) (tftags.KeyValueTags, error) {
+	input := ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String(identifier),
+		ResourceType: awstypes.ResourceTypeForTagging(resourceType),
+	}
+
+	output, err := conn.ListTagsForResource(ctx, &input, optFns...)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return KeyValueTags(ctx, output.TagList), nil
+}
+
 // []*SERVICE.Tag handling
 
 // Tags returns ssm service tags.
@@ -10,5 +10,6 @@ var (
 )
 
 var (
-	FindRotationByID = findRotationByID
+	AlignStageTargets = alignStageTargets
+	FindRotationByID  = findRotationByID
 )
@@ -4,6 +4,8 @@
 package ssmcontacts
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -77,6 +79,67 @@ func flattenStages(stages []types.Stage) []interface{} {
 	return result
 }
 
+// alignStageTargets reorders each stage's targets to match the order in prior
+// when both contain the same targets. GetContact does not preserve the order
+// in which a stage's targets were configured, which otherwise surfaces as a
+// perpetual diff on the stage list.
+func alignStageTargets(stages, prior []types.Stage) []types.Stage {
+	for i := range stages {
+		if i >= len(prior) {
+			break
+		}
+
+		if v, ok := alignTargets(stages[i].Targets, prior[i].Targets); ok {
+			stages[i].Targets = v
+		}
+	}
+
+	return stages
+}
+
+func alignTargets(targets, prior []types.Target) ([]types.Target, bool) {
+	if len(targets) != len(prior) {
+		return nil, false
+	}
+
+	remaining := make(map[string][]types.Target, len(targets))
+	for _, target := range targets {
+		k := targetKey(target)
+		remaining[k] = append(remaining[k], target)
+	}
+
+	result := make([]types.Target, 0, len(prior))
+	for _, target := range prior {
+		k := targetKey(target)
+		v := remaining[k]
+		if len(v) == 0 {
+			return nil, false
+		}
+		result = append(result, v[0])
+		remaining[k] = v[1:]
+	}
+
+	return result, true
+}
+
+func targetKey(target types.Target) string {
+	var channelID, contactID string
+	var retryInterval int32
+	var isEssential bool
+
+	if v := target.ChannelTargetInfo; v != nil {
+		channelID = aws.ToString(v.ContactChannelId)
+		retryInterval = aws.ToInt32(v.RetryIntervalInMinutes)
+	}
+
+	if v := target.ContactTargetInfo; v != nil {
+		contactID = aws.ToString(v.ContactId)
+		isEssential = aws.ToBool(v.IsEssential)
+	}
+
+	return fmt.Sprintf("%s|%d|%s|%t", channelID, retryInterval, contactID, isEssential)
+}
+
 func expandTargets(targets []interface{}) []types.Target {
 	targetList := make([]types.Target, 0)
 
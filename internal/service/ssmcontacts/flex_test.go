@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmcontacts_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+)
+
+func TestAlignStageTargets(t *testing.T) {
+	t.Parallel()
+
+	contact := func(id string) types.Target {
+		return types.Target{ContactTargetInfo: &types.ContactTargetInfo{ContactId: aws.String(id), IsEssential: aws.Bool(false)}}
+	}
+	channel := func(id string) types.Target {
+		return types.Target{ChannelTargetInfo: &types.ChannelTargetInfo{ContactChannelId: aws.String(id), RetryIntervalInMinutes: aws.Int32(5)}}
+	}
+	ids := func(targets []types.Target) []string {
+		var result []string
+		for _, t := range targets {
+			if v := t.ContactTargetInfo; v != nil {
+				result = append(result, aws.ToString(v.ContactId))
+			}
+			if v := t.ChannelTargetInfo; v != nil {
+				result = append(result, aws.ToString(v.ContactChannelId))
+			}
+		}
+		return result
+	}
+
+	testCases := map[string]struct {
+		stages   []types.Stage
+		prior    []types.Stage
+		expected [][]string
+	}{
+		"no prior": {
+			stages:   []types.Stage{{Targets: []types.Target{contact("b"), contact("a")}}},
+			expected: [][]string{{"b", "a"}},
+		},
+		"reordered": {
+			stages: []types.Stage{
+				{Targets: []types.Target{contact("c"), channel("x"), contact("a")}},
+				{Targets: []types.Target{contact("b"), contact("a")}},
+			},
+			prior: []types.Stage{
+				{Targets: []types.Target{contact("a"), contact("c"), channel("x")}},
+				{Targets: []types.Target{contact("a"), contact("b")}},
+			},
+			expected: [][]string{{"a", "c", "x"}, {"a", "b"}},
+		},
+		"changed targets": {
+			stages:   []types.Stage{{Targets: []types.Target{contact("c"), contact("a")}}},
+			prior:    []types.Stage{{Targets: []types.Target{contact("a"), contact("b")}}},
+			expected: [][]string{{"c", "a"}},
+		},
+		"new stage": {
+			stages: []types.Stage{
+				{Targets: []types.Target{contact("b"), contact("a")}},
+				{Targets: []types.Target{contact("d"), contact("c")}},
+			},
+			prior:    []types.Stage{{Targets: []types.Target{contact("a"), contact("b")}}},
+			expected: [][]string{{"a", "b"}, {"d", "c"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stages := tfssmcontacts.AlignStageTargets(testCase.stages, testCase.prior)
+
+			if got, want := len(stages), len(testCase.expected); got != want {
+				t.Fatalf("got %d stages, want %d", got, want)
+			}
+
+			for i, stage := range stages {
+				got, want := ids(stage.Targets), testCase.expected[i]
+				if len(got) != len(want) {
+					t.Fatalf("stage %d: got %v, want %v", i, got, want)
+				}
+				for j := range got {
+					if got[j] != want[j] {
+						t.Errorf("stage %d: got %v, want %v", i, got, want)
+						break
+					}
+				}
+			}
+		})
+	}
+}
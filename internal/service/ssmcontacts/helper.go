@@ -35,7 +35,8 @@ func setContactChannelResourceData(d *schema.ResourceData, out *ssmcontacts.GetC
 
 func setPlanResourceData(d *schema.ResourceData, getContactOutput *ssmcontacts.GetContactOutput) error {
 	d.Set("contact_id", getContactOutput.ContactArn)
-	if err := d.Set(names.AttrStage, flattenStages(getContactOutput.Plan.Stages)); err != nil {
+	stages := alignStageTargets(getContactOutput.Plan.Stages, expandStages(d.Get(names.AttrStage).([]interface{})))
+	if err := d.Set(names.AttrStage, flattenStages(stages)); err != nil {
 		return fmt.Errorf("setting stage: %w", err)
 	}
 
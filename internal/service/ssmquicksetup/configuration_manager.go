@@ -328,6 +328,10 @@ func waitConfigurationManagerCreated(ctx context.Context, conn *ssmquicksetup.Cl
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
+		if st := deploymentStatusSummary(out); st != nil && st.Status == awstypes.StatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(st.StatusMessage)))
+		}
+
 		return out, err
 	}
 
@@ -344,6 +348,10 @@ func waitConfigurationManagerUpdated(ctx context.Context, conn *ssmquicksetup.Cl
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
+		if st := deploymentStatusSummary(out); st != nil && st.Status == awstypes.StatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(st.StatusMessage)))
+		}
+
 		return out, err
 	}
 
@@ -360,6 +368,10 @@ func waitConfigurationManagerDeleted(ctx context.Context, conn *ssmquicksetup.Cl
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
+		if st := deploymentStatusSummary(out); st != nil && st.Status == awstypes.StatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(st.StatusMessage)))
+		}
+
 		return out, err
 	}
 
@@ -376,19 +388,27 @@ func statusConfigurationManager(ctx context.Context, conn *ssmquicksetup.Client,
 		if err != nil {
 			return nil, "", err
 		}
-		// GetConfigurationManager returns an array of status summaries. The item
-		// with a "Deployment" type will contain the status of the configuration
-		// manager during create, update, and delete.
-		for _, st := range out.StatusSummaries {
-			if st.StatusType == awstypes.StatusTypeDeployment {
-				return out, string(st.Status), nil
-			}
+		if st := deploymentStatusSummary(out); st != nil {
+			return out, string(st.Status), nil
 		}
 
 		return out, "", nil
 	}
 }
 
+// deploymentStatusSummary returns the status summary with a "Deployment" type,
+// which contains the status of the configuration manager during create, update,
+// and delete.
+func deploymentStatusSummary(out *ssmquicksetup.GetConfigurationManagerOutput) *awstypes.StatusSummary {
+	for _, st := range out.StatusSummaries {
+		if st.StatusType == awstypes.StatusTypeDeployment {
+			return &st
+		}
+	}
+
+	return nil
+}
+
 func findConfigurationManagerByID(ctx context.Context, conn *ssmquicksetup.Client, id string) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
 	in := &ssmquicksetup.GetConfigurationManagerInput{
 		ManagerArn: aws.String(id),
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -1423,6 +1424,47 @@ func testAccServer_workflowDetails(t *testing.T) {
 	})
 }
 
+func testAccServer_workflowDetailsReplaced(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.DescribedServer
+	var workflow1, workflow2 awstypes.DescribedWorkflow
+	resourceName := "aws_transfer_server.test"
+	workflowResourceName := "aws_transfer_workflow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerConfig_workflowCreateBeforeDestroy(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &conf),
+					testAccCheckWorkflowExists(ctx, workflowResourceName, &workflow1),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow_details.0.on_upload.0.workflow_id", workflowResourceName, names.AttrID),
+				),
+			},
+			{
+				Config: testAccServerConfig_workflowCreateBeforeDestroy(rName, "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(workflowResourceName, plancheck.ResourceActionCreateBeforeDestroy),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, resourceName, &conf),
+					testAccCheckWorkflowExists(ctx, workflowResourceName, &workflow2),
+					testAccCheckWorkflowRecreated(&workflow1, &workflow2),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow_details.0.on_upload.0.workflow_id", workflowResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckServerExists(ctx context.Context, n string, v *awstypes.DescribedServer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -2359,6 +2401,56 @@ resource "aws_transfer_server" "test" {
 `, rName)
 }
 
+func testAccServerConfig_workflowCreateBeforeDestroy(rName, stepName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "transfer.amazonaws.com"
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_transfer_workflow" "test" {
+  steps {
+    delete_step_details {
+      name                 = %[2]q
+      source_file_location = "$${original.file}"
+    }
+    type = "DELETE"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_transfer_server" "test" {
+  workflow_details {
+    on_upload {
+      execution_role = aws_iam_role.test.arn
+      workflow_id    = aws_transfer_workflow.test.id
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, stepName)
+}
+
 func testAccServerConfig_workflowUpdated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
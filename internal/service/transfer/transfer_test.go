@@ -59,6 +59,7 @@ func TestAccTransfer_serial(t *testing.T) {
 			"VPCEndpointID":                                          testAccServer_vpcEndpointID,
 			"VPCSecurityGroupIDs":                                    testAccServer_vpcSecurityGroupIDs,
 			"Workflow":                                               testAccServer_workflowDetails,
+			"WorkflowReplaced":                                       testAccServer_workflowDetailsReplaced,
 		},
 		"SSHKey": {
 			acctest.CtBasic:      testAccSSHKey_basic,
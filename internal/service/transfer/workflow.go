@@ -6,6 +6,8 @@ package transfer
 import (
 	"context"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -756,11 +758,23 @@ func resourceWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).TransferClient(ctx)
 
+	// There is no UpdateWorkflow API so every change to steps replaces the workflow.
+	// Deleting a workflow that a server still references leaves the server pointing at a workflow that no longer exists.
+	serverIDs, err := findServerIDsByWorkflowID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Servers referencing Workflow (%s): %s", d.Id(), err)
+	}
+
+	if len(serverIDs) > 0 {
+		return sdkdiag.AppendErrorf(diags, "deleting Transfer Workflow (%s): referenced by Transfer Server(s) %s; add lifecycle { create_before_destroy = true } to the workflow so that servers are updated before it is deleted", d.Id(), strings.Join(serverIDs, ", "))
+	}
+
 	log.Printf("[DEBUG] Deleting Transfer Workflow: %s", d.Id())
 	input := transfer.DeleteWorkflowInput{
 		WorkflowId: aws.String(d.Id()),
 	}
-	_, err := conn.DeleteWorkflow(ctx, &input)
+	_, err = conn.DeleteWorkflow(ctx, &input)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return diags
@@ -798,6 +812,46 @@ func findWorkflowByID(ctx context.Context, conn *transfer.Client, id string) (*a
 	return output.Workflow, nil
 }
 
+// findServerIDsByWorkflowID returns the IDs of servers whose workflow_details reference the specified workflow.
+func findServerIDsByWorkflowID(ctx context.Context, conn *transfer.Client, workflowID string) ([]string, error) {
+	var serverIDs []string
+
+	pages := transfer.NewListServersPaginator(conn, &transfer.ListServersInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Servers {
+			serverID := aws.ToString(v.ServerId)
+			server, err := findServerByID(ctx, conn, serverID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if server.WorkflowDetails == nil {
+				continue
+			}
+
+			references := func(v awstypes.WorkflowDetail) bool {
+				return aws.ToString(v.WorkflowId) == workflowID
+			}
+			if slices.ContainsFunc(server.WorkflowDetails.OnUpload, references) || slices.ContainsFunc(server.WorkflowDetails.OnPartialUpload, references) {
+				serverIDs = append(serverIDs, serverID)
+			}
+		}
+	}
+
+	return serverIDs, nil
+}
+
 func expandWorkflowSteps(tfList []interface{}) []awstypes.WorkflowStep {
 	if len(tfList) == 0 {
 		return nil
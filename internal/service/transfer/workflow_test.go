@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -321,6 +322,16 @@ func testAccCheckWorkflowExists(ctx context.Context, n string, v *awstypes.Descr
 	}
 }
 
+func testAccCheckWorkflowRecreated(i, j *awstypes.DescribedWorkflow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(i.WorkflowId) == aws.ToString(j.WorkflowId) {
+			return fmt.Errorf("Transfer Workflow (%s) not recreated", aws.ToString(i.WorkflowId))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckWorkflowDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferClient(ctx)
@@ -5,17 +5,19 @@ package verifiedpermissions
 
 // Exports for use in tests only.
 var (
-	ResourceIdentitySource = newResourceIdentitySource
-	ResourcePolicy         = newResourcePolicy
-	ResourcePolicyStore    = newResourcePolicyStore
-	ResourcePolicyTemplate = newResourcePolicyTemplate
-	ResourceSchema         = newResourceSchema
+	ResourceIdentitySource         = newResourceIdentitySource
+	ResourcePolicy                 = newResourcePolicy
+	ResourcePolicyStore            = newResourcePolicyStore
+	ResourcePolicyTemplate         = newResourcePolicyTemplate
+	ResourceSchema                 = newResourceSchema
+	ResourceTemplateLinkedPolicies = newResourceTemplateLinkedPolicies
 
 	FindIdentitySourceByIDAndPolicyStoreID = findIdentitySourceByIDAndPolicyStoreID
 	FindPolicyByID                         = findPolicyByID
 	FindPolicyStoreByID                    = findPolicyStoreByID
 	FindPolicyTemplateByID                 = findPolicyTemplateByID
 	FindSchemaByPolicyStoreID              = findSchemaByPolicyStoreID
+	FindTemplateLinkedPoliciesByTemplateID = findTemplateLinkedPoliciesByTemplateID
 )
 
 var (
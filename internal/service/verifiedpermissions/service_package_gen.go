@@ -51,6 +51,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_verifiedpermissions_schema",
 			Name:     "Schema",
 		},
+		{
+			Factory:  newResourceTemplateLinkedPolicies,
+			TypeName: "aws_verifiedpermissions_template_linked_policies",
+			Name:     "Template Linked Policies",
+		},
 	}
 }
 
@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	interflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(aws_verifiedpermissions_template_linked_policies, name="Template Linked Policies")
+func newResourceTemplateLinkedPolicies(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTemplateLinkedPolicies{}
+
+	return r, nil
+}
+
+const (
+	ResNameTemplateLinkedPolicies = "Template Linked Policies"
+
+	ResourceTemplateLinkedPoliciesIDPartsCount = 2
+)
+
+type resourceTemplateLinkedPolicies struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceTemplateLinkedPolicies) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_template_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrPolicy: schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[templateLinkedPolicyEntities](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"principal_entity_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"principal_entity_type": schema.StringAttribute{
+							Optional: true,
+						},
+						"resource_entity_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"resource_entity_type": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceTemplateLinkedPolicies) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var plan resourceTemplateLinkedPoliciesData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := []string{
+		plan.PolicyStoreID.ValueString(),
+		plan.PolicyTemplateID.ValueString(),
+	}
+
+	rID, err := interflex.FlattenResourceId(idParts, ResourceTemplateLinkedPoliciesIDPartsCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameTemplateLinkedPolicies, plan.PolicyTemplateID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	policies, diags := plan.Policies.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, policy := range policies {
+		if err := createTemplateLinkedPolicy(ctx, conn, plan.PolicyStoreID.ValueString(), plan.PolicyTemplateID.ValueString(), policy); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameTemplateLinkedPolicies, rID, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = fwflex.StringValueToFramework(ctx, rID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceTemplateLinkedPolicies) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var state resourceTemplateLinkedPoliciesData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rID, err := interflex.ExpandResourceId(state.ID.ValueString(), ResourceTemplateLinkedPoliciesIDPartsCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionSetting, ResNameTemplateLinkedPolicies, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findTemplateLinkedPoliciesByTemplateID(ctx, conn, rID[0], rID[1])
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionSetting, ResNameTemplateLinkedPolicies, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.PolicyStoreID = fwflex.StringValueToFramework(ctx, rID[0])
+	state.PolicyTemplateID = fwflex.StringValueToFramework(ctx, rID[1])
+
+	policies := make([]*templateLinkedPolicyEntities, 0, len(out))
+	for _, v := range out {
+		policies = append(policies, flattenTemplateLinkedPolicyEntities(ctx, v))
+	}
+
+	var diags diag.Diagnostics
+	state.Policies, diags = fwtypes.NewSetNestedObjectValueOfSlice(ctx, policies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceTemplateLinkedPolicies) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var state, plan resourceTemplateLinkedPoliciesData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Policies.Equal(state.Policies) {
+		policyStoreID, policyTemplateID := plan.PolicyStoreID.ValueString(), plan.PolicyTemplateID.ValueString()
+
+		out, err := findTemplateLinkedPoliciesByTemplateID(ctx, conn, policyStoreID, policyTemplateID)
+
+		if err != nil && !tfresource.NotFound(err) {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameTemplateLinkedPolicies, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		existing := make(map[string]string, len(out))
+		for _, v := range out {
+			existing[flattenTemplateLinkedPolicyEntities(ctx, v).key()] = aws.ToString(v.PolicyId)
+		}
+
+		policies, diags := plan.Policies.ToSlice(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Only the links that were added or removed are touched, so the remaining policies keep their IDs.
+		var add []*templateLinkedPolicyEntities
+		for _, policy := range policies {
+			if _, ok := existing[policy.key()]; ok {
+				delete(existing, policy.key())
+			} else {
+				add = append(add, policy)
+			}
+		}
+
+		for _, policyID := range existing {
+			if err := deleteTemplateLinkedPolicy(ctx, conn, policyStoreID, policyID); err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameTemplateLinkedPolicies, plan.ID.String(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		for _, policy := range add {
+			if err := createTemplateLinkedPolicy(ctx, conn, policyStoreID, policyTemplateID, policy); err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameTemplateLinkedPolicies, plan.ID.String(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceTemplateLinkedPolicies) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var state resourceTemplateLinkedPoliciesData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := state.PolicyStoreID.ValueString()
+
+	out, err := findTemplateLinkedPoliciesByTemplateID(ctx, conn, policyStoreID, state.PolicyTemplateID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNameTemplateLinkedPolicies, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	for _, v := range out {
+		if err := deleteTemplateLinkedPolicy(ctx, conn, policyStoreID, aws.ToString(v.PolicyId)); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNameTemplateLinkedPolicies, state.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func createTemplateLinkedPolicy(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyTemplateID string, policy *templateLinkedPolicyEntities) error {
+	value := awstypes.TemplateLinkedPolicyDefinition{
+		PolicyTemplateId: aws.String(policyTemplateID),
+	}
+
+	if !policy.PrincipalEntityID.IsNull() || !policy.PrincipalEntityType.IsNull() {
+		value.Principal = &awstypes.EntityIdentifier{
+			EntityId:   fwflex.StringFromFramework(ctx, policy.PrincipalEntityID),
+			EntityType: fwflex.StringFromFramework(ctx, policy.PrincipalEntityType),
+		}
+	}
+
+	if !policy.ResourceEntityID.IsNull() || !policy.ResourceEntityType.IsNull() {
+		value.Resource = &awstypes.EntityIdentifier{
+			EntityId:   fwflex.StringFromFramework(ctx, policy.ResourceEntityID),
+			EntityType: fwflex.StringFromFramework(ctx, policy.ResourceEntityType),
+		}
+	}
+
+	in := &verifiedpermissions.CreatePolicyInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Definition: &awstypes.PolicyDefinitionMemberTemplateLinked{
+			Value: value,
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	if _, err := conn.CreatePolicy(ctx, in); err != nil {
+		return fmt.Errorf("linking policy (%s): %w", policy.key(), err)
+	}
+
+	return nil
+}
+
+func deleteTemplateLinkedPolicy(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyID string) error {
+	in := &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	_, err := conn.DeletePolicy(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting policy (%s): %w", policyID, err)
+	}
+
+	return nil
+}
+
+func findTemplateLinkedPoliciesByTemplateID(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyTemplateID string) ([]awstypes.PolicyItem, error) {
+	in := &verifiedpermissions.ListPoliciesInput{
+		Filter: &awstypes.PolicyFilter{
+			PolicyTemplateId: aws.String(policyTemplateID),
+			PolicyType:       awstypes.PolicyTypeTemplateLinked,
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	var out []awstypes.PolicyItem
+
+	pages := verifiedpermissions.NewListPoliciesPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.Policies...)
+	}
+
+	if len(out) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func flattenTemplateLinkedPolicyEntities(ctx context.Context, apiObject awstypes.PolicyItem) *templateLinkedPolicyEntities {
+	policy := &templateLinkedPolicyEntities{
+		PrincipalEntityID:   types.StringNull(),
+		PrincipalEntityType: types.StringNull(),
+		ResourceEntityID:    types.StringNull(),
+		ResourceEntityType:  types.StringNull(),
+	}
+
+	if v := apiObject.Principal; v != nil {
+		policy.PrincipalEntityID = fwflex.StringToFramework(ctx, v.EntityId)
+		policy.PrincipalEntityType = fwflex.StringToFramework(ctx, v.EntityType)
+	}
+
+	if v := apiObject.Resource; v != nil {
+		policy.ResourceEntityID = fwflex.StringToFramework(ctx, v.EntityId)
+		policy.ResourceEntityType = fwflex.StringToFramework(ctx, v.EntityType)
+	}
+
+	return policy
+}
+
+type resourceTemplateLinkedPoliciesData struct {
+	ID               types.String                                                 `tfsdk:"id"`
+	Policies         fwtypes.SetNestedObjectValueOf[templateLinkedPolicyEntities] `tfsdk:"policy"`
+	PolicyStoreID    types.String                                                 `tfsdk:"policy_store_id"`
+	PolicyTemplateID types.String                                                 `tfsdk:"policy_template_id"`
+}
+
+type templateLinkedPolicyEntities struct {
+	PrincipalEntityID   types.String `tfsdk:"principal_entity_id"`
+	PrincipalEntityType types.String `tfsdk:"principal_entity_type"`
+	ResourceEntityID    types.String `tfsdk:"resource_entity_id"`
+	ResourceEntityType  types.String `tfsdk:"resource_entity_type"`
+}
+
+// key identifies a template-linked policy by the entities it is linked to.
+func (p *templateLinkedPolicyEntities) key() string {
+	return fmt.Sprintf("%s::%q|%s::%q", p.PrincipalEntityType.ValueString(), p.PrincipalEntityID.ValueString(), p.ResourceEntityType.ValueString(), p.ResourceEntityID.ValueString())
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	interflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsTemplateLinkedPolicies_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_template_linked_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateLinkedPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateLinkedPoliciesConfig_basic(rName, []string{"album1", "album2"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateLinkedPoliciesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policy.*", map[string]string{
+						"principal_entity_id":   "TestUsers",
+						"principal_entity_type": "User",
+						"resource_entity_id":    "album1",
+						"resource_entity_type":  "Album",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateLinkedPoliciesConfig_basic(rName, []string{"album2", "album3", "album4"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateLinkedPoliciesExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "policy.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policy.*", map[string]string{
+						"resource_entity_id": "album4",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsTemplateLinkedPolicies_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_template_linked_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateLinkedPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateLinkedPoliciesConfig_basic(rName, []string{"album1"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateLinkedPoliciesExists(ctx, resourceName, 1),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourceTemplateLinkedPolicies, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateLinkedPoliciesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_template_linked_policies" {
+				continue
+			}
+
+			rID, err := interflex.ExpandResourceId(rs.Primary.ID, tfverifiedpermissions.ResourceTemplateLinkedPoliciesIDPartsCount, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfverifiedpermissions.FindTemplateLinkedPoliciesByTemplateID(ctx, conn, rID[0], rID[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNameTemplateLinkedPolicies, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNameTemplateLinkedPolicies, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateLinkedPoliciesExists(ctx context.Context, name string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNameTemplateLinkedPolicies, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNameTemplateLinkedPolicies, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+		rID, err := interflex.ExpandResourceId(rs.Primary.ID, tfverifiedpermissions.ResourceTemplateLinkedPoliciesIDPartsCount, false)
+		if err != nil {
+			return err
+		}
+
+		out, err := tfverifiedpermissions.FindTemplateLinkedPoliciesByTemplateID(ctx, conn, rID[0], rID[1])
+
+		if err != nil {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNameTemplateLinkedPolicies, rs.Primary.ID, err)
+		}
+
+		if got := len(out); got != count {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNameTemplateLinkedPolicies, rs.Primary.ID, fmt.Errorf("expected %d linked policies, got %d", count, got))
+		}
+
+		return nil
+	}
+}
+
+func testAccTemplateLinkedPoliciesConfig_basic(rName string, albums []string) string {
+	quoted := make([]string, 0, len(albums))
+	for _, album := range albums {
+		quoted = append(quoted, strconv.Quote(album))
+	}
+
+	return acctest.ConfigCompose(
+		testAccPolicyConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  statement   = "permit (principal in ?principal, action in PhotoFlash::Action::\"FullPhotoAccess\", resource == ?resource) unless { resource.IsPrivate };"
+  description = %[1]q
+}
+
+resource "aws_verifiedpermissions_template_linked_policies" "test" {
+  policy_store_id    = aws_verifiedpermissions_policy_store.test.id
+  policy_template_id = aws_verifiedpermissions_policy_template.test.policy_template_id
+
+  dynamic "policy" {
+    for_each = toset([%[2]s])
+
+    content {
+      principal_entity_id   = "TestUsers"
+      principal_entity_type = "User"
+      resource_entity_id    = policy.value
+      resource_entity_type  = "Album"
+    }
+  }
+}
+`, rName, strings.Join(quoted, ", ")))
+}
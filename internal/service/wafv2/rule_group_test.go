@@ -2373,6 +2373,51 @@ func TestAccWAFV2RuleGroup_rateBasedStatement(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2RuleGroup_RateBased_customKeysComposite(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RuleGroup
+	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_rateBasedStatement_customKeysComposite(ruleGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"statement.0.rate_based_statement.0.aggregate_key_type":                                  "CUSTOM_KEYS",
+						"statement.0.rate_based_statement.0.custom_key.#":                                        "5",
+						"statement.0.rate_based_statement.0.custom_key.0.header.#":                               "1",
+						"statement.0.rate_based_statement.0.custom_key.0.header.0.name":                          "x-api-key",
+						"statement.0.rate_based_statement.0.custom_key.0.header.0.text_transformation.#":         "2",
+						"statement.0.rate_based_statement.0.custom_key.1.cookie.#":                               "1",
+						"statement.0.rate_based_statement.0.custom_key.1.cookie.0.name":                          "session",
+						"statement.0.rate_based_statement.0.custom_key.2.query_argument.#":                       "1",
+						"statement.0.rate_based_statement.0.custom_key.2.query_argument.0.name":                  "tenant",
+						"statement.0.rate_based_statement.0.custom_key.2.query_argument.0.text_transformation.#": "1",
+						"statement.0.rate_based_statement.0.custom_key.3.label_namespace.#":                      "1",
+						"statement.0.rate_based_statement.0.custom_key.3.label_namespace.0.namespace":            "awswaf:clientip:geo:country:",
+						"statement.0.rate_based_statement.0.custom_key.4.uri_path.#":                             "1",
+						"statement.0.rate_based_statement.0.custom_key.4.uri_path.0.text_transformation.#":       "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccRuleGroupImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
 func TestAccWAFV2RuleGroup_RateBased_maxNested(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.RuleGroup
@@ -5126,6 +5171,97 @@ resource "aws_wafv2_rule_group" "test" {
 `, rName)
 }
 
+func testAccRuleGroupConfig_rateBasedStatement_customKeysComposite(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_rule_group" "test" {
+  capacity = 200
+  name     = %[1]q
+  scope    = "REGIONAL"
+
+  rule {
+    name     = "rule-1"
+    priority = 1
+
+    action {
+      count {}
+    }
+
+    statement {
+      rate_based_statement {
+        aggregate_key_type = "CUSTOM_KEYS"
+        limit              = 50000
+
+        custom_key {
+          header {
+            name = "x-api-key"
+
+            text_transformation {
+              type     = "LOWERCASE"
+              priority = 0
+            }
+
+            text_transformation {
+              type     = "URL_DECODE"
+              priority = 1
+            }
+          }
+        }
+
+        custom_key {
+          cookie {
+            name = "session"
+
+            text_transformation {
+              type     = "NONE"
+              priority = 0
+            }
+          }
+        }
+
+        custom_key {
+          query_argument {
+            name = "tenant"
+
+            text_transformation {
+              type     = "NONE"
+              priority = 0
+            }
+          }
+        }
+
+        custom_key {
+          label_namespace {
+            namespace = "awswaf:clientip:geo:country:"
+          }
+        }
+
+        custom_key {
+          uri_path {
+            text_transformation {
+              type     = "LOWERCASE"
+              priority = 0
+            }
+          }
+        }
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName)
+}
+
 func testAccRuleGroupConfig_rateBasedStatement_update(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_rule_group" "test" {
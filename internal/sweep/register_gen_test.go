@@ -4,6 +4,7 @@ package sweep_test
 
 import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/account"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/amp"
@@ -177,6 +178,7 @@ import (
 
 func registerSweepers() {
 	accessanalyzer.RegisterSweepers()
+	account.RegisterSweepers()
 	acm.RegisterSweepers()
 	acmpca.RegisterSweepers()
 	amp.RegisterSweepers()
@@ -52,6 +52,12 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// UnknownTags is set when the default tags as a whole were unknown when the provider was configured,
+	// e.g. during planning when they are derived from a resource that has not yet been created.
+	UnknownTags bool
+	// UnknownValueKeys contains the keys of default tags whose values were unknown when the provider was configured.
+	// Such values are configured as empty strings.
+	UnknownValueKeys []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -75,6 +81,47 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// IsUnknown returns whether the DefaultConfig's Tags as a whole were unknown
+// when the provider was configured.
+func (dc *DefaultConfig) IsUnknown() bool {
+	if dc == nil {
+		return false
+	}
+
+	return dc.UnknownTags
+}
+
+// HasUnknownValues returns whether any of the DefaultConfig's Tags values were unknown
+// when the provider was configured.
+func (dc *DefaultConfig) HasUnknownValues() bool {
+	if dc == nil {
+		return false
+	}
+
+	return dc.UnknownTags || len(dc.UnknownValueKeys) > 0
+}
+
+// UnknownKeys returns the keys of allTags, the result of merging resourceTags on to
+// the DefaultConfig's Tags, whose values are those of default tags that were unknown
+// when the provider was configured.
+func (dc *DefaultConfig) UnknownKeys(resourceTags, allTags KeyValueTags) []string {
+	if dc == nil {
+		return nil
+	}
+
+	var keys []string
+
+	for _, k := range dc.UnknownValueKeys {
+		if resourceTags.KeyExists(k) || !allTags.KeyExists(k) {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -5,6 +5,7 @@ package tags
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -56,6 +57,172 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigHasUnknownValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		want          bool
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			want:          false,
+		},
+		{
+			name:          "empty config",
+			defaultConfig: &DefaultConfig{},
+			want:          false,
+		},
+		{
+			name: "known values",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "",
+				}),
+			},
+			want: false,
+		},
+		{
+			name: "unknown values",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "",
+				}),
+				UnknownValueKeys: []string{"key2"},
+			},
+			want: true,
+		},
+		{
+			name: "unknown tags",
+			defaultConfig: &DefaultConfig{
+				UnknownTags: true,
+			},
+			want: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.defaultConfig.HasUnknownValues(), testCase.want; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigUnknownKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		resourceTags  KeyValueTags
+		allTags       KeyValueTags
+		want          []string
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			resourceTags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			allTags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			want: nil,
+		},
+		{
+			name: "known values",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			resourceTags: New(ctx, map[string]string{
+				"key2": "value2",
+			}),
+			allTags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: nil,
+		},
+		{
+			name: "unknown values",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "",
+					"key3": "",
+				}),
+				UnknownValueKeys: []string{"key2", "key3"},
+			},
+			resourceTags: New(ctx, map[string]string{
+				"key4": "value4",
+			}),
+			allTags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "",
+				"key3": "",
+				"key4": "value4",
+			}),
+			want: []string{"key2", "key3"},
+		},
+		{
+			name: "unknown value overridden by resource tag",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "",
+				}),
+				UnknownValueKeys: []string{"key2"},
+			},
+			resourceTags: New(ctx, map[string]string{
+				"key2": "value2",
+			}),
+			allTags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: nil,
+		},
+		{
+			name: "unknown value ignored",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "",
+				}),
+				UnknownValueKeys: []string{"key2"},
+			},
+			allTags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			want: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.UnknownKeys(testCase.resourceTags, testCase.allTags)
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
@@ -92,6 +93,84 @@ func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T,
 	return output, nil
 }
 
+// RetryGWhenWithBackoff retries the function `f` when the error it returns satisfies `retryable`,
+// waiting between attempts with exponential backoff and logging each retry.
+// `f` is retried until `timeout` expires, after which it is called one final time.
+// If `ctx` is done while waiting between attempts, the context's error is returned wrapping the last error from `f`.
+func RetryGWhenWithBackoff[T any](ctx context.Context, timeout time.Duration, f func() (T, error), retryable Retryable) (T, error) {
+	return retryGWhenWithBackoff(ctx, realClock{}, timeout, f, retryable)
+}
+
+// clock abstracts the passage of time in retry loops so that they can be tested deterministically.
+type clock interface {
+	Now() time.Time
+	After(time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+const (
+	retryMinDelay = 500 * time.Millisecond
+	retryMaxDelay = 10 * time.Second
+)
+
+func retryGWhenWithBackoff[T any](ctx context.Context, clock clock, timeout time.Duration, f func() (T, error), retryable Retryable) (T, error) {
+	var zero T
+	deadline := clock.Now().Add(timeout)
+	delay := retryMinDelay
+
+	for attempt := 1; ; attempt++ {
+		output, err := f()
+		again, err := retryable(err)
+
+		if !again {
+			if err != nil {
+				return zero, err
+			}
+
+			return output, nil
+		}
+
+		remaining := deadline.Sub(clock.Now())
+		if remaining <= 0 {
+			// One final attempt after the timeout has elapsed.
+			output, err = f()
+
+			if err != nil {
+				return zero, err
+			}
+
+			return output, nil
+		}
+
+		tflog.Debug(ctx, "Retrying after retryable error", map[string]any{
+			"attempt": attempt,
+			"delay":   min(delay, remaining).String(),
+			"error":   fmt.Sprint(err),
+		})
+
+		select {
+		case <-ctx.Done():
+			if err == nil {
+				return zero, ctx.Err()
+			}
+
+			return zero, fmt.Errorf("%w; last error: %w", ctx.Err(), err)
+		case <-clock.After(min(delay, remaining)):
+		}
+
+		delay = min(delay*2, retryMaxDelay)
+	}
+}
+
 // RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
 func RetryWhenAWSErrCodeEquals(ctx context.Context, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
@@ -186,6 +265,51 @@ func RetryWhenIsAErrorMessageContains[T errs.ErrorWithErrorMessage](ctx context.
 	})
 }
 
+// RetryGWhenIsA retries the specified function with backoff when it returns an error of type E.
+func RetryGWhenIsA[T any, E error](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return RetryGWhenWithBackoff(ctx, timeout, f, retryableIsA[E])
+}
+
+// RetryGWhenIsOneOf2 retries the specified function with backoff when it returns an error of type E1 or E2.
+func RetryGWhenIsOneOf2[T any, E1, E2 error](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return RetryGWhenWithBackoff(ctx, timeout, f, retryableIsOneOf2[E1, E2])
+}
+
+// RetryGWhenIsOneOf3 retries the specified function with backoff when it returns an error of type E1, E2 or E3.
+func RetryGWhenIsOneOf3[T any, E1, E2, E3 error](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return RetryGWhenWithBackoff(ctx, timeout, f, retryableIsOneOf3[E1, E2, E3])
+}
+
+// RetryGWhenIsAErrorMessageContains retries the specified function with backoff when it returns an error of type E
+// whose message contains any of the specified needles.
+func RetryGWhenIsAErrorMessageContains[T any, E errs.ErrorWithErrorMessage](ctx context.Context, timeout time.Duration, f func() (T, error), needles ...string) (T, error) {
+	return RetryGWhenWithBackoff(ctx, timeout, f, retryableIsAErrorMessageContains[E](needles...))
+}
+
+func retryableIsA[E error](err error) (bool, error) {
+	return errs.IsA[E](err), err
+}
+
+func retryableIsOneOf2[E1, E2 error](err error) (bool, error) {
+	return errs.IsA[E1](err) || errs.IsA[E2](err), err
+}
+
+func retryableIsOneOf3[E1, E2, E3 error](err error) (bool, error) {
+	return errs.IsA[E1](err) || errs.IsA[E2](err) || errs.IsA[E3](err), err
+}
+
+func retryableIsAErrorMessageContains[E errs.ErrorWithErrorMessage](needles ...string) Retryable {
+	return func(err error) (bool, error) {
+		for _, needle := range needles {
+			if errs.IsAErrorMessageContains[E](err, needle) {
+				return true, err
+			}
+		}
+
+		return false, err
+	}
+}
+
 // RetryUntilEqual retries the specified function until it returns a value equal to `t`.
 func RetryUntilEqual[T comparable](ctx context.Context, timeout time.Duration, t T, f func() (T, error)) (T, error) {
 	var output T
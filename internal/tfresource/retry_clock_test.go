@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeClock advances instantly whenever a retry loop waits.
+type fakeClock struct {
+	now    time.Time
+	waits  []time.Duration
+	onWait func()
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+
+	if c.onWait != nil {
+		c.onWait()
+		// Never fire, the test cancels the context instead.
+		return make(chan time.Time)
+	}
+
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+type retryableError struct{}
+
+func (retryableError) Error() string { return "retryable" }
+
+type otherRetryableError struct{}
+
+func (otherRetryableError) Error() string { return "other retryable" }
+
+func TestRetryGWhenWithBackoff_succeedsAfterRetries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var attempts int
+
+	output, err := retryGWhenWithBackoff(ctx, clock, time.Minute, func() (int, error) {
+		attempts++
+		if attempts < 4 {
+			return 0, retryableError{}
+		}
+		return 42, nil
+	}, retryableIsA[retryableError])
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := output, 42; got != want {
+		t.Errorf("output = %v, want %v", got, want)
+	}
+	if got, want := attempts, 4; got != want {
+		t.Errorf("attempts = %v, want %v", got, want)
+	}
+	if got, want := clock.waits, []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second}; !slices.Equal(got, want) {
+		t.Errorf("waits = %v, want %v", got, want)
+	}
+}
+
+func TestRetryGWhenWithBackoff_nonRetryableError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	terminal := errors.New("terminal")
+	var attempts int
+
+	_, err := retryGWhenWithBackoff(ctx, clock, time.Minute, func() (int, error) {
+		attempts++
+		if attempts == 1 {
+			return 0, retryableError{}
+		}
+		return 0, terminal
+	}, retryableIsA[retryableError])
+
+	if !errors.Is(err, terminal) {
+		t.Errorf("error = %v, want %v", err, terminal)
+	}
+	if got, want := attempts, 2; got != want {
+		t.Errorf("attempts = %v, want %v", got, want)
+	}
+}
+
+func TestRetryGWhenWithBackoff_timeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var attempts int
+
+	_, err := retryGWhenWithBackoff(ctx, clock, 30*time.Second, func() (int, error) {
+		attempts++
+		return 0, retryableError{}
+	}, retryableIsA[retryableError])
+
+	var e retryableError
+	if !errors.As(err, &e) {
+		t.Errorf("error = %v, want retryable error", err)
+	}
+
+	// 0.5s, 1s, 2s, 4s, 8s, 10s, then the remaining 4.5s, then one final attempt.
+	if got, want := clock.waits, []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 4500 * time.Millisecond}; !slices.Equal(got, want) {
+		t.Errorf("waits = %v, want %v", got, want)
+	}
+	if got, want := attempts, len(clock.waits)+2; got != want {
+		t.Errorf("attempts = %v, want %v", got, want)
+	}
+}
+
+func TestRetryGWhenWithBackoff_contextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clock := &fakeClock{now: time.Unix(0, 0), onWait: cancel}
+	var attempts int
+
+	_, err := retryGWhenWithBackoff(ctx, clock, time.Hour, func() (int, error) {
+		attempts++
+		return 0, retryableError{}
+	}, retryableIsA[retryableError])
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+	var e retryableError
+	if !errors.As(err, &e) {
+		t.Errorf("error = %v, want wrapped retryable error", err)
+	}
+	if got, want := attempts, 1; got != want {
+		t.Errorf("attempts = %v, want %v", got, want)
+	}
+}
+
+type thirdRetryableError struct{}
+
+func (thirdRetryableError) Error() string { return "third retryable" }
+
+type messageError struct {
+	message string
+}
+
+func (e messageError) Error() string        { return e.message }
+func (e messageError) ErrorMessage() string { return e.message }
+
+func TestRetryGWhenIsOneOf(t *testing.T) {
+	t.Parallel()
+
+	terminal := errors.New("terminal")
+
+	testCases := map[string]struct {
+		errs      []error
+		retryable Retryable
+		wantErr   error
+	}{
+		"one of 2": {
+			errs:      []error{retryableError{}, otherRetryableError{}},
+			retryable: retryableIsOneOf2[retryableError, otherRetryableError],
+		},
+		"one of 2 terminal": {
+			errs:      []error{retryableError{}, thirdRetryableError{}},
+			retryable: retryableIsOneOf2[retryableError, otherRetryableError],
+			wantErr:   thirdRetryableError{},
+		},
+		"one of 3": {
+			errs:      []error{thirdRetryableError{}, retryableError{}, otherRetryableError{}},
+			retryable: retryableIsOneOf3[retryableError, otherRetryableError, thirdRetryableError],
+		},
+		"one of 3 terminal": {
+			errs:      []error{thirdRetryableError{}, terminal},
+			retryable: retryableIsOneOf3[retryableError, otherRetryableError, thirdRetryableError],
+			wantErr:   terminal,
+		},
+		"message contains": {
+			errs:      []error{messageError{"role is not assumable"}, messageError{"Unable to assume role"}},
+			retryable: retryableIsAErrorMessageContains[messageError]("is not assumable", "Unable to assume role"),
+		},
+		"message contains terminal": {
+			errs:      []error{messageError{"role is not assumable"}, messageError{"validation failed"}},
+			retryable: retryableIsAErrorMessageContains[messageError]("is not assumable", "Unable to assume role"),
+			wantErr:   messageError{"validation failed"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			clock := &fakeClock{now: time.Unix(0, 0)}
+			var attempts int
+
+			output, err := retryGWhenWithBackoff(ctx, clock, time.Minute, func() (string, error) {
+				attempts++
+				if attempts <= len(testCase.errs) {
+					return "", testCase.errs[attempts-1]
+				}
+				return "ok", nil
+			}, testCase.retryable)
+
+			if testCase.wantErr != nil {
+				if !errors.Is(err, testCase.wantErr) {
+					t.Errorf("error = %v, want %v", err, testCase.wantErr)
+				}
+				if got, want := attempts, len(testCase.errs); got != want {
+					t.Errorf("attempts = %v, want %v", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got, want := output, "ok"; got != want {
+				t.Errorf("output = %v, want %v", got, want)
+			}
+			if got, want := attempts, len(testCase.errs)+1; got != want {
+				t.Errorf("attempts = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -35,7 +35,16 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/18366
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19005
 
-	if !diff.GetRawPlan().GetAttr("tags").IsWhollyKnown() {
+	if !diff.GetRawPlan().GetAttr("tags").IsWhollyKnown() || defaultTagsConfig.IsUnknown() {
+		if err := diff.SetNewComputed("tags_all"); err != nil {
+			return fmt.Errorf("setting tags_all to computed: %w", err)
+		}
+		return nil
+	}
+
+	// A ResourceDiff can't plan individual map elements as unknown, so when the value of any
+	// default tag that is not overridden by a resource tag is not yet known, "tags_all" is planned as unknown.
+	if keys := defaultTagsConfig.UnknownKeys(resourceTags, allTags); len(keys) > 0 {
 		if err := diff.SetNewComputed("tags_all"); err != nil {
 			return fmt.Errorf("setting tags_all to computed: %w", err)
 		}
@@ -4,8 +4,15 @@
 package verify
 
 import (
+	"context"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestSuppressEquivalentRoundedTime(t *testing.T) {
@@ -60,3 +67,89 @@ func TestSuppressEquivalentRoundedTime(t *testing.T) {
 		}
 	}
 }
+
+func TestSetTagsDiff_defaultTagsUnknownValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resourceTags map[string]interface{}
+		computed     bool
+	}{
+		"unknown default tag": {
+			resourceTags: map[string]interface{}{
+				"key2": "value2",
+			},
+			computed: true,
+		},
+		"unknown default tag overridden by resource tag": {
+			resourceTags: map[string]interface{}{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			computed: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			meta := &conns.AWSClient{}
+			conns.SetDefaultTagsConfig(meta, &tftags.DefaultConfig{
+				Tags: tftags.New(ctx, map[string]string{
+					"key1": "",
+					"key3": "value3",
+				}),
+				UnknownValueKeys: []string{"key1"},
+			})
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"tags_all": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+				CustomizeDiff: SetTagsDiff,
+			}
+
+			tags := make(map[string]cty.Value)
+			for k, v := range testCase.resourceTags {
+				tags[k] = cty.StringVal(v.(string))
+			}
+			state := &terraform.InstanceState{
+				RawPlan: cty.ObjectVal(map[string]cty.Value{
+					"id":       cty.NullVal(cty.String),
+					"tags":     cty.MapVal(tags),
+					"tags_all": cty.UnknownVal(cty.Map(cty.String)),
+				}),
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"tags": testCase.resourceTags,
+			})
+
+			diff, err := r.Diff(ctx, state, config, meta)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attr, ok := diff.Attributes["tags_all.%"]
+			if !ok {
+				t.Fatal("expected a tags_all diff")
+			}
+
+			if got, want := attr.NewComputed, testCase.computed; got != want {
+				t.Errorf("tags_all NewComputed = %t, want %t", got, want)
+			}
+		})
+	}
+}
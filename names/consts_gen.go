@@ -73,6 +73,7 @@ const (
 	DMS                          = "dms"
 	DRS                          = "drs"
 	DS                           = "ds"
+	DSQL                         = "dsql"
 	DataBrew                     = "databrew"
 	DataExchange                 = "dataexchange"
 	DataPipeline                 = "datapipeline"
@@ -101,6 +102,7 @@ const (
 	ElasticBeanstalk             = "elasticbeanstalk"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -166,6 +168,7 @@ const (
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
 	NetworkMonitor               = "networkmonitor"
+	Notifications                = "notifications"
 	ObservabilityAccessManager   = "oam"
 	OpenSearch                   = "opensearch"
 	OpenSearchIngestion          = "osis"
@@ -329,6 +332,7 @@ const (
 	DMSServiceID                          = "Database Migration Service"
 	DRSServiceID                          = "DRS"
 	DSServiceID                           = "Directory Service"
+	DSQLServiceID                         = "DSQL"
 	DataBrewServiceID                     = "DataBrew"
 	DataExchangeServiceID                 = "DataExchange"
 	DataPipelineServiceID                 = "Data Pipeline"
@@ -357,6 +361,7 @@ const (
 	ElasticBeanstalkServiceID             = "Elastic Beanstalk"
 	ElasticTranscoderServiceID            = "Elastic Transcoder"
 	ElasticsearchServiceID                = "Elasticsearch Service"
+	EntityResolutionServiceID             = "EntityResolution"
 	EventsServiceID                       = "EventBridge"
 	EvidentlyServiceID                    = "Evidently"
 	FISServiceID                          = "fis"
@@ -422,6 +427,7 @@ const (
 	NetworkFirewallServiceID              = "Network Firewall"
 	NetworkManagerServiceID               = "NetworkManager"
 	NetworkMonitorServiceID               = "NetworkMonitor"
+	NotificationsServiceID                = "Notifications"
 	ObservabilityAccessManagerServiceID   = "OAM"
 	OpenSearchServiceID                   = "OpenSearch"
 	OpenSearchIngestionServiceID          = "OSIS"
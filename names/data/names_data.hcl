@@ -2678,6 +2678,29 @@ service "drs" {
   brand                    = "AWS"
 }
 
+service "dsql" {
+  sdk {
+    id = "DSQL"
+  }
+
+  names {
+    provider_name_upper = "DSQL"
+    human_friendly      = "DSQL"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListClusters"
+  }
+
+  resource_prefix {
+    correct = "aws_dsql_"
+  }
+
+  provider_package_correct = "dsql"
+  doc_prefix               = ["dsql_"]
+  brand                    = "Amazon"
+}
+
 service "ds" {
   go_packages {
     v1_package = "directoryservice"
@@ -3420,6 +3443,29 @@ service "emrserverless" {
   brand                    = "AWS"
 }
 
+service "entityresolution" {
+  sdk {
+    id = "EntityResolution"
+  }
+
+  names {
+    provider_name_upper = "EntityResolution"
+    human_friendly      = "Entity Resolution"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListMatchingWorkflows"
+  }
+
+  resource_prefix {
+    correct = "aws_entityresolution_"
+  }
+
+  provider_package_correct = "entityresolution"
+  doc_prefix               = ["entityresolution_"]
+  brand                    = "AWS"
+}
+
 service "events" {
   go_packages {
     v1_package = "eventbridge"
@@ -5628,6 +5674,32 @@ service "nimble" {
   not_implemented          = true
 }
 
+service "notifications" {
+  sdk {
+    id = "Notifications"
+  }
+
+  names {
+    provider_name_upper = "Notifications"
+    human_friendly      = "User Notifications"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListNotificationConfigurations"
+    endpoint_region_overrides = {
+      "aws" = "us-east-1"
+    }
+  }
+
+  resource_prefix {
+    correct = "aws_notifications_"
+  }
+
+  provider_package_correct = "notifications"
+  doc_prefix               = ["notifications_"]
+  brand                    = "AWS"
+}
+
 service "oam" {
   sdk {
     id = "OAM"
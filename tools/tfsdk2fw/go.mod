@@ -20,14 +20,14 @@ require (
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
 	github.com/aws/aws-sdk-go v1.55.6 // indirect
-	github.com/aws/aws-sdk-go-v2 v1.36.2 // indirect
+	github.com/aws/aws-sdk-go-v2 v1.37.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.10 // indirect
 	github.com/aws/aws-sdk-go-v2/config v1.29.7 // indirect
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.60 // indirect
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.29 // indirect
 	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.17.63 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.33 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.33 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.4.0 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.7.0 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.3 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.33 // indirect
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.37.1 // indirect
@@ -114,11 +114,12 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/docdb v1.40.11 // indirect
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.14.13 // indirect
 	github.com/aws/aws-sdk-go-v2/service/drs v1.30.16 // indirect
+	github.com/aws/aws-sdk-go-v2/service/dsql v1.5.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.40.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ec2 v1.203.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.41.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.31.3 // indirect
-	github.com/aws/aws-sdk-go-v2/service/ecs v1.53.16 // indirect
+	github.com/aws/aws-sdk-go-v2/service/ecs v1.61.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/efs v1.34.12 // indirect
 	github.com/aws/aws-sdk-go-v2/service/eks v1.58.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/elasticache v1.44.13 // indirect
@@ -130,6 +131,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/emr v1.47.13 // indirect
 	github.com/aws/aws-sdk-go-v2/service/emrcontainers v1.34.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.27.9 // indirect
+	github.com/aws/aws-sdk-go-v2/service/entityresolution v1.15.17 // indirect
 	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.36.12 // indirect
 	github.com/aws/aws-sdk-go-v2/service/evidently v1.23.16 // indirect
 	github.com/aws/aws-sdk-go-v2/service/finspace v1.28.16 // indirect
@@ -148,7 +150,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/healthlake v1.29.4 // indirect
 	github.com/aws/aws-sdk-go-v2/service/iam v1.39.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/identitystore v1.27.17 // indirect
-	github.com/aws/aws-sdk-go-v2/service/imagebuilder v1.40.8 // indirect
+	github.com/aws/aws-sdk-go-v2/service/imagebuilder v1.42.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/inspector v1.25.16 // indirect
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.34.10 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.3 // indirect
@@ -199,6 +201,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.45.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/networkmanager v1.32.11 // indirect
 	github.com/aws/aws-sdk-go-v2/service/networkmonitor v1.7.16 // indirect
+	github.com/aws/aws-sdk-go-v2/service/notifications v1.2.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/oam v1.15.19 // indirect
 	github.com/aws/aws-sdk-go-v2/service/opensearch v1.45.12 // indirect
 	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.18.1 // indirect
@@ -240,7 +243,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.53.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/s3outposts v1.28.16 // indirect
 	github.com/aws/aws-sdk-go-v2/service/s3tables v1.1.3 // indirect
-	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.177.0 // indirect
+	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.192.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.12.18 // indirect
 	github.com/aws/aws-sdk-go-v2/service/schemas v1.28.18 // indirect
 	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.34.19 // indirect
@@ -286,7 +289,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.52.6 // indirect
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.26.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/xray v1.30.13 // indirect
-	github.com/aws/smithy-go v1.22.3 // indirect
+	github.com/aws/smithy-go v1.22.5 // indirect
 	github.com/beevik/etree v1.5.0 // indirect
 	github.com/bgentry/speakeasy v0.2.0 // indirect
 	github.com/cedar-policy/cedar-go v0.1.0 // indirect